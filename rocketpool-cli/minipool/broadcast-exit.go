@@ -0,0 +1,112 @@
+package minipool
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+// Config
+const (
+	voluntaryExitPath    string        = "/eth/v1/beacon/pool/voluntary_exits"
+	broadcastExitTimeout time.Duration = 30 * time.Second
+)
+
+func broadcastExit(c *cli.Context) error {
+
+	// Read the exit file
+	if c.String("file") == "" {
+		return errors.New("Please specify the exit file to broadcast with --file.")
+	}
+	fileBytes, err := os.ReadFile(c.String("file"))
+	if err != nil {
+		return fmt.Errorf("error reading exit file: %w", err)
+	}
+	passphrase := ""
+	if validator.IsExitFileEncrypted(fileBytes) {
+		passphrase = cliutils.PromptPassword("Please enter the passphrase the exit file was encrypted with:", "^.+$", "Please enter the passphrase.")
+	}
+	exit, err := validator.ParseExitFile(fileBytes, passphrase)
+	if err != nil {
+		return err
+	}
+	epoch, err := exit.GetEpoch()
+	if err != nil {
+		return err
+	}
+	signature, err := exit.GetSignature()
+	if err != nil {
+		return err
+	}
+
+	// Show a warning message
+	fmt.Printf("%sNOTE:\n", colorYellow)
+	fmt.Printf("You are about to exit validator %s. This will tell it to stop all activities on the Beacon Chain.\n", exit.Message.ValidatorIndex)
+	fmt.Printf("Please continue to run it until the exit has been processed by the exit queue.%s\n\n", colorReset)
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.ConfirmWithIAgree(fmt.Sprintf("Are you sure you want to exit validator %s? This action cannot be undone!", exit.Message.ValidatorIndex))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Send the exit straight to the provided Beacon Node, which doesn't need the Smartnode to be installed
+	if c.String("beacon-url") != "" {
+		if err := postVoluntaryExit(c.String("beacon-url"), exit); err != nil {
+			return err
+		}
+		fmt.Printf("Successfully broadcast the exit for validator %s.\n", exit.Message.ValidatorIndex)
+		return nil
+	}
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Broadcast the exit with the node's Beacon Node
+	if _, err := rp.BroadcastMinipoolExit(exit.Message.ValidatorIndex, epoch, signature); err != nil {
+		return err
+	}
+	fmt.Printf("Successfully broadcast the exit for validator %s.\n", exit.Message.ValidatorIndex)
+	fmt.Println("It may take several hours for your minipool's status to be reflected.")
+	return nil
+
+}
+
+// Submit a signed voluntary exit to a Beacon Node's pool
+func postVoluntaryExit(beaconUrl string, exit validator.SignedVoluntaryExit) error {
+
+	body, err := json.Marshal(exit)
+	if err != nil {
+		return fmt.Errorf("error serializing exit: %w", err)
+	}
+	client := http.Client{
+		Timeout: broadcastExitTimeout,
+	}
+	response, err := client.Post(strings.TrimSuffix(beaconUrl, "/")+voluntaryExitPath, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error sending exit to the Beacon Node: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		responseBody, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("the Beacon Node rejected the exit: HTTP status %d; response body: '%s'", response.StatusCode, string(responseBody))
+	}
+	return nil
+
+}
@@ -101,7 +101,7 @@ func closeMinipools(c *cli.Context) error {
 
 	// Get selected minipools
 	var selectedMinipools []api.MinipoolCloseDetails
-	if c.String("minipool") == "" {
+	if getMinipoolSelection(c) == "" {
 
 		// Prompt for minipool selection
 		options := make([]string, len(closableMinipools)+1)
@@ -125,10 +125,10 @@ func closeMinipools(c *cli.Context) error {
 	} else {
 
 		// Get matching minipools
-		if c.String("minipool") == "all" {
+		if getMinipoolSelection(c) == "all" {
 			selectedMinipools = closableMinipools
 		} else {
-			selectedAddress := common.HexToAddress(c.String("minipool"))
+			selectedAddress := common.HexToAddress(getMinipoolSelection(c))
 			for _, minipool := range closableMinipools {
 				if bytes.Equal(minipool.Address.Bytes(), selectedAddress.Bytes()) {
 					selectedMinipools = []api.MinipoolCloseDetails{minipool}
@@ -200,21 +200,20 @@ func closeMinipools(c *cli.Context) error {
 	}
 
 	// Close minipools
-	for _, minipool := range selectedMinipools {
-
-		response, err := rp.CloseMinipool(minipool.Address)
-		if err != nil {
-			fmt.Printf("Could not close minipool %s: %s.\n", minipool.Address.Hex(), err.Error())
-			continue
-		}
-
-		fmt.Printf("Closing minipool %s...\n", minipool.Address.Hex())
-		cliutils.PrintTransactionHash(rp, response.TxHash)
-		if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-			fmt.Printf("Could not close minipool %s: %s.\n", minipool.Address.Hex(), err.Error())
-		} else {
-			fmt.Printf("Successfully closed minipool %s.\n", minipool.Address.Hex())
-		}
+	addresses := make([]common.Address, len(selectedMinipools))
+	for mi, minipool := range selectedMinipools {
+		addresses[mi] = minipool.Address
+	}
+	err = sendMinipoolTransactions(c, rp, addresses, minipoolTransactionMessages{
+		sending:   "Closing minipool %s...",
+		failed:    "Could not close minipool %s",
+		succeeded: "Successfully closed minipool %s.",
+	}, func(minipool common.Address) (common.Hash, error) {
+		response, err := rp.CloseMinipool(minipool)
+		return response.TxHash, err
+	})
+	if err != nil {
+		return err
 	}
 
 	// Return
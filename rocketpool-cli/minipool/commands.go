@@ -38,6 +38,30 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "performance",
+				Usage:     "Show the recent attestation, proposal, and sync committee performance of the node's minipools",
+				UsageText: "rocketpool minipool performance [options]",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "epochs, e",
+						Usage: "The number of recent epochs to show",
+						Value: 225,
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getPerformance(c)
+
+				},
+			},
+
 			{
 				Name:      "stake",
 				Aliases:   []string{"t"},
@@ -108,7 +132,15 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					},
 					cli.BoolFlag{
 						Name:  "no-restart",
-						Usage: "Don't restart the Validator Client after importing the key. Note that the key won't be loaded (and won't attest) until you restart the VC to load it.",
+						Usage: "Don't load the key into the Validator Client (or restart it) after importing the key. Note that the key won't be loaded (and won't attest) until you restart the VC to load it.",
+					},
+					cli.StringFlag{
+						Name:  "slashing-protection",
+						Usage: "The path to an EIP-3076 slashing protection file exported from the Validator Client that was running this validator before",
+					},
+					cli.BoolFlag{
+						Name:  "skip-liveness-check",
+						Usage: "Import the key even if the validator attested in the last few epochs. Only use this if you're certain it isn't running in any other Validator Client!",
 					},
 					cli.BoolFlag{
 						Name:  "yes, y",
@@ -141,6 +173,15 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "minipool, m",
 						Usage: "The minipool/s to promote (address or 'all')",
 					},
+					cli.BoolFlag{
+						Name:  "all",
+						Usage: "Select all eligible minipools without prompting (the same as '--minipool all')",
+					},
+					cli.UintFlag{
+						Name:  "batch-size, b",
+						Usage: "The number of transactions to send before waiting for them to be mined; each one gets the next nonce, so a whole batch can be mined in the same block",
+						Value: 1,
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -150,10 +191,8 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					}
 
 					// Validate flags
-					if c.String("minipool") != "" && c.String("minipool") != "all" {
-						if _, err := cliutils.ValidateAddress("minipool address", c.String("minipool")); err != nil {
-							return err
-						}
+					if err := validateMinipoolBatchFlags(c); err != nil {
+						return err
 					}
 
 					// Run
@@ -234,6 +273,15 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "minipool, m",
 						Usage: "The minipool/s to reduce the bond for (address or 'all')",
 					},
+					cli.BoolFlag{
+						Name:  "all",
+						Usage: "Select all eligible minipools without prompting (the same as '--minipool all')",
+					},
+					cli.UintFlag{
+						Name:  "batch-size, b",
+						Usage: "The number of transactions to send before waiting for them to be mined; each one gets the next nonce, so a whole batch can be mined in the same block",
+						Value: 1,
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -243,10 +291,8 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					}
 
 					// Validate flags
-					if c.String("minipool") != "" && c.String("minipool") != "all" {
-						if _, err := cliutils.ValidateAddress("minipool address", c.String("minipool")); err != nil {
-							return err
-						}
+					if err := validateMinipoolBatchFlags(c); err != nil {
+						return err
 					}
 
 					// Run
@@ -265,6 +311,15 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "minipool, m",
 						Usage: "The minipool/s to distribute the balance of (address or 'all')",
 					},
+					cli.BoolFlag{
+						Name:  "all",
+						Usage: "Select all eligible minipools without prompting (the same as '--minipool all')",
+					},
+					cli.UintFlag{
+						Name:  "batch-size, b",
+						Usage: "The number of transactions to send before waiting for them to be mined; each one gets the next nonce, so a whole batch can be mined in the same block",
+						Value: 1,
+					},
 					cli.Float64Flag{
 						Name:  "threshold, t",
 						Usage: "Filter on a minimum amount of ETH that can be distributed - minipools below this amount won't be shown",
@@ -278,10 +333,8 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					}
 
 					// Validate flags
-					if c.String("minipool") != "" && c.String("minipool") != "all" {
-						if _, err := cliutils.ValidateAddress("minipool address", c.String("minipool")); err != nil {
-							return err
-						}
+					if err := validateMinipoolBatchFlags(c); err != nil {
+						return err
 					}
 
 					// Run
@@ -358,6 +411,64 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "export-exits",
+				Usage:     "Save pre-signed voluntary exits for all staking minipools to files that can be broadcast later",
+				UsageText: "rocketpool minipool export-exits [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "output-dir, o",
+						Usage: "The folder to save the exit files to",
+					},
+					cli.BoolFlag{
+						Name:  "no-encryption",
+						Usage: "Save the exit files without encrypting them with a passphrase",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return exportExits(c)
+
+				},
+			},
+
+			{
+				Name:      "broadcast-exit",
+				Usage:     "Broadcast a voluntary exit saved with `export-exits`; this doesn't need a node wallet",
+				UsageText: "rocketpool minipool broadcast-exit --file path [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "file, f",
+						Usage: "The exit file to broadcast",
+					},
+					cli.StringFlag{
+						Name:  "beacon-url",
+						Usage: "The URL of a Beacon Node to send the exit to directly, instead of the Smartnode's Beacon Node",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm broadcasting the exit",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return broadcastExit(c)
+
+				},
+			},
+
 			{
 				Name:      "close",
 				Aliases:   []string{"c"},
@@ -368,6 +479,15 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "minipool, m",
 						Usage: "The minipool/s to close (address or 'all')",
 					},
+					cli.BoolFlag{
+						Name:  "all",
+						Usage: "Select all eligible minipools without prompting (the same as '--minipool all')",
+					},
+					cli.UintFlag{
+						Name:  "batch-size, b",
+						Usage: "The number of transactions to send before waiting for them to be mined; each one gets the next nonce, so a whole batch can be mined in the same block",
+						Value: 1,
+					},
 					cli.BoolFlag{
 						Name:  "confirm-slashing",
 						Usage: "Reserved for acknowledging situations where you've been slashed by the Beacon Chain, and closing a minipool will result in the complete loss of the ETH bond and your RPL collateral. DO NOT use this flag unless you have been explicitly instructed to do so.",
@@ -381,10 +501,8 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					}
 
 					// Validate flags
-					if c.String("minipool") != "" && c.String("minipool") != "all" {
-						if _, err := cliutils.ValidateAddress("minipool address", c.String("minipool")); err != nil {
-							return err
-						}
+					if err := validateMinipoolBatchFlags(c); err != nil {
+						return err
 					}
 
 					// Run
@@ -403,6 +521,15 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "minipool, m",
 						Usage: "The minipool/s to upgrade (address or 'all')",
 					},
+					cli.BoolFlag{
+						Name:  "all",
+						Usage: "Select all eligible minipools without prompting (the same as '--minipool all')",
+					},
+					cli.UintFlag{
+						Name:  "batch-size, b",
+						Usage: "The number of transactions to send before waiting for them to be mined; each one gets the next nonce, so a whole batch can be mined in the same block",
+						Value: 1,
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -412,10 +539,8 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					}
 
 					// Validate flags
-					if c.String("minipool") != "" && c.String("minipool") != "all" {
-						if _, err := cliutils.ValidateAddress("minipool address", c.String("minipool")); err != nil {
-							return err
-						}
+					if err := validateMinipoolBatchFlags(c); err != nil {
+						return err
 					}
 
 					// Run
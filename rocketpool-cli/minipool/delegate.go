@@ -48,11 +48,11 @@ func delegateUpgradeMinipools(c *cli.Context) error {
 	// Get selected minipools
 	var selectedMinipools []common.Address
 
-	if c.String("minipool") != "" && c.String("minipool") != "all" {
-		selectedAddress := common.HexToAddress(c.String("minipool"))
+	if getMinipoolSelection(c) != "" && getMinipoolSelection(c) != "all" {
+		selectedAddress := common.HexToAddress(getMinipoolSelection(c))
 		selectedMinipools = []common.Address{selectedAddress}
 	} else {
-		if c.String("minipool") == "" {
+		if getMinipoolSelection(c) == "" {
 			// Prompt for minipool selection
 			options := make([]string, len(minipools)+1)
 			options[0] = "All available minipools"
@@ -111,20 +111,16 @@ func delegateUpgradeMinipools(c *cli.Context) error {
 	}
 
 	// Upgrade minipools
-	for _, minipool := range selectedMinipools {
+	err = sendMinipoolTransactions(c, rp, selectedMinipools, minipoolTransactionMessages{
+		sending:   "Upgrading minipool %s...",
+		failed:    "Could not upgrade minipool %s",
+		succeeded: "Successfully upgraded minipool %s.",
+	}, func(minipool common.Address) (common.Hash, error) {
 		response, err := rp.DelegateUpgradeMinipool(minipool)
-		if err != nil {
-			fmt.Printf("Could not upgrade minipool %s: %s.\n", minipool.Hex(), err)
-			continue
-		}
-
-		fmt.Printf("Upgrading minipool %s...\n", minipool.Hex())
-		cliutils.PrintTransactionHash(rp, response.TxHash)
-		if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-			fmt.Printf("Could not upgrade minipool %s: %s.\n", minipool.Hex(), err)
-		} else {
-			fmt.Printf("Successfully upgraded minipool %s.\n", minipool.Hex())
-		}
+		return response.TxHash, err
+	})
+	if err != nil {
+		return err
 	}
 
 	// Return
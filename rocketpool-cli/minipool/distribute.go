@@ -140,7 +140,7 @@ func distributeBalance(c *cli.Context) error {
 
 	// Get selected minipools
 	var selectedMinipools []api.MinipoolBalanceDistributionDetails
-	if c.String("minipool") == "" {
+	if getMinipoolSelection(c) == "" {
 
 		// Get total rewards
 		totalEthAvailable := big.NewInt(0)
@@ -180,10 +180,10 @@ func distributeBalance(c *cli.Context) error {
 	} else {
 
 		// Get matching minipools
-		if c.String("minipool") == "all" {
+		if getMinipoolSelection(c) == "all" {
 			selectedMinipools = eligibleMinipools
 		} else {
-			selectedAddress := common.HexToAddress(c.String("minipool"))
+			selectedAddress := common.HexToAddress(getMinipoolSelection(c))
 			for _, minipool := range eligibleMinipools {
 				if bytes.Equal(minipool.Address.Bytes(), selectedAddress.Bytes()) {
 					selectedMinipools = []api.MinipoolBalanceDistributionDetails{minipool}
@@ -222,21 +222,20 @@ func distributeBalance(c *cli.Context) error {
 	}
 
 	// Distribute minipool balances
-	for _, minipool := range selectedMinipools {
-
-		response, err := rp.DistributeBalance(minipool.Address)
-		if err != nil {
-			fmt.Printf("Could not distribute the ETH balance of minipool %s: %s.\n", minipool.Address.Hex(), err.Error())
-			continue
-		}
-
-		fmt.Printf("Distributing balance of minipool %s...\n", minipool.Address.Hex())
-		cliutils.PrintTransactionHash(rp, response.TxHash)
-		if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-			fmt.Printf("Could not distribute the ETH balance of minipool %s: %s.\n", minipool.Address.Hex(), err.Error())
-		} else {
-			fmt.Printf("Successfully distributed the ETH balance of minipool %s.\n", minipool.Address.Hex())
-		}
+	addresses := make([]common.Address, len(selectedMinipools))
+	for mi, minipool := range selectedMinipools {
+		addresses[mi] = minipool.Address
+	}
+	err = sendMinipoolTransactions(c, rp, addresses, minipoolTransactionMessages{
+		sending:   "Distributing balance of minipool %s...",
+		failed:    "Could not distribute the ETH balance of minipool %s",
+		succeeded: "Successfully distributed the ETH balance of minipool %s.",
+	}, func(minipool common.Address) (common.Hash, error) {
+		response, err := rp.DistributeBalance(minipool)
+		return response.TxHash, err
+	})
+	if err != nil {
+		return err
 	}
 
 	// Return
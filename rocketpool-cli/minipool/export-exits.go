@@ -0,0 +1,105 @@
+package minipool
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+// Config
+const (
+	exitPassphraseMinLength int = 12
+)
+
+func exportExits(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the output folder
+	outputDir := c.String("output-dir")
+	if outputDir == "" {
+		outputDir = cliutils.Prompt("Please enter the folder to save the exit files to (ideally a removable drive or a folder that gets copied off of this machine):", "^.+$", "Please enter a folder.")
+	}
+	outputDir, err = filepath.Abs(outputDir)
+	if err != nil {
+		return fmt.Errorf("error getting the path of the output folder: %w", err)
+	}
+
+	// Show a warning message
+	fmt.Printf("%sNOTE:\n", colorYellow)
+	fmt.Println("This will create a signed voluntary exit for each of your staking minipools. Anyone who has one of these files can exit its minipool at any time, and exits cannot be undone.")
+	fmt.Println("The files stay valid through future network upgrades, so treat them like your validator keys: store them somewhere safe and off of this machine.")
+	fmt.Printf("We strongly recommend encrypting them with a passphrase.%s\n\n", colorReset)
+
+	// Get the passphrase
+	passphrase := ""
+	if !c.Bool("no-encryption") {
+		passphrase = promptExitPassphrase()
+	}
+
+	// Sign the exits
+	response, err := rp.ExportMinipoolExits()
+	if err != nil {
+		return err
+	}
+	for _, address := range response.Skipped {
+		fmt.Printf("Skipping minipool %s because it isn't staking or its validator can no longer be exited.\n", address.Hex())
+	}
+	if len(response.Exits) == 0 {
+		fmt.Println("No minipools have exits that can be exported.")
+		return nil
+	}
+
+	// Write the exit files
+	if err := os.MkdirAll(outputDir, 0700); err != nil {
+		return fmt.Errorf("error creating output folder %s: %w", outputDir, err)
+	}
+	for _, exit := range response.Exits {
+		signedExit := validator.NewSignedVoluntaryExit(exit.ValidatorIndex, exit.Epoch, exit.Signature)
+		fileBytes, err := validator.CreateExitFile(signedExit, exit.Pubkey, passphrase)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(outputDir, fmt.Sprintf("exit-%s-%s.json", exit.Address.Hex(), exit.ValidatorIndex))
+		if err := os.WriteFile(path, fileBytes, 0600); err != nil {
+			return fmt.Errorf("error saving exit for minipool %s: %w", exit.Address.Hex(), err)
+		}
+		fmt.Printf("Saved the exit for minipool %s (validator %s) to %s.\n", exit.Address.Hex(), exit.ValidatorIndex, path)
+	}
+
+	fmt.Println()
+	fmt.Printf("Exported %d exit(s). You can submit one with `rocketpool minipool broadcast-exit --file <path>`, even from a machine without a node wallet.\n", len(response.Exits))
+	if passphrase != "" {
+		fmt.Println("You will need the passphrase to use them; without it they cannot be recovered.")
+	}
+	return nil
+
+}
+
+// Prompt for a passphrase to encrypt exit files with
+func promptExitPassphrase() string {
+	for {
+		passphrase := cliutils.PromptPassword(
+			"Please enter a passphrase to encrypt the exit files with:",
+			fmt.Sprintf("^.{%d,}$", exitPassphraseMinLength),
+			fmt.Sprintf("Your passphrase must be at least %d characters long. Please try again:", exitPassphraseMinLength),
+		)
+		confirmation := cliutils.PromptPassword("Please confirm your passphrase:", "^.*$", "")
+		if passphrase == confirmation {
+			return passphrase
+		}
+		fmt.Println("Passphrase confirmation does not match.")
+		fmt.Println("")
+	}
+}
@@ -0,0 +1,95 @@
+package minipool
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/performance"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+)
+
+// The most missed slots to list for each minipool
+const maxListedMissedSlots = 10
+
+func getPerformance(c *cli.Context) error {
+
+	// Get the number of epochs to show
+	epochs := c.Uint64("epochs")
+	if epochs == 0 {
+		return errors.New("Please specify a positive number of epochs.")
+	}
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the minipool performance
+	response, err := rp.MinipoolPerformance(epochs)
+	if err != nil {
+		return err
+	}
+	if !response.IsTracked {
+		fmt.Println("The node daemon hasn't tracked the performance of your minipools yet. It starts once your validators are active; please check again in a few epochs.")
+		return nil
+	}
+	if len(response.Minipools) == 0 {
+		fmt.Printf("Your minipools didn't have any duties between epochs %d and %d.\n", response.StartEpoch, response.LatestEpoch)
+		return nil
+	}
+
+	// Print the performance of each minipool
+	fmt.Printf("Performance of your minipools between epochs %d and %d (%d epochs):\n\n", response.StartEpoch, response.LatestEpoch, response.LatestEpoch-response.StartEpoch+1)
+	for _, minipool := range response.Minipools {
+		summary := performance.Summarize(minipool.Epochs, response.StartEpoch)
+
+		fmt.Printf("--------------------\n\n")
+		fmt.Printf("Minipool:            %s\n", minipool.Address.Hex())
+		fmt.Printf("Validator index:     %s\n", minipool.ValidatorIndex)
+
+		// Attestations
+		hitRateColor := colorReset
+		if summary.AttestationHitRate() < 0.95 {
+			hitRateColor = colorRed
+		} else if summary.AttestationHitRate() < 0.99 {
+			hitRateColor = colorYellow
+		}
+		fmt.Printf("Attestations:        %s%d of %d included (%.2f%%)%s\n", hitRateColor, summary.AttestationsIncluded, summary.AttestationDuties, summary.AttestationHitRate()*100, colorReset)
+		if len(summary.MissedAttestationSlots) > 0 {
+			fmt.Printf("Missed slots:        %s\n", formatSlots(summary.MissedAttestationSlots))
+		}
+
+		// Proposals
+		fmt.Printf("Proposals:           %d\n", summary.Proposals)
+		if summary.MissedProposals > 0 {
+			fmt.Printf("%sMissed proposals:    %d (%s)%s\n", colorRed, summary.MissedProposals, formatSlots(summary.MissedProposalSlots), colorReset)
+		}
+
+		// Sync committees
+		if summary.SyncDuties > 0 {
+			fmt.Printf("Sync committee:      %d of %d slots (%.2f%%)\n", summary.SyncParticipation, summary.SyncDuties, summary.SyncParticipationRate()*100)
+		}
+		fmt.Println()
+	}
+
+	return nil
+
+}
+
+// Get a readable list of slots, truncated if there are too many
+func formatSlots(slots []uint64) string {
+	slotStrings := []string{}
+	for i, slot := range slots {
+		if i == maxListedMissedSlots {
+			slotStrings = append(slotStrings, fmt.Sprintf("and %d more", len(slots)-maxListedMissedSlots))
+			break
+		}
+		slotStrings = append(slotStrings, fmt.Sprint(slot))
+	}
+	return strings.Join(slotStrings, ", ")
+}
@@ -45,7 +45,7 @@ func promoteMinipools(c *cli.Context) error {
 
 	// Get selected minipools
 	var selectedMinipools []api.MinipoolDetails
-	if c.String("minipool") == "" {
+	if getMinipoolSelection(c) == "" {
 
 		// Prompt for minipool selection
 		options := make([]string, len(promotableMinipools)+1)
@@ -65,10 +65,10 @@ func promoteMinipools(c *cli.Context) error {
 	} else {
 
 		// Get matching minipools
-		if c.String("minipool") == "all" {
+		if getMinipoolSelection(c) == "all" {
 			selectedMinipools = promotableMinipools
 		} else {
-			selectedAddress := common.HexToAddress(c.String("minipool"))
+			selectedAddress := common.HexToAddress(getMinipoolSelection(c))
 			for _, minipool := range promotableMinipools {
 				if bytes.Equal(minipool.Address.Bytes(), selectedAddress.Bytes()) {
 					selectedMinipools = []api.MinipoolDetails{minipool}
@@ -113,20 +113,20 @@ func promoteMinipools(c *cli.Context) error {
 	}
 
 	// Promote minipools
-	for _, minipool := range selectedMinipools {
-		response, err := rp.PromoteMinipool(minipool.Address)
-		if err != nil {
-			fmt.Printf("Could not promote minipool %s: %s.\n", minipool.Address.Hex(), err)
-			continue
-		}
-
-		fmt.Printf("Promoting minipool %s...\n", minipool.Address.Hex())
-		cliutils.PrintTransactionHash(rp, response.TxHash)
-		if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-			fmt.Printf("Could not promote minipool %s: %s.\n", minipool.Address.Hex(), err)
-		} else {
-			fmt.Printf("Successfully promoted minipool %s.\n", minipool.Address.Hex())
-		}
+	addresses := make([]common.Address, len(selectedMinipools))
+	for mi, minipool := range selectedMinipools {
+		addresses[mi] = minipool.Address
+	}
+	err = sendMinipoolTransactions(c, rp, addresses, minipoolTransactionMessages{
+		sending:   "Promoting minipool %s...",
+		failed:    "Could not promote minipool %s",
+		succeeded: "Successfully promoted minipool %s.",
+	}, func(minipool common.Address) (common.Hash, error) {
+		response, err := rp.PromoteMinipool(minipool)
+		return response.TxHash, err
+	})
+	if err != nil {
+		return err
 	}
 
 	// Return
@@ -259,7 +259,7 @@ func reduceBondAmount(c *cli.Context) error {
 
 	// Get selected minipools
 	var selectedMinipools []api.MinipoolDetails
-	if c.String("minipool") == "" {
+	if getMinipoolSelection(c) == "" {
 
 		// Prompt for minipool selection
 		options := make([]string, len(reduceableMinipools)+1)
@@ -279,10 +279,10 @@ func reduceBondAmount(c *cli.Context) error {
 	} else {
 
 		// Get matching minipools
-		if c.String("minipool") == "all" {
+		if getMinipoolSelection(c) == "all" {
 			selectedMinipools = reduceableMinipools
 		} else {
-			selectedAddress := common.HexToAddress(c.String("minipool"))
+			selectedAddress := common.HexToAddress(getMinipoolSelection(c))
 			for _, minipool := range reduceableMinipools {
 				if bytes.Equal(minipool.Address.Bytes(), selectedAddress.Bytes()) {
 					selectedMinipools = []api.MinipoolDetails{minipool}
@@ -329,21 +329,21 @@ func reduceBondAmount(c *cli.Context) error {
 		return nil
 	}
 
-	// Begin bond reduction
-	for _, minipool := range selectedMinipools {
-		response, err := rp.ReduceBondAmount(minipool.Address)
-		if err != nil {
-			fmt.Printf("Could not reduce bond for minipool %s: %s.\n", minipool.Address.Hex(), err.Error())
-			continue
-		}
-
-		fmt.Printf("Reducing bond for minipool %s...\n", minipool.Address.Hex())
-		cliutils.PrintTransactionHash(rp, response.TxHash)
-		if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-			fmt.Printf("Could not reduce bond for minipool %s: %s.\n", minipool.Address.Hex(), err.Error())
-		} else {
-			fmt.Printf("Successfully reduced bond for minipool %s.\n", minipool.Address.Hex())
-		}
+	// Reduce bonds
+	addresses := make([]common.Address, len(selectedMinipools))
+	for mi, minipool := range selectedMinipools {
+		addresses[mi] = minipool.Address
+	}
+	err = sendMinipoolTransactions(c, rp, addresses, minipoolTransactionMessages{
+		sending:   "Reducing bond for minipool %s...",
+		failed:    "Could not reduce bond for minipool %s",
+		succeeded: "Successfully reduced bond for minipool %s.",
+	}, func(minipool common.Address) (common.Hash, error) {
+		response, err := rp.ReduceBondAmount(minipool)
+		return response.TxHash, err
+	})
+	if err != nil {
+		return err
 	}
 
 	// Return
@@ -12,6 +12,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/output"
 	"github.com/rocket-pool/smartnode/shared/utils/hex"
 	"github.com/rocket-pool/smartnode/shared/utils/math"
 )
@@ -63,6 +64,8 @@ func getStatus(c *cli.Context) error {
 
 	}
 
+	output.SetData(getStatusData(status, c.Bool("include-finalized"), len(finalisedMinipools)))
+
 	// Return if there aren't any minipools
 	if len(status.Minipools) == 0 {
 		fmt.Println("The node does not have any minipools yet.")
@@ -213,3 +216,46 @@ func printMinipoolDetails(minipool api.MinipoolDetails, latestDelegate common.Ad
 	fmt.Printf("\n")
 
 }
+
+// Get the node's minipools for the JSON output
+func getStatusData(status api.MinipoolStatusResponse, includeFinalized bool, finalizedCount int) api.MinipoolStatusData {
+	minipools := []api.MinipoolStatus{}
+	for _, minipool := range status.Minipools {
+		if minipool.Finalised && !includeFinalized {
+			continue
+		}
+		minipools = append(minipools, api.MinipoolStatus{
+			Address:              minipool.Address,
+			ValidatorPubkey:      minipool.ValidatorPubkey,
+			Status:               minipool.Status.Status.String(),
+			StatusTime:           minipool.Status.StatusTime,
+			Finalized:            minipool.Finalised,
+			Penalties:            minipool.Penalties,
+			NodeFee:              minipool.Node.Fee,
+			NodeDepositBalance:   minipool.Node.DepositBalance,
+			UserDepositAssigned:  minipool.User.DepositAssigned,
+			UserDepositBalance:   minipool.User.DepositBalance,
+			QueuePosition:        minipool.Queue.Position,
+			Balance:              minipool.Balances.ETH,
+			NodeShareOfBalance:   minipool.NodeShareOfETHBalance,
+			RefundBalance:        minipool.Node.RefundBalance,
+			ValidatorIndex:       minipool.Validator.Index,
+			ValidatorSeen:        minipool.Validator.Exists,
+			ValidatorActive:      minipool.Validator.Active,
+			ValidatorBalance:     minipool.Validator.Balance,
+			ValidatorNodeBalance: minipool.Validator.NodeBalance,
+			RefundAvailable:      minipool.RefundAvailable,
+			WithdrawalAvailable:  minipool.WithdrawalAvailable,
+			CloseAvailable:       minipool.CloseAvailable,
+			UseLatestDelegate:    minipool.UseLatestDelegate,
+			Delegate:             minipool.Delegate,
+			PreviousDelegate:     minipool.PreviousDelegate,
+			EffectiveDelegate:    minipool.EffectiveDelegate,
+		})
+	}
+	return api.MinipoolStatusData{
+		Minipools:      minipools,
+		FinalizedCount: finalizedCount,
+		LatestDelegate: status.LatestDelegate,
+	}
+}
@@ -1,4 +1,127 @@
 package minipool
 
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
 // Config
 const TimeFormat = "2006-01-02, 15:04 -0700 MST"
+
+// Messages printed for each minipool's transaction; each one takes the minipool's address
+type minipoolTransactionMessages struct {
+	sending   string
+	failed    string
+	succeeded string
+}
+
+// Validate the --minipool, --all and --batch-size flags
+func validateMinipoolBatchFlags(c *cli.Context) error {
+	if c.String("minipool") != "" && c.String("minipool") != "all" {
+		if c.Bool("all") {
+			return errors.New("--minipool and --all can't be used together")
+		}
+		if _, err := cliutils.ValidateAddress("minipool address", c.String("minipool")); err != nil {
+			return err
+		}
+	}
+	if c.Uint("batch-size") == 0 {
+		return errors.New("--batch-size must be at least 1")
+	}
+	return nil
+}
+
+// Get the minipool selection: a minipool address, "all", or blank to prompt for one
+func getMinipoolSelection(c *cli.Context) string {
+	if c.Bool("all") {
+		return "all"
+	}
+	return c.String("minipool")
+}
+
+// Send a transaction to each minipool, sending up to --batch-size of them before waiting for any to be mined.
+// A minipool whose transaction can't be sent is skipped, since the failed call doesn't use up a nonce; with a custom nonce,
+// the ones already sent are waited for and the rest of the minipools are skipped instead.
+// Minipools only accept these calls from the node account, so they can't be bundled into one call through a multicall contract;
+// instead each transaction is submitted before the next one is built, so the Execution client's pending nonce gives them sequential nonces.
+func sendMinipoolTransactions(c *cli.Context, rp *rocketpool.Client, minipools []common.Address, messages minipoolTransactionMessages, send func(common.Address) (common.Hash, error)) error {
+
+	// Transactions exported for offline signing aren't sent, so the pending nonce can't move on to the next one
+	batchSize := int(c.Uint("batch-size"))
+	if rp.IsUnsignedTxMode() {
+		batchSize = 1
+	}
+
+	// Every transaction uses the gas settings that were picked for the whole operation
+	maxFee, maxPrioFee, gasLimit := rp.GetGasSettings()
+	customNonce := c.GlobalString("nonce") != ""
+	if customNonce && len(minipools) > 1 {
+		cliutils.PrintMultiTransactionNonceWarning()
+	}
+
+	for start := 0; start < len(minipools); start += batchSize {
+		end := start + batchSize
+		if end > len(minipools) {
+			end = len(minipools)
+		}
+
+		// Send the batch
+		sentMinipools := []common.Address{}
+		hashes := []common.Hash{}
+		sendFailed := false
+		for _, minipool := range minipools[start:end] {
+			fmt.Printf(messages.sending+"\n", minipool.Hex())
+			rp.AssignGasSettings(maxFee, maxPrioFee, gasLimit)
+			hash, err := send(minipool)
+			if err != nil {
+				fmt.Printf(messages.failed+": %s.\n", minipool.Hex(), err.Error())
+
+				// The custom nonce was picked for this transaction, so don't let another minipool's transaction take it
+				if customNonce {
+					sendFailed = true
+					break
+				}
+				continue
+			}
+			cliutils.PrintTransactionHash(rp, hash)
+			sentMinipools = append(sentMinipools, minipool)
+			hashes = append(hashes, hash)
+
+			// If a custom nonce is set, increment it for the next transaction
+			if customNonce {
+				rp.IncrementCustomNonce()
+			}
+		}
+
+		// Wait for all of it to be mined
+		for i, minipool := range sentMinipools {
+			_, err := rp.WaitForTransaction(hashes[i])
+			if errors.Is(err, rocketpool.ErrTransactionNotSent) {
+				return err
+			}
+			if err != nil {
+				fmt.Printf(messages.failed+": %s.\n", minipool.Hex(), err.Error())
+			} else {
+				fmt.Printf(messages.succeeded+"\n", minipool.Hex())
+			}
+		}
+
+		// Don't send anything else once a transaction with a custom nonce has failed, so the user can look into it first
+		if sendFailed {
+			remaining := len(minipools) - start - len(sentMinipools) - 1
+			if remaining > 0 {
+				return fmt.Errorf("stopped before processing the remaining %d minipool(s) because a transaction couldn't be sent", remaining)
+			}
+			return errors.New("a transaction couldn't be sent")
+		}
+	}
+
+	return nil
+
+}
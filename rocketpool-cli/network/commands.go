@@ -118,6 +118,30 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "verify-rewards-tree",
+				Aliases:   []string{"v"},
+				Usage:     "Regenerate the rewards tree for the provided interval and compare it against the canonical one, node by node and minipool by minipool.\nThis runs in the foreground and can take a long time to complete.",
+				UsageText: "rocketpool network verify-rewards-tree [options]",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "index",
+						Usage: "The index of the rewards interval you want to verify",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return verifyRewardsTree(c)
+
+				},
+			},
+
 			{
 				Name:      "dao-proposals",
 				Aliases:   []string{"d"},
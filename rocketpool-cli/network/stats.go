@@ -6,6 +6,8 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/output"
 )
 
 const (
@@ -31,6 +33,28 @@ func getStats(c *cli.Context) error {
 		response.StakingMinipoolCount +
 		response.WithdrawableMinipoolCount +
 		response.DissolvedMinipoolCount
+	output.SetData(api.NetworkStatsData{
+		TotalValueLocked:          response.TotalValueLocked,
+		DepositPoolBalance:        response.DepositPoolBalance,
+		MinipoolCapacity:          response.MinipoolCapacity,
+		StakerUtilization:         response.StakerUtilization,
+		NodeFee:                   response.NodeFee,
+		NodeCount:                 response.NodeCount,
+		ActiveMinipoolCount:       activeMinipools,
+		InitializedMinipoolCount:  response.InitializedMinipoolCount,
+		PrelaunchMinipoolCount:    response.PrelaunchMinipoolCount,
+		StakingMinipoolCount:      response.StakingMinipoolCount,
+		WithdrawableMinipoolCount: response.WithdrawableMinipoolCount,
+		DissolvedMinipoolCount:    response.DissolvedMinipoolCount,
+		FinalizedMinipoolCount:    response.FinalizedMinipoolCount,
+		SmoothingPoolAddress:      response.SmoothingPoolAddress,
+		SmoothingPoolNodes:        response.SmoothingPoolNodes,
+		SmoothingPoolBalance:      response.SmoothingPoolBalance,
+		RethPrice:                 response.RethPrice,
+		RplPrice:                  response.RplPrice,
+		TotalRplStaked:            response.TotalRplStaked,
+		EffectiveRplStaked:        response.EffectiveRplStaked,
+	})
 
 	// Print & return
 	fmt.Printf("%s========== General Stats ==========%s\n", colorGreen, colorReset)
@@ -0,0 +1,153 @@
+package network
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/urfave/cli"
+)
+
+func verifyRewardsTree(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get config
+	cfg, _, err := rp.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("Error loading configuration: %w", err)
+	}
+
+	// Print archive node info
+	archiveEcUrl := cfg.Smartnode.ArchiveECUrl.Value.(string)
+	if archiveEcUrl == "" {
+		fmt.Printf("%sNOTE: in order to regenerate a Merkle rewards tree for a past rewards interval, you will likely need to have access to an Execution client with archival state.\nBy default, your Smartnode's Execution client will not provide this.\n\nPlease specify the URL of an archive-capable EC in the Smartnode section of the `rocketpool service config` Terminal UI.%s\n\n", colorYellow, colorReset)
+	} else {
+		fmt.Printf("%sYou have an archive EC specified at [%s]. This will be used for tree generation if your primary EC doesn't have the required state.%s\n\n", colorGreen, archiveEcUrl, colorReset)
+	}
+
+	// Get the index
+	var index uint64
+	if c.IsSet("index") {
+		index = c.Uint64("index")
+	} else {
+		indexString := cliutils.Prompt("Which interval would you like to verify the Merkle rewards tree for?", "^\\d+$", "Invalid interval. Please provide a number.")
+		index, err = strconv.ParseUint(indexString, 0, 64)
+		if err != nil {
+			return fmt.Errorf("'%s' is not a valid interval: %w.\n", indexString, err)
+		}
+	}
+
+	// Check if generation will work
+	canResponse, err := rp.CanGenerateRewardsTree(index)
+	if err != nil {
+		return err
+	}
+	if canResponse.CurrentIndex <= index {
+		return fmt.Errorf("The current active rewards period is interval %d. You cannot verify the tree for interval %d until the active interval is past it.", canResponse.CurrentIndex, index)
+	}
+
+	// Run the verification
+	fmt.Printf("Regenerating the rewards tree for interval %d and comparing it against the canonical one. This may take a long time...\n\n", index)
+	response, err := rp.VerifyRewardsTree(index)
+	if err != nil {
+		return err
+	}
+	comparison := response.Comparison
+
+	// Print the results
+	fmt.Printf("Canonical CID:          %s\n", response.CanonicalCID)
+	fmt.Printf("Canonical Merkle root:  %s\n", comparison.CanonicalMerkleRoot)
+	fmt.Printf("Generated Merkle root:  %s\n\n", comparison.GeneratedMerkleRoot)
+
+	if len(comparison.NodeDifferences) > 0 {
+		fmt.Printf("%d node(s) had different rewards:\n\n", len(comparison.NodeDifferences))
+		for _, diff := range comparison.NodeDifferences {
+			fmt.Printf("%s\n", diff.Address.Hex())
+			if diff.MissingFromCanonical {
+				fmt.Println("\tOnly present in the generated tree")
+			}
+			if diff.MissingFromGenerated {
+				fmt.Println("\tOnly present in the canonical tree")
+			}
+			if diff.CanonicalRewardNetwork != diff.GeneratedRewardNetwork {
+				fmt.Printf("\tReward network:     canonical %d, generated %d\n", diff.CanonicalRewardNetwork, diff.GeneratedRewardNetwork)
+			}
+			printAmountDifference("Collateral RPL:    ", "RPL", diff.CanonicalCollateralRpl, diff.GeneratedCollateralRpl)
+			printAmountDifference("Oracle DAO RPL:    ", "RPL", diff.CanonicalOracleDaoRpl, diff.GeneratedOracleDaoRpl)
+			printAmountDifference("Smoothing Pool ETH:", "ETH", diff.CanonicalSmoothingPoolEth, diff.GeneratedSmoothingPoolEth)
+			fmt.Println()
+		}
+	}
+
+	if len(comparison.MinipoolDifferences) > 0 {
+		fmt.Printf("%d minipool(s) had different performance:\n\n", len(comparison.MinipoolDifferences))
+		for _, diff := range comparison.MinipoolDifferences {
+			fmt.Printf("%s\n", diff.Address.Hex())
+			if diff.MissingFromCanonical {
+				fmt.Println("\tOnly present in the generated performance file")
+			}
+			if diff.MissingFromGenerated {
+				fmt.Println("\tOnly present in the canonical performance file")
+			}
+			if diff.CanonicalSuccessfulCount != diff.GeneratedSuccessfulCount {
+				fmt.Printf("\tSuccessful attestations: canonical %d, generated %d\n", diff.CanonicalSuccessfulCount, diff.GeneratedSuccessfulCount)
+			}
+			if diff.CanonicalMissedCount != diff.GeneratedMissedCount {
+				fmt.Printf("\tMissed attestations:     canonical %d, generated %d\n", diff.CanonicalMissedCount, diff.GeneratedMissedCount)
+			}
+			if len(diff.MissedOnlyInCanonicalSlots) > 0 {
+				fmt.Printf("\tSlots only missed in the canonical file: %v\n", diff.MissedOnlyInCanonicalSlots)
+			}
+			if len(diff.MissedOnlyInGeneratedSlots) > 0 {
+				fmt.Printf("\tSlots only missed in the generated file: %v\n", diff.MissedOnlyInGeneratedSlots)
+			}
+			if !rewards.BigIntsEqual(diff.CanonicalEthEarned, diff.GeneratedEthEarned) {
+				fmt.Printf("\tETH earned:              canonical %.6f, generated %.6f\n", weiToEth(diff.CanonicalEthEarned), weiToEth(diff.GeneratedEthEarned))
+			}
+			fmt.Println()
+		}
+	} else if !comparison.PerformanceCompared {
+		fmt.Printf("%sThe canonical tree for this interval doesn't have a minipool performance file, so attestation performance was not compared.%s\n\n", colorYellow, colorReset)
+	}
+
+	if comparison.IsMatch() {
+		fmt.Printf("%sThe regenerated tree for interval %d matches the canonical one.%s\n", colorGreen, index, colorReset)
+	} else {
+		fmt.Printf("%sThe regenerated tree for interval %d does NOT match the canonical one.%s\n", colorRed, index, colorReset)
+	}
+	return nil
+
+}
+
+// Print a node reward amount if it differs between the canonical and generated trees
+func printAmountDifference(label string, token string, canonical *rewards.QuotedBigInt, generated *rewards.QuotedBigInt) {
+	var canonicalAmount, generatedAmount *big.Int
+	if canonical != nil {
+		canonicalAmount = &canonical.Int
+	}
+	if generated != nil {
+		generatedAmount = &generated.Int
+	}
+	if rewards.BigIntsEqual(canonicalAmount, generatedAmount) {
+		return
+	}
+	fmt.Printf("\t%s canonical %.6f %s, generated %.6f %s\n", label, weiToEth(canonicalAmount), token, weiToEth(generatedAmount), token)
+}
+
+// Convert an amount to ETH, treating nil as zero
+func weiToEth(amount *big.Int) float64 {
+	if amount == nil {
+		return 0
+	}
+	return eth.WeiToEth(amount)
+}
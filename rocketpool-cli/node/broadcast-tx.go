@@ -0,0 +1,50 @@
+package node
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Submit a transaction that was signed on an offline machine with `rocketpool wallet sign-tx`
+func broadcastTransaction(c *cli.Context, input string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Read the transaction from a file, or take it as-is if it isn't one
+	signedTx := input
+	if _, err := os.Stat(input); err == nil {
+		bytes, err := os.ReadFile(input)
+		if err != nil {
+			return fmt.Errorf("error reading transaction file [%s]: %w", input, err)
+		}
+		signedTx = string(bytes)
+	}
+	signedTx = strings.TrimSpace(signedTx)
+
+	// Broadcast it
+	response, err := rp.BroadcastTransaction(signedTx)
+	if err != nil {
+		return err
+	}
+
+	cliutils.PrintTransactionHash(rp, response.TxHash)
+	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Println("The transaction was successfully completed.")
+	return nil
+
+}
@@ -85,7 +85,13 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Name:      "rewards",
 				Aliases:   []string{"e"},
 				Usage:     "Get the time and your expected RPL rewards of the next checkpoint",
-				UsageText: "rocketpool node rewards",
+				UsageText: "rocketpool node rewards [options]",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "estimate-smoothing-pool",
+						Usage: "Estimate your Smoothing Pool ETH and collateral RPL rewards for the current interval so far (this may take a while)",
+					},
+				},
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -94,6 +100,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					}
 
 					// Run
+					if c.Bool("estimate-smoothing-pool") {
+						return estimateSmoothingPoolRewards(c)
+					}
 					return getRewards(c)
 
 				},
@@ -383,6 +392,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "salt, l",
 						Usage: "An optional seed to use when generating the new minipool's address. Use this if you want it to have a custom vanity address.",
 					},
+					cli.BoolFlag{
+						Name:  "skip-liveness-check",
+						Usage: "Create the minipool even if its new validator key attested in the last few epochs. Only use this if you're certain it isn't running in any other Validator Client!",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -442,7 +455,15 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					},
 					cli.BoolFlag{
 						Name:  "no-restart",
-						Usage: "Don't restart the Validator Client after importing the key. Note that the key won't be loaded (and won't attest) until you restart the VC to load it.",
+						Usage: "Don't load the key into the Validator Client (or restart it) after importing the key. Note that the key won't be loaded (and won't attest) until you restart the VC to load it.",
+					},
+					cli.StringFlag{
+						Name:  "slashing-protection",
+						Usage: "The path to an EIP-3076 slashing protection file exported from the Validator Client that was running this validator before",
+					},
+					cli.BoolFlag{
+						Name:  "skip-liveness-check",
+						Usage: "Import the key even if the validator attested in the last few epochs. Only use this if you're certain it isn't running in any other Validator Client!",
 					},
 				},
 				Action: func(c *cli.Context) error {
@@ -511,6 +532,23 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "broadcast-tx",
+				Usage:     "Submit a transaction that was exported with --unsigned-tx and signed on an offline machine with `rocketpool wallet sign-tx`. <transaction> is the signed transaction's file, or its hex string.",
+				UsageText: "rocketpool node broadcast-tx transaction",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return broadcastTransaction(c, c.Args().Get(0))
+
+				},
+			},
+
 			{
 				Name:      "set-voting-delegate",
 				Aliases:   []string{"sv"},
@@ -659,12 +697,24 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Name:      "sign-message",
 				Aliases:   []string{"sm"},
 				Usage:     "Sign an arbitrary message with the node's private key",
-				UsageText: "rocketpool node sign-message [-m message]",
+				UsageText: "rocketpool node sign-message [-m message | -f file] [--siwe]",
 				Flags: []cli.Flag{
 					cli.StringFlag{
 						Name:  "message, m",
 						Usage: "The 'quoted message' to be signed",
 					},
+					cli.StringFlag{
+						Name:  "file, f",
+						Usage: "A file containing the message to be signed",
+					},
+					cli.BoolFlag{
+						Name:  "siwe",
+						Usage: "Treat the message as a Sign-In with Ethereum (EIP-4361) request, and check it's for the node account on the node's network before signing",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm signing a Sign-In with Ethereum request",
+					},
 				},
 				Action: func(c *cli.Context) error {
 					// Run
@@ -672,6 +722,78 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "sign-typed-data",
+				Aliases:   []string{"st"},
+				Usage:     "Sign EIP-712 typed data with the node's private key",
+				UsageText: "rocketpool node sign-typed-data --file data.json [--allow-no-chain-id] [-y]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "file, f",
+						Usage: "A file containing the typed data to sign, in the eth_signTypedData_v4 format",
+					},
+					cli.BoolFlag{
+						Name:  "allow-no-chain-id",
+						Usage: "Sign typed data that doesn't specify a chain ID, so its signature can be used on any network",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm signing the typed data",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return signTypedData(c)
+
+				},
+			},
+
+			{
+				Name:      "verify-signature",
+				Aliases:   []string{"vs"},
+				Usage:     "Check that a message or EIP-712 typed data was signed by an address",
+				UsageText: "rocketpool node verify-signature --address address --signature signature (--message message | --message-file file | --typed-data-file file)",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "address, a",
+						Usage: "The address that should have made the signature",
+					},
+					cli.StringFlag{
+						Name:  "signature, s",
+						Usage: "The hex-encoded signature",
+					},
+					cli.StringFlag{
+						Name:  "message, m",
+						Usage: "The 'quoted message' that was signed",
+					},
+					cli.StringFlag{
+						Name:  "message-file",
+						Usage: "A file containing the message that was signed (including Sign-In with Ethereum messages)",
+					},
+					cli.StringFlag{
+						Name:  "typed-data-file",
+						Usage: "A file containing the EIP-712 typed data that was signed",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return verifySignature(c)
+
+				},
+			},
+
 			{
 				Name:      "send-message",
 				Usage:     "Send a zero-ETH transaction to the target address (or ENS) with the provided hex-encoded message as the data payload",
@@ -698,6 +820,59 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 
 				},
 			},
+
+			{
+				Name:  "tx",
+				Usage: "Manage the node's pending transactions",
+				Subcommands: []cli.Command{
+
+					{
+						Name:      "list",
+						Aliases:   []string{"l"},
+						Usage:     "List the node's transactions that haven't been mined yet",
+						UsageText: "rocketpool node tx list",
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run
+							return getPendingTransactions(c)
+
+						},
+					},
+
+					{
+						Name:      "cancel",
+						Aliases:   []string{"c"},
+						Usage:     "Cancel a pending transaction by replacing it with an empty transfer to the node address",
+						UsageText: "rocketpool node tx cancel [-y] nonce",
+						Flags: []cli.Flag{
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm the cancellation",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+							nonce, err := cliutils.ValidateUint("nonce", c.Args().Get(0))
+							if err != nil {
+								return err
+							}
+
+							// Run
+							return cancelTransaction(c, nonce)
+
+						},
+					},
+				},
+			},
 		},
 	})
 }
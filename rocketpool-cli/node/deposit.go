@@ -178,6 +178,12 @@ func nodeDeposit(c *cli.Context) error {
 		}
 		return nil
 	}
+	if canDeposit.ValidatorKeyIsLive && !c.Bool("skip-liveness-check") {
+		fmt.Printf("%sThe validator key for your new minipool attested in epoch %d, so it's already running in another Validator Client.\n", colorRed, canDeposit.LastAttestationEpoch)
+		fmt.Printf("This usually means your node wallet was restored on another machine that's still validating. Creating a minipool with this key would get it SLASHED.%s\n", colorReset)
+		fmt.Println("If you're certain the key isn't running anywhere else, you can skip this check with `--skip-liveness-check`.")
+		return nil
+	}
 
 	useCreditBalance := false
 	fmt.Printf("You currently have %.2f ETH in your credit balance.\n", eth.WeiToEth(canDeposit.CreditBalance))
@@ -247,7 +253,7 @@ func nodeDeposit(c *cli.Context) error {
 	}
 
 	// Make deposit
-	response, err := rp.NodeDeposit(amountWei, minNodeFee, salt, useCreditBalance, true)
+	response, err := rp.NodeDeposit(amountWei, minNodeFee, salt, useCreditBalance, c.Bool("skip-liveness-check"), true)
 	if err != nil {
 		return err
 	}
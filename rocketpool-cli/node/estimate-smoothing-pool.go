@@ -0,0 +1,65 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+func estimateSmoothingPoolRewards(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the estimate
+	fmt.Println("Estimating your rewards for the current interval. This needs the state of every node in the network, so it may take a few minutes...")
+	estimate, err := rp.EstimateSmoothingPoolRewards()
+	if err != nil {
+		return err
+	}
+	fmt.Println()
+
+	// Print the interval timing
+	elapsed := estimate.EstimateTime.Sub(estimate.IntervalStartTime)
+	duration := estimate.IntervalEndTime.Sub(estimate.IntervalStartTime)
+	fmt.Printf("=== Interval %d (Ruleset v%d) ===\n", estimate.Index, estimate.RulesetVersion)
+	fmt.Printf("The interval started on %s and is expected to end on %s.\n", cliutils.GetDateTimeString(uint64(estimate.IntervalStartTime.Unix())), cliutils.GetDateTimeString(uint64(estimate.IntervalEndTime.Unix())))
+	fmt.Printf("This estimate uses the network state as of %s (%.1f%% of the way through the interval).\n", cliutils.GetDateTimeString(uint64(estimate.EstimateTime.Unix())), elapsed.Hours()/duration.Hours()*100)
+
+	// Print the Smoothing Pool estimate
+	fmt.Println("\n=== Smoothing Pool ===")
+	fmt.Printf("The Smoothing Pool currently holds %.6f ETH.\n", eth.WeiToEth(estimate.SmoothingPoolBalance))
+	fmt.Printf("Of that, an estimated %.6f ETH would go to node operators and %.6f ETH to the pool stakers.\n", eth.WeiToEth(estimate.NodeOperatorSmoothingPoolEth), eth.WeiToEth(estimate.PoolStakerSmoothingPoolEth))
+	fmt.Printf("Your node's estimated share is %s%.6f ETH%s.\n", colorGreen, eth.WeiToEth(estimate.NodeSmoothingPoolEth), colorReset)
+	if !estimate.IsOptedIn {
+		fmt.Printf("%sYour node is not currently opted into the Smoothing Pool, so it won't earn any more Smoothing Pool rewards this interval.%s\n", colorYellow, colorReset)
+	}
+
+	// Print the RPL estimate
+	fmt.Println("\n=== RPL ===")
+	fmt.Printf("Your node's estimated collateral rewards for this interval are %s%.6f RPL%s (out of %.6f RPL for all node operators).\n", colorGreen, eth.WeiToEth(estimate.NodeCollateralRpl), colorReset, eth.WeiToEth(estimate.TotalCollateralRpl))
+
+	// Print the assumptions
+	fmt.Println("\n=== Assumptions ===")
+	fmt.Println("This is only an estimate; the actual rewards are calculated by the Oracle DAO at the end of the interval. It assumes that:")
+	fmt.Println("- The interval ended right now. The Smoothing Pool balance will keep growing until the interval actually ends, so your ETH share will grow with it.")
+	if estimate.UsedRollingRecord {
+		fmt.Printf("- Attestation performance matches the local rolling record, which covers duties up to slot %d.\n", estimate.RollingRecordSlot)
+	} else {
+		fmt.Println("- Every minipool that's currently opted into the Smoothing Pool attested perfectly for the whole interval, regardless of when it joined.")
+	}
+	fmt.Println("- Every node's Smoothing Pool opt-in status, bond and commission stay as they are now.")
+	fmt.Println("- The RPL price and every node's effective RPL stake stay as they are now until the end of the interval.")
+
+	// Return
+	return nil
+
+}
@@ -8,7 +8,9 @@ import (
 
 	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/output"
 )
 
 func getRewards(c *cli.Context) error {
@@ -27,6 +29,7 @@ func getRewards(c *cli.Context) error {
 	}
 
 	if !rewardsInfoResponse.Registered {
+		output.SetData(api.NodeRewardsData{Registered: false})
 		fmt.Printf("This node is not currently registered.\n")
 		return nil
 	}
@@ -85,6 +88,8 @@ func getRewards(c *cli.Context) error {
 		return err
 	}
 
+	output.SetData(getRewardsData(rewards))
+
 	fmt.Printf("%sNOTE: Legacy rewards from pre-Redstone are temporarily not being included in the below figures. They will be added back in a future release. We apologize for the inconvenience!%s\n\n", colorYellow, colorReset)
 
 	fmt.Println("=== ETH ===")
@@ -131,3 +136,34 @@ func getRewards(c *cli.Context) error {
 	return nil
 
 }
+
+// Get the node's rewards for the JSON output
+func getRewardsData(rewards api.NodeRewardsResponse) api.NodeRewardsData {
+	data := api.NodeRewardsData{
+		Registered:                 rewards.Registered,
+		IntervalStart:              rewards.LastCheckpoint,
+		IntervalEnd:                rewards.LastCheckpoint.Add(rewards.RewardsInterval),
+		BeaconRewards:              rewards.BeaconRewards,
+		ClaimedSmoothingPoolEth:    rewards.CumulativeEthRewards,
+		UnclaimedSmoothingPoolEth:  rewards.UnclaimedEthRewards,
+		TotalRplStake:              rewards.TotalRplStake,
+		EstimatedRplRewards:        rewards.EstimatedRewards,
+		ClaimedRplRewards:          rewards.CumulativeRplRewards,
+		UnclaimedRplRewards:        rewards.UnclaimedRplRewards,
+		Trusted:                    rewards.Trusted,
+		TrustedRplBond:             rewards.TrustedRplBond,
+		EstimatedTrustedRplRewards: rewards.EstimatedTrustedRplRewards,
+		ClaimedTrustedRplRewards:   rewards.CumulativeTrustedRplRewards,
+		UnclaimedTrustedRplRewards: rewards.UnclaimedTrustedRplRewards,
+	}
+
+	// Assume 365 days in a year, 24 hours per day; JSON can't hold the NaN a zero stake would give
+	intervalHours := rewards.RewardsInterval.Hours()
+	if rewards.TotalRplStake > 0 && intervalHours > 0 {
+		data.EstimatedRplApr = rewards.EstimatedRewards / rewards.TotalRplStake / intervalHours * (24 * 365) * 100
+	}
+	if rewards.TrustedRplBond > 0 && intervalHours > 0 {
+		data.EstimatedTrustedRplApr = rewards.EstimatedTrustedRplRewards / rewards.TrustedRplBond / intervalHours * (24 * 365) * 100
+	}
+	return data
+}
@@ -2,13 +2,18 @@ package node
 
 import (
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/goccy/go-json"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/signing"
 )
 
 const signatureVersion = 1
@@ -38,13 +43,38 @@ func signMessage(c *cli.Context) error {
 	}
 
 	message := c.String("message")
+	if c.String("file") != "" {
+		bytes, err := os.ReadFile(c.String("file"))
+		if err != nil {
+			return fmt.Errorf("error reading message file: %w", err)
+		}
+		message = string(bytes)
+	}
 	for message == "" {
 		message = cliutils.Prompt("Please enter the message you want to sign: (EIP-191 personal_sign)", "^.+$", "Please enter the message you want to sign: (EIP-191 personal_sign)")
 	}
 
-	response, err := rp.SignMessage(message)
-	if err != nil {
-		return err
+	var response api.NodeSignResponse
+	if c.Bool("siwe") {
+		// Show what the node is signing in to before signing
+		siwe, err := signing.ParseSiweMessage(message)
+		if err != nil {
+			return fmt.Errorf("invalid Sign-In with Ethereum message: %w", err)
+		}
+		printSiweMessage(siwe)
+		if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Do you want to sign in to %s with your node account?", siwe.Domain))) {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+		response, err = rp.SignSiweMessage(message)
+		if err != nil {
+			return err
+		}
+	} else {
+		response, err = rp.SignMessage(message)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Print the signature
@@ -64,3 +94,30 @@ func signMessage(c *cli.Context) error {
 	return nil
 
 }
+
+// Print the details of a Sign-In with Ethereum message
+func printSiweMessage(siwe *signing.SiweMessage) {
+	fmt.Println("Sign-In with Ethereum request:")
+	fmt.Printf("\tSite:       %s\n", siwe.Domain)
+	fmt.Printf("\tAccount:    %s\n", siwe.Address.Hex())
+	if siwe.Statement != "" {
+		fmt.Printf("\tStatement:  %s\n", siwe.Statement)
+	}
+	fmt.Printf("\tURI:        %s\n", siwe.Uri)
+	fmt.Printf("\tChain ID:   %s\n", siwe.ChainID.String())
+	fmt.Printf("\tNonce:      %s\n", siwe.Nonce)
+	fmt.Printf("\tIssued at:  %s\n", siwe.IssuedAt.Format(time.RFC1123))
+	if siwe.ExpirationTime != nil {
+		fmt.Printf("\tExpires at: %s\n", siwe.ExpirationTime.Format(time.RFC1123))
+	}
+	if siwe.NotBefore != nil {
+		fmt.Printf("\tNot before: %s\n", siwe.NotBefore.Format(time.RFC1123))
+	}
+	if siwe.RequestID != "" {
+		fmt.Printf("\tRequest ID: %s\n", siwe.RequestID)
+	}
+	if len(siwe.Resources) > 0 {
+		fmt.Printf("\tResources:  %s\n", strings.Join(siwe.Resources, ", "))
+	}
+	fmt.Println()
+}
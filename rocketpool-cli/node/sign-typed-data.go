@@ -0,0 +1,109 @@
+package node
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/goccy/go-json"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/signing"
+)
+
+type TypedDataSignature struct {
+	Address     common.Address `json:"address"`
+	PrimaryType string         `json:"primaryType"`
+	Hash        string         `json:"hash"`
+	Signature   string         `json:"sig"`
+	Version     string         `json:"version"`
+}
+
+func signTypedData(c *cli.Context) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Read the typed data
+	if c.String("file") == "" {
+		return fmt.Errorf("Please specify the file with the typed data to sign with --file.")
+	}
+	data, err := os.ReadFile(c.String("file"))
+	if err != nil {
+		return fmt.Errorf("error reading typed data file: %w", err)
+	}
+	typedData, err := signing.ParseTypedData(data)
+	if err != nil {
+		return err
+	}
+	hash, err := signing.GetTypedDataHash(typedData)
+	if err != nil {
+		return err
+	}
+
+	// Show what's being signed
+	rendering, err := signing.FormatTypedData(typedData)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Typed data to sign (EIP-712):\n\n%s\n", rendering)
+	allowNoChainID := c.Bool("allow-no-chain-id")
+	if signing.GetTypedDataChainID(typedData) == nil && !allowNoChainID {
+		fmt.Printf("%sWARNING: this data does not specify a chain ID, so the signature could be used on any network.%s\n\n", colorYellow, colorReset)
+		if c.Bool("yes") {
+			return fmt.Errorf("The typed data does not specify a chain ID. Use --allow-no-chain-id to sign it anyway.")
+		}
+		if !cliutils.Confirm("Do you want to allow signing data that can be used on any network?") {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+		allowNoChainID = true
+	}
+	if !(c.Bool("yes") || cliutils.Confirm("Do you want to sign this data with your node account?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Sign it; the daemon checks the chain ID against the node's network
+	compactData, err := json.Marshal(typedData)
+	if err != nil {
+		return fmt.Errorf("error serializing typed data: %w", err)
+	}
+	response, err := rp.SignTypedData(string(compactData), allowNoChainID)
+	if err != nil {
+		return err
+	}
+
+	// Print the signature
+	formattedSignature := TypedDataSignature{
+		Address:     status.AccountAddress,
+		PrimaryType: typedData.PrimaryType,
+		Hash:        hexutil.Encode(hash),
+		Signature:   response.SignedData,
+		Version:     fmt.Sprint(signatureVersion),
+	}
+	bytes, err := json.MarshalIndent(formattedSignature, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Signed Typed Data:\n\n%s\n", string(bytes))
+
+	return nil
+
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/output"
 	"github.com/rocket-pool/smartnode/shared/utils/math"
 )
 
@@ -46,6 +48,7 @@ func getStatus(c *cli.Context) error {
 	if err != nil {
 		return fmt.Errorf("Error loading configuration: %w", err)
 	}
+	output.SetData(getStatusData(status))
 
 	// Account address & balances
 	fmt.Printf("%s=== Account and Balances ===%s\n", colorGreen, colorReset)
@@ -293,3 +296,70 @@ func getStatus(c *cli.Context) error {
 	return nil
 
 }
+
+// Get the node's status for the JSON output
+func getStatusData(status api.NodeStatusResponse) api.NodeStatusData {
+	remainingBorrowableEth := big.NewInt(0)
+	if status.Registered {
+		remainingBorrowableEth.Sub(status.EthMatchedLimit, status.EthMatched)
+		remainingBorrowableEth.Sub(remainingBorrowableEth, status.PendingMatchAmount)
+		if remainingBorrowableEth.Sign() < 0 {
+			remainingBorrowableEth.SetUint64(0)
+		}
+	}
+
+	penalizedMinipools := []api.MinipoolPenalty{}
+	for mp, count := range status.PenalizedMinipools {
+		penalizedMinipools = append(penalizedMinipools, api.MinipoolPenalty{
+			Address:   mp,
+			Penalties: count,
+		})
+	}
+	sort.Slice(penalizedMinipools, func(i, j int) bool {
+		return penalizedMinipools[i].Address.Hex() < penalizedMinipools[j].Address.Hex()
+	})
+
+	return api.NodeStatusData{
+		AccountAddress:              status.AccountAddress,
+		EthBalance:                  status.AccountBalances.ETH,
+		RplBalance:                  status.AccountBalances.RPL,
+		FixedSupplyRplBalance:       status.AccountBalances.FixedSupplyRPL,
+		CreditBalance:               status.CreditBalance,
+		Registered:                  status.Registered,
+		Trusted:                     status.Trusted,
+		TimezoneLocation:            status.TimezoneLocation,
+		WithdrawalAddress:           status.WithdrawalAddress,
+		PendingWithdrawalAddress:    status.PendingWithdrawalAddress,
+		WithdrawalEthBalance:        status.WithdrawalBalances.ETH,
+		WithdrawalRplBalance:        status.WithdrawalBalances.RPL,
+		VotingDelegate:              status.VotingDelegate,
+		RplStake:                    status.RplStake,
+		EffectiveRplStake:           status.EffectiveRplStake,
+		MinimumRplStake:             status.MinimumRplStake,
+		MaximumRplStake:             status.MaximumRplStake,
+		BorrowedCollateralRatio:     status.BorrowedCollateralRatio,
+		BondedCollateralRatio:       status.BondedCollateralRatio,
+		EligibleRplStake:            status.PendingEffectiveRplStake,
+		RemainingBorrowableEth:      remainingBorrowableEth,
+		InSmoothingPool:             status.FeeRecipientInfo.IsInSmoothingPool,
+		SmoothingPoolOptOutCooldown: status.FeeRecipientInfo.IsInOptOutCooldown,
+		SmoothingPoolOptOutEpoch:    status.FeeRecipientInfo.OptOutEpoch,
+		SmoothingPoolAddress:        status.FeeRecipientInfo.SmoothingPoolAddress,
+		FeeDistributorAddress:       status.FeeRecipientInfo.FeeDistributorAddress,
+		FeeDistributorBalance:       status.FeeDistributorBalance,
+		FeeDistributorInitialized:   status.IsFeeDistributorInitialized,
+		Minipools: api.NodeMinipoolCounts{
+			Total:               status.MinipoolCounts.Total,
+			Initialized:         status.MinipoolCounts.Initialized,
+			Prelaunch:           status.MinipoolCounts.Prelaunch,
+			Staking:             status.MinipoolCounts.Staking,
+			Withdrawable:        status.MinipoolCounts.Withdrawable,
+			Dissolved:           status.MinipoolCounts.Dissolved,
+			Finalized:           status.MinipoolCounts.Finalised,
+			RefundAvailable:     status.MinipoolCounts.RefundAvailable,
+			WithdrawalAvailable: status.MinipoolCounts.WithdrawalAvailable,
+			CloseAvailable:      status.MinipoolCounts.CloseAvailable,
+		},
+		PenalizedMinipools: penalizedMinipools,
+	}
+}
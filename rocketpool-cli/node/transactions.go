@@ -0,0 +1,99 @@
+package node
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+func getPendingTransactions(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the pending transactions
+	response, err := rp.GetPendingTransactions()
+	if err != nil {
+		return err
+	}
+
+	// Print them
+	if len(response.Transactions) == 0 {
+		fmt.Println("The Smartnode isn't tracking any pending transactions for your node.")
+	}
+	for _, tx := range response.Transactions {
+		fmt.Printf("%s--- Nonce %d ---%s\n", colorGreen, tx.Nonce, colorReset)
+		fmt.Printf("Hash:           %s\n", tx.Hash.Hex())
+		if tx.IsCancellation {
+			fmt.Printf("To:             %s (cancellation)\n", tx.To.Hex())
+		} else if tx.To != nil {
+			fmt.Printf("To:             %s\n", tx.To.Hex())
+		}
+		fmt.Printf("Value:          %.6f ETH\n", eth.WeiToEth(tx.Value))
+		fmt.Printf("Max fee:        %.2f gwei (%.2f gwei priority fee)\n", eth.WeiToGwei(tx.MaxFee), eth.WeiToGwei(tx.MaxPriorityFee))
+		fmt.Printf("First sent:     %s (%s ago)\n", tx.FirstSent.Format(time.RFC822), time.Since(tx.FirstSent).Round(time.Second))
+		if tx.Replacements > 0 {
+			fmt.Printf("Replaced:       %d time(s), most recently %s\n", tx.Replacements, tx.LastSent.Format(time.RFC822))
+		}
+		fmt.Println()
+	}
+
+	// Point out transactions the Smartnode didn't send
+	untracked := int64(response.PendingNonce) - int64(response.LatestNonce) - int64(len(response.Transactions))
+	if untracked > 0 {
+		fmt.Printf("%sYour Execution client has %d other pending transaction(s) for your node that the Smartnode didn't send (nonces %d to %d). You can still cancel them by nonce.%s\n", colorYellow, untracked, response.LatestNonce, response.PendingNonce-1, colorReset)
+	}
+	fmt.Printf("Next nonce: %d\n", response.PendingNonce)
+	return nil
+
+}
+
+func cancelTransaction(c *cli.Context, nonce uint64) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check the transaction can be cancelled
+	canCancel, err := rp.CanCancelTransaction(nonce)
+	if err != nil {
+		return err
+	}
+
+	// Prompt for confirmation
+	maxCost := eth.WeiToEth(canCancel.MaxFee) * float64(canCancel.GasLimit)
+	fmt.Printf("The transaction with nonce %d will be replaced with an empty transfer to your node address, using a max fee of %.2f gwei and a priority fee of %.2f gwei (costing at most %.6f ETH).\n", nonce, eth.WeiToGwei(canCancel.MaxFee), eth.WeiToGwei(canCancel.MaxPriorityFee), maxCost)
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to cancel the transaction with nonce %d?", nonce))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Cancel it
+	response, err := rp.CancelTransaction(nonce)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Cancelling the transaction with nonce %d...\n", nonce)
+	cliutils.PrintTransactionHash(rp, response.TxHash)
+	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Printf("The cancellation was mined; the transaction with nonce %d will not be executed.\n", nonce)
+	return nil
+
+}
@@ -0,0 +1,70 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/signing"
+)
+
+func verifySignature(c *cli.Context) error {
+
+	// Get the signature and the expected signer
+	signature, err := cliutils.ValidateByteArray("signature", c.String("signature"))
+	if err != nil {
+		return err
+	}
+	address, err := cliutils.ValidateAddress("address", c.String("address"))
+	if err != nil {
+		return err
+	}
+
+	// Recover the signer from the signed data; this doesn't need the node wallet or any clients
+	var signer common.Address
+	switch {
+	case c.String("typed-data-file") != "":
+		data, err := os.ReadFile(c.String("typed-data-file"))
+		if err != nil {
+			return fmt.Errorf("error reading typed data file: %w", err)
+		}
+		typedData, err := signing.ParseTypedData(data)
+		if err != nil {
+			return err
+		}
+		signer, err = signing.RecoverTypedDataSigner(typedData, signature)
+		if err != nil {
+			return err
+		}
+
+	case c.String("message-file") != "" || c.String("message") != "":
+		message := c.String("message")
+		if c.String("message-file") != "" {
+			bytes, err := os.ReadFile(c.String("message-file"))
+			if err != nil {
+				return fmt.Errorf("error reading message file: %w", err)
+			}
+			message = string(bytes)
+		}
+		signer, err = signing.RecoverMessageSigner(message, signature)
+		if err != nil {
+			return err
+		}
+
+	default:
+		return errors.New("Please specify the signed data with --message, --message-file, or --typed-data-file.")
+	}
+
+	// Print the result
+	if signer == address {
+		fmt.Printf("%sThe signature is valid: it was made by %s.%s\n", colorGreen, address.Hex(), colorReset)
+		return nil
+	}
+	fmt.Printf("%sThe signature is NOT valid for %s; it was made by %s.%s\n", colorRed, address.Hex(), signer.Hex(), colorReset)
+	return nil
+
+}
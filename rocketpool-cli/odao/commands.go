@@ -50,6 +50,23 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "penalties",
+				Usage:     "List the illegal fee recipients detected by the watchtower",
+				UsageText: "rocketpool odao penalties",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getPenalties(c)
+
+				},
+			},
+
 			{
 				Name:      "member-settings",
 				Aliases:   []string{"b"},
@@ -0,0 +1,84 @@
+package odao
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/math"
+)
+
+func getPenalties(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the detected violations
+	response, err := rp.TNDAOPenalties()
+	if err != nil {
+		return err
+	}
+
+	// Print & return
+	if len(response.Violations) == 0 {
+		fmt.Println("The watchtower has not detected any illegal fee recipients.")
+		return nil
+	}
+	fmt.Printf("The watchtower has detected %d illegal fee recipient(s):\n", len(response.Violations))
+	fmt.Println("")
+	for _, violation := range response.Violations {
+		fmt.Printf("--------------------\n")
+		fmt.Printf("\n")
+		fmt.Printf("Violation:            %s\n", getViolationDescription(violation.Type))
+		fmt.Printf("Slot:                 %d\n", violation.Slot)
+		fmt.Printf("Execution block:      %d\n", violation.ExecutionBlockNumber)
+		fmt.Printf("Validator index:      %s\n", violation.ProposerIndex)
+		fmt.Printf("Minipool:             %s\n", violation.MinipoolAddress.Hex())
+		fmt.Printf("Node:                 %s\n", violation.NodeAddress.Hex())
+		fmt.Printf("Expected recipient:   %s\n", violation.ExpectedRecipient.Hex())
+		fmt.Printf("Fee recipient:        %s\n", violation.FeeRecipient.Hex())
+		if violation.HasMevPayment {
+			fmt.Printf("MEV recipient:        %s\n", violation.MevRecipient.Hex())
+			fmt.Printf("MEV amount:           %.6f ETH\n", math.RoundDown(eth.WeiToEth(violation.MevAmount), 6))
+		}
+		fmt.Printf("Detected at:          %s\n", cliutils.GetDateTimeString(uint64(violation.DetectedTime.Unix())))
+		if violation.PenaltySubmitted {
+			if violation.PenaltyTxHash != (common.Hash{}) {
+				fmt.Printf("Penalty:              submitted in %s\n", violation.PenaltyTxHash.Hex())
+			} else {
+				fmt.Printf("Penalty:              already applied\n")
+			}
+		} else {
+			fmt.Printf("Penalty:              not submitted\n")
+		}
+		fmt.Printf("\n")
+	}
+	if !response.SubmitPenalties {
+		fmt.Println("NOTE: penalty submission is disabled, so these violations have only been recorded. You can enable it in the Smartnode section of `rocketpool service config`.")
+	}
+	return nil
+
+}
+
+// Get a description of a violation type
+func getViolationDescription(violationType api.PenaltyViolationType) string {
+	switch violationType {
+	case api.PenaltyViolationType_SmoothingPoolTheft:
+		return "fees sent away from the Smoothing Pool while opted in"
+	case api.PenaltyViolationType_LateOptOut:
+		return "opted out of the Smoothing Pool too late to keep this block"
+	case api.PenaltyViolationType_IllegalFeeRecipient:
+		return "fees sent away from the node's fee distributor"
+	default:
+		return string(violationType)
+	}
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/output"
 )
 
 func filterProposalState(state string, stateFilter string) bool {
@@ -68,6 +70,9 @@ func getProposals(c *cli.Context, stateFilter string) error {
 	proposalStateInputs := []string{"pending", "active", "succeeded", "executed", "cancelled", "defeated", "expired"}
 
 	// Print & return
+	data := api.TNDAOProposalsData{
+		Proposals: []api.TNDAOProposal{},
+	}
 	count := 0
 	for i, stateName := range proposalStates {
 		proposals, ok := stateProposals[stateName]
@@ -86,17 +91,37 @@ func getProposals(c *cli.Context, stateFilter string) error {
 
 		// Proposals
 		for _, proposal := range proposals {
+			proposerID := ""
 			for _, member := range allMembers.Members {
 				if bytes.Equal(proposal.ProposerAddress.Bytes(), member.Address.Bytes()) {
+					proposerID = member.ID
 					fmt.Printf("%d: %s - Proposed by: %s (%s)\n", proposal.ID, proposal.Message, member.ID, proposal.ProposerAddress)
 				}
 			}
+			data.Proposals = append(data.Proposals, api.TNDAOProposal{
+				ID:              proposal.ID,
+				Message:         proposal.Message,
+				ProposerAddress: proposal.ProposerAddress,
+				ProposerID:      proposerID,
+				State:           stateName,
+				CreatedTime:     proposal.CreatedTime,
+				StartTime:       proposal.StartTime,
+				EndTime:         proposal.EndTime,
+				ExpiryTime:      proposal.ExpiryTime,
+				VotesRequired:   proposal.VotesRequired,
+				VotesFor:        proposal.VotesFor,
+				VotesAgainst:    proposal.VotesAgainst,
+				MemberVoted:     proposal.MemberVoted,
+				MemberSupported: proposal.MemberSupported,
+				Payload:         proposal.PayloadStr,
+			})
 		}
 
 		count += len(proposals)
 
 		fmt.Println()
 	}
+	output.SetData(data)
 	if count == 0 {
 		fmt.Println("There are no matching oracle DAO proposals.")
 	}
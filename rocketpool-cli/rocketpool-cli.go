@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
@@ -20,6 +21,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/output"
 	"github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
@@ -98,10 +100,44 @@ ______           _        _    ______           _
 			Name:  "nonce",
 			Usage: "Use this flag to explicitly specify the nonce that this transaction should use, so it can override an existing 'stuck' transaction",
 		},
+		cli.BoolFlag{
+			Name:  "simulate",
+			Usage: "Preview what each transaction will do (whether it reverts, the ETH balance changes and the events it emits) before you're asked to confirm it",
+		},
+		cli.BoolFlag{
+			Name:  "unsigned-tx",
+			Usage: "Build each transaction without signing or sending it, and save it to the current directory so it can be signed on an offline machine with 'rocketpool wallet sign-tx'",
+		},
+		cli.StringFlag{
+			Name:  "unsigned-tx-format",
+			Usage: "The `format` to save unsigned transactions in: 'json', or 'rlp' for a hex string of the transaction's unsigned payload that fits in a QR code",
+			Value: rocketpool.UnsignedTxFormat_Json,
+		},
 		cli.BoolFlag{
 			Name:  "debug",
 			Usage: "Enable debug printing of API commands",
 		},
+		cli.BoolFlag{
+			Name:  "use-api-server",
+			Usage: "Send API calls to the node daemon's API server over its unix socket instead of starting a new API process for each one",
+		},
+		cli.StringFlag{
+			Name:  "api-url",
+			Usage: "Send API calls to the node daemon's API server at this HTTPS `url` (e.g. https://192.168.1.10:8280), for nodes running on another machine",
+		},
+		cli.StringFlag{
+			Name:  "api-token",
+			Usage: "The `path` of the API server's bearer token; defaults to the one in your data folder",
+		},
+		cli.StringFlag{
+			Name:  "api-cert",
+			Usage: "The `path` of the API server's TLS certificate, which the connection is pinned to when using --api-url; defaults to the one in your data folder",
+		},
+		cli.StringFlag{
+			Name:  "output",
+			Usage: "The `format` to print command results in: 'text' for people, or 'json' for scripts. JSON goes to stdout and everything else goes to stderr.",
+			Value: output.Format_Text,
+		},
 		cli.BoolFlag{
 			Name: "secure-session, s",
 			Usage: "Some commands may print sensitive information to your terminal. " +
@@ -153,13 +189,25 @@ ______           _        _    ______           _
 	queue.RegisterCommands(app, "queue", []string{"q"})
 	service.RegisterCommands(app, "service", []string{"s"})
 	wallet.RegisterCommands(app, "wallet", []string{"w"})
+	output.RegisterCommandNames(app.Commands, "")
 
 	app.Before = func(c *cli.Context) error {
+		// Print JSON to stdout and send everything else to stderr; this comes first so the checks below are reported in the JSON output
+		switch format := c.GlobalString("output"); format {
+		case output.Format_Text:
+		case output.Format_Json:
+			output.EnableJson(os.Stdout)
+			os.Stdout = os.Stderr
+		default:
+			fmt.Fprintf(os.Stderr, "Invalid output format: %s\n", format)
+			os.Exit(1)
+		}
+
 		// Check user ID
 		if os.Getuid() == 0 && !c.GlobalBool("allow-root") {
 			fmt.Fprintln(os.Stderr, "rocketpool should not be run as root. Please try again without 'sudo'.")
 			fmt.Fprintln(os.Stderr, "If you want to run rocketpool as root anyway, use the '--allow-root' option to override this warning.")
-			os.Exit(1)
+			output.Exit(1, errors.New("rocketpool should not be run as root"))
 		}
 
 		// If set, validate custom nonce
@@ -168,21 +216,37 @@ ______           _        _    ______           _
 			nonce, ok := big.NewInt(0).SetString(customNonce, 0)
 			if !ok {
 				fmt.Fprintf(os.Stderr, "Invalid nonce: %s\n", customNonce)
-				os.Exit(1)
+				output.Exit(1, fmt.Errorf("Invalid nonce: %s", customNonce))
 			}
 
 			// Save the parsed value on Metadata so we don't need to reparse it later
 			c.App.Metadata["nonce"] = nonce
 		}
 
+		// Validate the unsigned transaction format
+		switch format := c.GlobalString("unsigned-tx-format"); format {
+		case rocketpool.UnsignedTxFormat_Json, rocketpool.UnsignedTxFormat_Rlp:
+		default:
+			fmt.Fprintf(os.Stderr, "Invalid unsigned transaction format: %s\n", format)
+			output.Exit(1, fmt.Errorf("Invalid unsigned transaction format: %s", format))
+		}
+
 		return nil
 	}
 
 	// Run application
 	fmt.Println("")
-	if err := app.Run(os.Args); err != nil {
+	err = app.Run(os.Args)
+	if errors.Is(err, rocketpool.ErrTransactionNotSent) {
+		// Commands stop at the first transaction they would wait for when it's exported for offline signing
+		err = nil
+	}
+	if err != nil {
 		cliutils.PrettyPrintError(err)
 	}
+	if err := output.Print(err); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
 	fmt.Println("")
 
 }
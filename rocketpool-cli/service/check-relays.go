@@ -0,0 +1,78 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+)
+
+// Print which MEV-boost relays are reachable and whether the node's validators are registered with them
+func checkRelays(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check the relays
+	response, err := rp.CheckRelays()
+	if err != nil {
+		return err
+	}
+	if !response.MevBoostEnabled {
+		fmt.Println("MEV-Boost is not enabled, so there are no relays to check.")
+		return nil
+	}
+	if response.IsExternal {
+		fmt.Println("MEV-Boost is managed externally, so the Smartnode doesn't know which relays it uses. Please check them with your MEV-Boost client instead.")
+		return nil
+	}
+	if len(response.Relays) == 0 {
+		fmt.Printf("%sMEV-Boost is enabled but no relays are selected. Please select some relays with `rocketpool service config`.%s\n", colorYellow, colorReset)
+		return nil
+	}
+
+	fmt.Printf("Checking %d relay(s) for %d validator(s) with the fee recipient %s.\n\n", len(response.Relays), response.ValidatorCount, response.ExpectedFeeRecipient.Hex())
+	problems := 0
+	for _, relay := range response.Relays {
+		fmt.Printf("%s=== %s ===%s\n", colorGreen, relay.Name, colorReset)
+		if !relay.IsReachable {
+			problems++
+			fmt.Printf("%sNot reachable: %s%s\n\n", colorRed, relay.Error, colorReset)
+			continue
+		}
+		fmt.Println("Reachable: yes")
+		fmt.Printf("Registered validators: %d / %d\n", relay.RegisteredCount, response.ValidatorCount)
+		if len(relay.UnregisteredPubkeys) > 0 {
+			problems++
+			fmt.Printf("%sNot registered:%s\n", colorYellow, colorReset)
+			for _, pubkey := range relay.UnregisteredPubkeys {
+				fmt.Printf("\t%s\n", pubkey)
+			}
+		}
+		if len(relay.WrongFeeRecipientPubkeys) > 0 {
+			problems++
+			fmt.Printf("%sRegistered with the wrong fee recipient:%s\n", colorRed, colorReset)
+			for _, pubkey := range relay.WrongFeeRecipientPubkeys {
+				fmt.Printf("\t%s\n", pubkey)
+			}
+		}
+		if relay.RegistrationErrorCount > 0 {
+			problems++
+			fmt.Printf("%sCould not check %d validator(s): %s%s\n", colorYellow, relay.RegistrationErrorCount, relay.Error, colorReset)
+		}
+		fmt.Println()
+	}
+
+	if problems == 0 {
+		fmt.Printf("%sAll relays are reachable and all of your validators are registered with them.%s\n", colorGreen, colorReset)
+	} else {
+		fmt.Println("Validators are registered by your Validator Client through MEV-Boost once per epoch, so newly activated validators may take a few minutes to show up. If a relay stays unreachable, consider disabling it with `rocketpool service config`.")
+	}
+	return nil
+
+}
@@ -164,6 +164,68 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					return configureService(c)
 
 				},
+				Subcommands: []cli.Command{
+					{
+						Name:      "export",
+						Aliases:   []string{"e"},
+						Usage:     "Print your configuration as a settings file that can be applied to other nodes with `rocketpool service config apply`",
+						UsageText: "rocketpool service config export [options]",
+						Flags: []cli.Flag{
+							cli.StringFlag{
+								Name:  "format",
+								Usage: "The format of the settings file ('yaml' or 'json')",
+								Value: configFormatYaml,
+							},
+							cli.BoolFlag{
+								Name:  "include-secrets",
+								Usage: "Include settings with secrets, such as passwords, access tokens and URLs that can contain credentials; they're left out by default",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run command
+							return exportConfig(c)
+
+						},
+					},
+
+					{
+						Name:      "apply",
+						Aliases:   []string{"a"},
+						Usage:     "Validate the settings in a YAML or JSON file, show how they change your configuration, and save them. Settings that aren't in the file keep their current values.",
+						UsageText: "rocketpool service config apply --file path [options]",
+						Flags: []cli.Flag{
+							cli.StringFlag{
+								Name:  "file, f",
+								Usage: "The settings file to apply",
+							},
+							cli.BoolFlag{
+								Name:  "dry-run",
+								Usage: "Validate the settings file and show the changes without saving them",
+							},
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm saving the changes",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run command
+							return applyConfig(c)
+
+						},
+					},
+				},
 			},
 
 			{
@@ -184,6 +246,40 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "task-status",
+				Usage:     "View the run history of the node and watchtower daemon tasks",
+				UsageText: "rocketpool service task-status",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run command
+					return taskStatus(c)
+
+				},
+			},
+
+			{
+				Name:      "check-relays",
+				Usage:     "Check which of the enabled MEV-boost relays are reachable and whether your validators are registered with them",
+				UsageText: "rocketpool service check-relays",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run command
+					return checkRelays(c)
+
+				},
+			},
+
 			{
 				Name:      "start",
 				Aliases:   []string{"s"},
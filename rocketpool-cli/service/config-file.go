@@ -0,0 +1,197 @@
+package service
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/goccy/go-json"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Config file formats
+const (
+	configFormatYaml string = "yaml"
+	configFormatJson string = "json"
+)
+
+// Print the current configuration as a settings file that can be applied to other nodes
+func exportConfig(c *cli.Context) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Load the config
+	cfg, isNew, err := rp.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("error loading user settings: %w", err)
+	}
+	if isNew {
+		return fmt.Errorf("the Smartnode has not been configured yet; please run `rocketpool service config` first")
+	}
+
+	// Serialize it
+	settings, redactedCount := cfg.ExportSettings(c.Bool("include-secrets"))
+	var bytes []byte
+	switch format := c.String("format"); format {
+	case configFormatYaml:
+		bytes, err = yaml.Marshal(settings)
+	case configFormatJson:
+		bytes, err = json.MarshalIndent(settings, "", "  ")
+	default:
+		return fmt.Errorf("unknown format [%s]; must be '%s' or '%s'", format, configFormatYaml, configFormatJson)
+	}
+	if err != nil {
+		return fmt.Errorf("error serializing configuration: %w", err)
+	}
+
+	fmt.Println(string(bytes))
+	if redactedCount > 0 {
+		fmt.Fprintf(os.Stderr, "%sLeft out %d setting(s) with secrets such as passwords, access tokens and private URLs; nodes that apply this file keep their own values for them. Use --include-secrets to export them too.%s\n", colorYellow, redactedCount, colorReset)
+	}
+	return nil
+
+}
+
+// Apply the settings in a YAML or JSON file to the current configuration, after validating them and showing the changes
+func applyConfig(c *cli.Context) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Read the settings file; JSON is valid YAML, so one parser handles both formats
+	path := c.String("file")
+	if path == "" {
+		return fmt.Errorf("please provide the settings file to apply with --file")
+	}
+	fileBytes, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading settings file [%s]: %w", path, err)
+	}
+	var settings map[string]map[string]string
+	if err := yaml.Unmarshal(fileBytes, &settings); err != nil {
+		return fmt.Errorf("error parsing settings file [%s]: %w", path, err)
+	}
+
+	// Load the current config, upgrading it first if this is the first run after an update
+	oldCfg, isNew, err := rp.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("error loading user settings: %w", err)
+	}
+	isUpdate, err := rp.IsFirstRun()
+	if err != nil {
+		return fmt.Errorf("error checking for first-run status: %w", err)
+	}
+	baseCfg := oldCfg
+	if isUpdate {
+		baseCfg = oldCfg.CreateCopy()
+		err = baseCfg.UpdateDefaults()
+		if err != nil {
+			return fmt.Errorf("error upgrading configuration with the latest parameters: %w", err)
+		}
+	}
+
+	// Apply the settings on top of it
+	newCfg, err := baseCfg.CreateCopyWithSettings(settings)
+	if err != nil {
+		return fmt.Errorf("error applying settings file [%s]: %w", path, err)
+	}
+
+	// Validate the result
+	errors := newCfg.ValidateParameters()
+	errors = append(errors, newCfg.Validate()...)
+	if len(errors) > 0 {
+		fmt.Printf("%sThe new configuration is invalid:%s\n", colorRed, colorReset)
+		for _, err := range errors {
+			fmt.Printf("\t%s\n", err)
+		}
+		return fmt.Errorf("the settings file was not applied")
+	}
+
+	// Print the changes
+	changedSettings, affectedContainers, changeNetworks := newCfg.GetChanges(oldCfg)
+	if changeNetworks {
+		return fmt.Errorf("the settings file changes the network from %v to %v; please use `rocketpool service config` to change networks, since it has to remove your chain data, node wallet and validator keys", oldCfg.Smartnode.Network.Value, newCfg.Smartnode.Network.Value)
+	}
+	if printConfigChanges(changedSettings) == 0 && !isNew && !isUpdate {
+		fmt.Println("The settings file doesn't change anything; your configuration is already up to date.")
+		return nil
+	}
+	printAffectedContainers(newCfg, affectedContainers)
+
+	// Save the config
+	if c.Bool("dry-run") {
+		fmt.Println("This was a dry run, so your configuration has not been changed.")
+		return nil
+	}
+	if !(c.Bool("yes") || cliutils.Confirm("Would you like to save these changes?")) {
+		fmt.Println("Your changes have not been saved. Your Smartnode configuration is the same as it was before.")
+		return nil
+	}
+	err = rp.SaveConfig(newCfg)
+	if err != nil {
+		return fmt.Errorf("error saving config: %w", err)
+	}
+	fmt.Println("Your changes have been saved!")
+	if newCfg.IsNativeMode {
+		fmt.Println("Please restart your daemon service for them to take effect.")
+	} else if len(affectedContainers) > 0 || isNew || isUpdate {
+		fmt.Println("Please run `rocketpool service start` when you are ready to apply them.")
+	}
+	return nil
+
+}
+
+// Print the changed settings in each section of the config, returning how many there are
+func printConfigChanges(changedSettings map[string][]cfgtypes.ChangedSetting) int {
+
+	sectionNames := []string{}
+	for sectionName := range changedSettings {
+		sectionNames = append(sectionNames, sectionName)
+	}
+	sort.Strings(sectionNames)
+
+	changeCount := 0
+	for _, sectionName := range sectionNames {
+		settingList := changedSettings[sectionName]
+		if len(settingList) == 0 {
+			continue
+		}
+		fmt.Printf("%s=== %s ===%s\n", colorGreen, sectionName, colorReset)
+		for _, setting := range settingList {
+			fmt.Printf("\t%s: %s%s%s => %s%s%s\n", setting.Name, colorRed, setting.OldValue, colorReset, colorGreen, setting.NewValue, colorReset)
+		}
+		fmt.Println()
+		changeCount += len(settingList)
+	}
+	return changeCount
+
+}
+
+// Print the containers that have to be restarted for the changes to take effect
+func printAffectedContainers(cfg *config.RocketPoolConfig, affectedContainers map[cfgtypes.ContainerID]bool) {
+
+	if len(affectedContainers) == 0 || cfg.IsNativeMode {
+		return
+	}
+	containers := []string{}
+	for container := range affectedContainers {
+		containers = append(containers, fmt.Sprintf("%s_%s", cfg.Smartnode.ProjectName.Value, container))
+	}
+	sort.Strings(containers)
+
+	fmt.Println("The following containers must be restarted for these changes to take effect:")
+	for _, container := range containers {
+		fmt.Printf("\t%s\n", container)
+	}
+	fmt.Println()
+
+}
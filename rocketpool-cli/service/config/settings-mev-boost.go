@@ -86,6 +86,7 @@ func (configPage *MevBoostConfigPage) createContent() {
 	configPage.selectionModeBox = createParameterizedDropDown(&configPage.masterConfig.MevBoost.SelectionMode, configPage.layout.descriptionBox)
 
 	localParams := []*cfgtypes.Parameter{
+		&configPage.masterConfig.MevBoost.CustomRelays,
 		&configPage.masterConfig.MevBoost.Port,
 		&configPage.masterConfig.MevBoost.OpenRpcPort,
 		&configPage.masterConfig.MevBoost.ContainerTag,
@@ -20,8 +20,10 @@ import (
 	"github.com/rocket-pool/smartnode/shared"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/output"
 	"github.com/rocket-pool/smartnode/shared/utils/sys"
 	"github.com/shirou/gopsutil/v3/disk"
 )
@@ -188,9 +190,73 @@ func serviceStatus(c *cli.Context) error {
 		return err
 	}
 
-	// Print service status
-	return rp.PrintServiceStatus(getComposeFiles(c))
+	cfg, _, err := rp.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("Error loading configuration: %w", err)
+	}
+	statusData := api.ServiceStatusData{
+		Network: string(cfg.Smartnode.Network.Value.(cfgtypes.Network)),
+	}
+
+	// Print service status, or read it for the JSON output
+	if output.IsJson() {
+		statusData.Containers, err = rp.GetServiceStatus(getComposeFiles(c))
+	} else {
+		err = rp.PrintServiceStatus(getComposeFiles(c))
+	}
+	if err != nil {
+		return err
+	}
+
+	// Print the health of the client pools
+	clientStatus, err := rp.GetClientStatus()
+	if err != nil {
+		output.SetData(statusData)
+		fmt.Printf("\n%sCould not get the status of your Execution and Consensus clients: %s%s\n", colorYellow, err.Error(), colorReset)
+		return nil
+	}
+	statusData.ExecutionClients = &clientStatus.EcManagerStatus
+	statusData.ConsensusClients = &clientStatus.BcManagerStatus
+	output.SetData(statusData)
+	fmt.Println()
+	printClientPoolStatus("Execution", clientStatus.EcManagerStatus)
+	printClientPoolStatus("Consensus", clientStatus.BcManagerStatus)
+	return nil
+
+}
 
+// Print the health of each client in a pool
+func printClientPoolStatus(clientType string, status api.ClientManagerStatus) {
+	fmt.Printf("%s%s clients:%s\n", colorBold, clientType, colorReset)
+	for i, endpoint := range status.Endpoints {
+		role := "fallback"
+		if i == 0 {
+			role = "primary"
+		}
+		active := ""
+		if endpoint.IsActive {
+			active = fmt.Sprintf(" %s[active]%s", colorGreen, colorReset)
+		}
+		fmt.Printf("  %s (%s)%s\n", endpoint.Endpoint, role, active)
+
+		switch {
+		case endpoint.Status.IsSynced && endpoint.IsLagging:
+			fmt.Printf("    Status:  %slagging (%d behind the best client)%s\n", colorYellow, endpoint.HeadLag, colorReset)
+		case endpoint.Status.IsSynced:
+			fmt.Printf("    Status:  %ssynced%s\n", colorGreen, colorReset)
+		case endpoint.Status.IsWorking && endpoint.Status.Error == "":
+			fmt.Printf("    Status:  %ssyncing (%.2f%%)%s\n", colorYellow, endpoint.Status.SyncProgress*100, colorReset)
+		default:
+			fmt.Printf("    Status:  %sunavailable (%s)%s\n", colorRed, endpoint.Status.Error, colorReset)
+		}
+		if endpoint.Status.IsWorking {
+			fmt.Printf("    Head:    %d\n", endpoint.Head)
+		}
+		fmt.Printf("    Latency: %.0f ms\n", endpoint.LatencyMs)
+		fmt.Printf("    Errors:  %.1f%%\n", endpoint.ErrorRate*100)
+		fmt.Printf("    Score:   %.1f / 100\n", endpoint.Score)
+	}
+	fmt.Println()
 }
 
 // Configure the service
@@ -256,6 +322,12 @@ func configureService(c *cli.Context) error {
 
 	// Deal with saving the config and printing the changes
 	if md.ShouldSave {
+		// Export the old Validator Client's slashing protection database before switching clients
+		migrateSlashingProtection := false
+		if !isNative && !isNew && !md.ChangeNetworks && isValidatorClientChange(md.PreviousConfig, md.Config) {
+			migrateSlashingProtection = exportSlashingProtectionForSwitch(rp, md.PreviousConfig, md.Config)
+		}
+
 		// Save the config
 		err = rp.SaveConfig(md.Config)
 		if err != nil {
@@ -302,6 +374,11 @@ func configureService(c *cli.Context) error {
 			return startService(c, true)
 		}
 
+		// Import the old Validator Client's slashing protection database into the new one before it starts
+		if migrateSlashingProtection {
+			importSlashingProtectionForSwitch(rp, md.Config)
+		}
+
 		// Query for service start if this is old and there are containers to change
 		if len(md.ContainersToRestart) > 0 {
 			fmt.Println("The following containers must be restarted for the changes to take effect:")
@@ -333,6 +410,73 @@ func configureService(c *cli.Context) error {
 	return err
 }
 
+// Check if the Validator Client is changing to a different client
+func isValidatorClientChange(oldCfg *config.RocketPoolConfig, newCfg *config.RocketPoolConfig) bool {
+	oldClient, _ := oldCfg.GetSelectedConsensusClient()
+	newClient, _ := newCfg.GetSelectedConsensusClient()
+	return oldClient != newClient
+}
+
+// Get the display name of the Validator Client
+func getValidatorClientName(cfg *config.RocketPoolConfig) string {
+	ccCfg, err := cfg.GetSelectedConsensusClientConfig()
+	if err != nil {
+		client, _ := cfg.GetSelectedConsensusClient()
+		return string(client)
+	}
+	return ccCfg.GetName()
+}
+
+// Stops the old Validator Client and exports its slashing protection database so it can be imported into the new one.
+// Returns true if the export succeeded.
+func exportSlashingProtectionForSwitch(rp *rocketpool.Client, oldCfg *config.RocketPoolConfig, newCfg *config.RocketPoolConfig) bool {
+
+	// Validators can only exist if the wallet does
+	status, err := rp.WalletStatus()
+	if err != nil || !status.WalletInitialized {
+		return false
+	}
+
+	oldClient := getValidatorClientName(oldCfg)
+	newClient := getValidatorClientName(newCfg)
+	fmt.Printf("You are switching your Validator Client from %s to %s. Its slashing protection database should be moved to %s so it knows what your validators have already signed.\n", oldClient, newClient, newClient)
+	if !cliutils.Confirm("Would you like the Smartnode to move it for you now? This will stop your current Validator Client.") {
+		fmt.Printf("%sPlease make sure you import your slashing protection history into %s with `rocketpool wallet import-slashing-protection --input <file>` before it starts validating.%s\n\n", colorYellow, newClient, colorReset)
+		return false
+	}
+
+	fmt.Print("Stopping your Validator Client... ")
+	_, err = rp.StopVc()
+	if err != nil {
+		fmt.Println()
+		fmt.Printf("%sCould not stop your Validator Client: %s\nYour slashing protection database has not been moved; please use `rocketpool wallet export-slashing-protection` and `rocketpool wallet import-slashing-protection` to move it manually.%s\n\n", colorRed, err.Error(), colorReset)
+		return false
+	}
+	fmt.Println("done!")
+
+	validatorCount, err := rp.ExportVcSlashingProtection(oldCfg)
+	if err != nil {
+		fmt.Printf("%sCould not export the slashing protection database of %s: %s\nPlease move it manually with your client's own tools before %s starts validating.%s\n\n", colorRed, oldClient, err.Error(), newClient, colorReset)
+		return false
+	}
+	fmt.Printf("Exported the slashing protection history of %d validators from %s.\n\n", validatorCount, oldClient)
+	return true
+
+}
+
+// Imports the slashing protection history saved during a client switch into the new Validator Client
+func importSlashingProtectionForSwitch(rp *rocketpool.Client, newCfg *config.RocketPoolConfig) {
+
+	newClient := getValidatorClientName(newCfg)
+	validatorCount, err := rp.ImportVcSlashingProtection(newCfg)
+	if err != nil {
+		fmt.Printf("%sCould not import your slashing protection history into %s: %s\nPlease run `rocketpool wallet import-slashing-protection` before starting it.%s\n\n", colorRed, newClient, err.Error(), colorReset)
+		return
+	}
+	fmt.Printf("Imported the slashing protection history of %d validators into %s.\n\n", validatorCount, newClient)
+
+}
+
 // Updates a configuration from the provided CLI arguments headlessly
 func configureHeadless(c *cli.Context, cfg *config.RocketPoolConfig) error {
 
@@ -1244,8 +1388,15 @@ func serviceVersion(c *cli.Context) error {
 		return fmt.Errorf("Settings file not found. Please run `rocketpool service config` to set up your Smartnode.")
 	}
 
+	versionData := api.ServiceVersionData{
+		ClientVersion:  c.App.Version,
+		ServiceVersion: serviceVersion,
+		IsNativeMode:   cfg.IsNativeMode,
+	}
+
 	// Handle native mode
 	if cfg.IsNativeMode {
+		output.SetData(versionData)
 		fmt.Printf("Rocket Pool client version: %s\n", c.App.Version)
 		fmt.Printf("Rocket Pool service version: %s\n", serviceVersion)
 		fmt.Println("Configured for Native Mode")
@@ -1255,17 +1406,22 @@ func serviceVersion(c *cli.Context) error {
 	// Get the execution client string
 	var eth1ClientString string
 	eth1ClientMode := cfg.ExecutionClientMode.Value.(cfgtypes.Mode)
+	versionData.ExecutionClientMode = string(eth1ClientMode)
 	switch eth1ClientMode {
 	case cfgtypes.Mode_Local:
 		eth1Client := cfg.ExecutionClient.Value.(cfgtypes.ExecutionClient)
 		format := "%s (Locally managed)\n\tImage: %s"
+		versionData.ExecutionClient = string(eth1Client)
 		switch eth1Client {
 		case cfgtypes.ExecutionClient_Geth:
-			eth1ClientString = fmt.Sprintf(format, "Geth", cfg.Geth.ContainerTag.Value.(string))
+			versionData.ExecutionClientImage = cfg.Geth.ContainerTag.Value.(string)
+			eth1ClientString = fmt.Sprintf(format, "Geth", versionData.ExecutionClientImage)
 		case cfgtypes.ExecutionClient_Nethermind:
-			eth1ClientString = fmt.Sprintf(format, "Nethermind", cfg.Nethermind.ContainerTag.Value.(string))
+			versionData.ExecutionClientImage = cfg.Nethermind.ContainerTag.Value.(string)
+			eth1ClientString = fmt.Sprintf(format, "Nethermind", versionData.ExecutionClientImage)
 		case cfgtypes.ExecutionClient_Besu:
-			eth1ClientString = fmt.Sprintf(format, "Besu", cfg.Besu.ContainerTag.Value.(string))
+			versionData.ExecutionClientImage = cfg.Besu.ContainerTag.Value.(string)
+			eth1ClientString = fmt.Sprintf(format, "Besu", versionData.ExecutionClientImage)
 		default:
 			return fmt.Errorf("unknown local execution client [%v]", eth1Client)
 		}
@@ -1280,21 +1436,30 @@ func serviceVersion(c *cli.Context) error {
 	// Get the consensus client string
 	var eth2ClientString string
 	eth2ClientMode := cfg.ConsensusClientMode.Value.(cfgtypes.Mode)
+	versionData.ConsensusClientMode = string(eth2ClientMode)
 	switch eth2ClientMode {
 	case cfgtypes.Mode_Local:
 		eth2Client := cfg.ConsensusClient.Value.(cfgtypes.ConsensusClient)
 		format := "%s (Locally managed)\n\tImage: %s"
+		versionData.ConsensusClient = string(eth2Client)
 		switch eth2Client {
 		case cfgtypes.ConsensusClient_Lighthouse:
-			eth2ClientString = fmt.Sprintf(format, "Lighthouse", cfg.Lighthouse.ContainerTag.Value.(string))
+			versionData.ConsensusClientImage = cfg.Lighthouse.ContainerTag.Value.(string)
+			eth2ClientString = fmt.Sprintf(format, "Lighthouse", versionData.ConsensusClientImage)
 		case cfgtypes.ConsensusClient_Lodestar:
-			eth2ClientString = fmt.Sprintf(format, "Lodestar", cfg.Lodestar.ContainerTag.Value.(string))
+			versionData.ConsensusClientImage = cfg.Lodestar.ContainerTag.Value.(string)
+			eth2ClientString = fmt.Sprintf(format, "Lodestar", versionData.ConsensusClientImage)
 		case cfgtypes.ConsensusClient_Nimbus:
-			eth2ClientString = fmt.Sprintf(format+"\n\tVC image: %s", "Nimbus", cfg.Nimbus.BnContainerTag.Value.(string), cfg.Nimbus.VcContainerTag.Value.(string))
+			versionData.ConsensusClientImage = cfg.Nimbus.BnContainerTag.Value.(string)
+			versionData.ValidatorClientImage = cfg.Nimbus.VcContainerTag.Value.(string)
+			eth2ClientString = fmt.Sprintf(format+"\n\tVC image: %s", "Nimbus", versionData.ConsensusClientImage, versionData.ValidatorClientImage)
 		case cfgtypes.ConsensusClient_Prysm:
-			eth2ClientString = fmt.Sprintf(format+"\n\tVC image: %s", "Prysm", cfg.Prysm.BnContainerTag.Value.(string), cfg.Prysm.VcContainerTag.Value.(string))
+			versionData.ConsensusClientImage = cfg.Prysm.BnContainerTag.Value.(string)
+			versionData.ValidatorClientImage = cfg.Prysm.VcContainerTag.Value.(string)
+			eth2ClientString = fmt.Sprintf(format+"\n\tVC image: %s", "Prysm", versionData.ConsensusClientImage, versionData.ValidatorClientImage)
 		case cfgtypes.ConsensusClient_Teku:
-			eth2ClientString = fmt.Sprintf(format, "Teku", cfg.Teku.ContainerTag.Value.(string))
+			versionData.ConsensusClientImage = cfg.Teku.ContainerTag.Value.(string)
+			eth2ClientString = fmt.Sprintf(format, "Teku", versionData.ConsensusClientImage)
 		default:
 			return fmt.Errorf("unknown local consensus client [%v]", eth2Client)
 		}
@@ -1302,17 +1467,23 @@ func serviceVersion(c *cli.Context) error {
 	case cfgtypes.Mode_External:
 		eth2Client := cfg.ExternalConsensusClient.Value.(cfgtypes.ConsensusClient)
 		format := "%s (Externally managed)\n\tVC Image: %s"
+		versionData.ConsensusClient = string(eth2Client)
 		switch eth2Client {
 		case cfgtypes.ConsensusClient_Lighthouse:
-			eth2ClientString = fmt.Sprintf(format, "Lighthouse", cfg.ExternalLighthouse.ContainerTag.Value.(string))
+			versionData.ValidatorClientImage = cfg.ExternalLighthouse.ContainerTag.Value.(string)
+			eth2ClientString = fmt.Sprintf(format, "Lighthouse", versionData.ValidatorClientImage)
 		case cfgtypes.ConsensusClient_Lodestar:
-			eth2ClientString = fmt.Sprintf(format, "Lodestar", cfg.ExternalLodestar.ContainerTag.Value.(string))
+			versionData.ValidatorClientImage = cfg.ExternalLodestar.ContainerTag.Value.(string)
+			eth2ClientString = fmt.Sprintf(format, "Lodestar", versionData.ValidatorClientImage)
 		case cfgtypes.ConsensusClient_Nimbus:
-			eth2ClientString = fmt.Sprintf(format, "Nimbus", cfg.ExternalNimbus.ContainerTag.Value.(string))
+			versionData.ValidatorClientImage = cfg.ExternalNimbus.ContainerTag.Value.(string)
+			eth2ClientString = fmt.Sprintf(format, "Nimbus", versionData.ValidatorClientImage)
 		case cfgtypes.ConsensusClient_Prysm:
-			eth2ClientString = fmt.Sprintf(format, "Prysm", cfg.ExternalPrysm.ContainerTag.Value.(string))
+			versionData.ValidatorClientImage = cfg.ExternalPrysm.ContainerTag.Value.(string)
+			eth2ClientString = fmt.Sprintf(format, "Prysm", versionData.ValidatorClientImage)
 		case cfgtypes.ConsensusClient_Teku:
-			eth2ClientString = fmt.Sprintf(format, "Teku", cfg.ExternalTeku.ContainerTag.Value.(string))
+			versionData.ValidatorClientImage = cfg.ExternalTeku.ContainerTag.Value.(string)
+			eth2ClientString = fmt.Sprintf(format, "Teku", versionData.ValidatorClientImage)
 		default:
 			return fmt.Errorf("unknown external consensus client [%v]", eth2Client)
 		}
@@ -1322,6 +1493,7 @@ func serviceVersion(c *cli.Context) error {
 	}
 
 	// Print version info
+	output.SetData(versionData)
 	fmt.Printf("Rocket Pool client version: %s\n", c.App.Version)
 	fmt.Printf("Rocket Pool service version: %s\n", serviceVersion)
 	fmt.Printf("Selected Eth 1.0 client: %s\n", eth1ClientString)
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+)
+
+// Print the run history of the daemon tasks
+func taskStatus(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the task status
+	response, err := rp.GetTaskStatus()
+	if err != nil {
+		return err
+	}
+	if len(response.Daemons) == 0 {
+		fmt.Println("The node and watchtower daemons haven't recorded any task runs yet.")
+		return nil
+	}
+
+	for _, daemon := range response.Daemons {
+		fmt.Printf("%s=== %s (updated %s) ===%s\n", colorGreen, daemon.Daemon, daemon.UpdatedTime.Format(time.RFC822), colorReset)
+		for _, task := range daemon.Tasks {
+			if task.LastRunTime.IsZero() {
+				fmt.Printf("%s: hasn't run yet\n", task.Name)
+				continue
+			}
+
+			status := "succeeded"
+			if task.Running {
+				status = "running"
+			} else if task.ConsecutiveFailures > 0 {
+				status = fmt.Sprintf("%sfailed %d time(s) in a row%s", colorRed, task.ConsecutiveFailures, colorReset)
+			}
+			fmt.Printf("%s: %s, last run %s ago (took %s)\n", task.Name, status, time.Since(task.LastRunTime).Round(time.Second), task.LastDuration.Round(time.Millisecond))
+			if task.ConsecutiveFailures > 0 {
+				fmt.Printf("\tLast error (%s): %s\n", task.LastErrorTime.Format(time.RFC822), task.LastError)
+				if !task.LastSuccessTime.IsZero() {
+					fmt.Printf("\tLast success: %s\n", task.LastSuccessTime.Format(time.RFC822))
+				}
+			}
+			if !task.Running {
+				fmt.Printf("\tNext run: %s\n", task.NextRunTime.Format(time.RFC822))
+			}
+		}
+		fmt.Println()
+	}
+
+	return nil
+
+}
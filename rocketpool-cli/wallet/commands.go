@@ -213,6 +213,87 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "sign-tx",
+				Usage:     "Sign a transaction that was exported with --unsigned-tx, using the node wallet on this (offline) machine. <transaction> is the exported file, or its contents.",
+				UsageText: "rocketpool wallet sign-tx [options] transaction",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "output, o",
+						Usage: "The path to save the signed transaction to; defaults to signed-tx-<nonce>.txt in the current directory",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm signing the transaction",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return signTransaction(c, c.Args().Get(0))
+
+				},
+			},
+
+			{
+				Name:      "export-slashing-protection",
+				Usage:     "Export your Validator Client's slashing protection database to an EIP-3076 interchange file, and save it alongside your validator keys",
+				UsageText: "rocketpool wallet export-slashing-protection [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "output, o",
+						Usage: "An optional path to also write the EIP-3076 interchange file to",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm stopping the Validator Client",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return exportSlashingProtection(c)
+
+				},
+			},
+
+			{
+				Name:      "import-slashing-protection",
+				Usage:     "Import the slashing protection history saved alongside your validator keys into your Validator Client's database, optionally merging an EIP-3076 interchange file into it first",
+				UsageText: "rocketpool wallet import-slashing-protection [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "input, i",
+						Usage: "The path of an EIP-3076 interchange file to import, such as one exported from another machine",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm stopping the Validator Client",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return importSlashingProtection(c)
+
+				},
+			},
+
 			{
 				Name:      "set-ens-name",
 				Aliases:   []string{"ens"},
@@ -8,6 +8,7 @@ import (
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/output"
 )
 
 func exportWallet(c *cli.Context) error {
@@ -30,9 +31,10 @@ func exportWallet(c *cli.Context) error {
 		// Check if stdout is interactive
 		stat, err := os.Stdout.Stat()
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "An error occured while determining whether or not the output is a tty: %w\n"+
-				"Use \"rocketpool --secure-session wallet export\" to bypass.\n", err)
-			os.Exit(1)
+			err = fmt.Errorf("An error occured while determining whether or not the output is a tty: %w\n"+
+				"Use \"rocketpool --secure-session wallet export\" to bypass.", err)
+			fmt.Fprintln(os.Stderr, err.Error())
+			output.Exit(1, err)
 		}
 
 		if (stat.Mode()&os.ModeCharDevice) == os.ModeCharDevice &&
@@ -0,0 +1,89 @@
+package wallet
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/unsignedtx"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Sign a transaction that was exported with --unsigned-tx, so it can be broadcast from the online node
+func signTransaction(c *cli.Context, input string) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Read the transaction from a file, or take it as-is if it isn't one
+	exported := []byte(input)
+	if _, err := os.Stat(input); err == nil {
+		exported, err = os.ReadFile(input)
+		if err != nil {
+			return fmt.Errorf("error reading transaction file [%s]: %w", input, err)
+		}
+	}
+	tx, from, err := unsignedtx.Parse(exported)
+	if err != nil {
+		return err
+	}
+	if from != (common.Address{}) && from != status.AccountAddress {
+		return fmt.Errorf("the transaction was built for %s, but this node wallet's address is %s", from.Hex(), status.AccountAddress.Hex())
+	}
+
+	// Show what's being signed
+	to := "(contract creation)"
+	if tx.To() != nil {
+		to = tx.To().Hex()
+	}
+	fmt.Printf("From:             %s\n", status.AccountAddress.Hex())
+	fmt.Printf("To:               %s\n", to)
+	fmt.Printf("Chain ID:         %s\n", tx.ChainId().String())
+	fmt.Printf("Nonce:            %d\n", tx.Nonce())
+	fmt.Printf("Value:            %.6f ETH\n", eth.WeiToEth(tx.Value()))
+	fmt.Printf("Data:             %d bytes\n", len(tx.Data()))
+	fmt.Printf("Gas limit:        %d\n", tx.Gas())
+	fmt.Printf("Max fee:          %.6f gwei\n", eth.WeiToGwei(tx.GasFeeCap()))
+	fmt.Printf("Max priority fee: %.6f gwei\n\n", eth.WeiToGwei(tx.GasTipCap()))
+	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to sign this transaction?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Sign it
+	response, err := rp.SignTransaction(strings.TrimSpace(string(exported)))
+	if err != nil {
+		return err
+	}
+	signedTx := hexutil.Encode(response.SignedTransaction)
+	path := c.String("output")
+	if path == "" {
+		path = fmt.Sprintf("signed-tx-%d.txt", tx.Nonce())
+	}
+	if err := os.WriteFile(path, []byte(signedTx), 0644); err != nil {
+		return fmt.Errorf("error saving signed transaction to %s: %w", path, err)
+	}
+
+	fmt.Printf("The transaction has been signed. Its hash will be %s.\n", response.TxHash.Hex())
+	fmt.Printf("Signed transaction: %s\n\n", signedTx)
+	fmt.Printf("It has been saved to %s. Submit it from your online node with `rocketpool node broadcast-tx %s`.\n", path, path)
+	return nil
+
+}
@@ -0,0 +1,180 @@
+package wallet
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/goccy/go-json"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+func exportSlashingProtection(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Load the config
+	cfg, _, err := rp.LoadConfig()
+	if err != nil {
+		return err
+	}
+	if cfg.IsNativeMode {
+		fmt.Println("Managing your Validator Client's slashing protection database isn't supported in Native Mode; please use your client's own tools instead.")
+		return nil
+	}
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm("Your Validator Client will be stopped while its slashing protection database is exported, and restarted afterwards. Would you like to continue?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Export the database while the VC is stopped
+	fmt.Print("Stopping your Validator Client... ")
+	_, err = rp.StopVc()
+	if err != nil {
+		return err
+	}
+	fmt.Println("done!")
+	validatorCount, exportErr := rp.ExportVcSlashingProtection(cfg)
+
+	fmt.Print("Restarting your Validator Client... ")
+	_, err = rp.RestartVc()
+	if err != nil {
+		fmt.Println()
+		fmt.Printf("%sWARNING: your Validator Client could not be restarted: %s\nPlease run `rocketpool service start` to restart it.%s\n", colorYellow, err.Error(), colorReset)
+	} else {
+		fmt.Println("done!")
+	}
+	if exportErr != nil {
+		return exportErr
+	}
+	fmt.Printf("Saved the slashing protection history of %d validators alongside your validator keys.\n", validatorCount)
+
+	// Write it to the requested file
+	outputPath := c.String("output")
+	if outputPath == "" {
+		return nil
+	}
+	history, err := rp.GetSlashingProtection()
+	if err != nil {
+		return err
+	}
+	bytes, err := json.MarshalIndent(history.Interchange, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing slashing protection history: %w", err)
+	}
+	err = os.WriteFile(outputPath, bytes, 0600)
+	if err != nil {
+		return fmt.Errorf("error writing slashing protection history to %s: %w", outputPath, err)
+	}
+	fmt.Printf("Wrote the EIP-3076 interchange file to %s.\n", outputPath)
+	return nil
+
+}
+
+func importSlashingProtection(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Load the config
+	cfg, _, err := rp.LoadConfig()
+	if err != nil {
+		return err
+	}
+	if cfg.IsNativeMode {
+		fmt.Println("Managing your Validator Client's slashing protection database isn't supported in Native Mode; please use your client's own tools instead.")
+		return nil
+	}
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Save the provided interchange file first, so it gets merged with everything else the node has signed
+	inputPath := c.String("input")
+	if inputPath != "" {
+		bytes, err := os.ReadFile(inputPath)
+		if err != nil {
+			return fmt.Errorf("error reading slashing protection file %s: %w", inputPath, err)
+		}
+		_, err = validator.ParseSlashingProtectionInterchange(bytes)
+		if err != nil {
+			return err
+		}
+		response, err := rp.SaveSlashingProtection(string(bytes))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Saved the slashing protection history of %d validators from %s.\n", response.ValidatorCount, inputPath)
+	}
+
+	// Make sure there's something to import
+	history, err := rp.GetSlashingProtection()
+	if err != nil {
+		return err
+	}
+	if !history.Exists || len(history.Interchange.Data) == 0 {
+		fmt.Println("There is no slashing protection history saved alongside your validator keys yet. Please provide an EIP-3076 interchange file with `--input`.")
+		return nil
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Your Validator Client will be stopped while the slashing protection history of %d validators is imported into its database, and restarted afterwards. Would you like to continue?", len(history.Interchange.Data)))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Import the history while the VC is stopped
+	fmt.Print("Stopping your Validator Client... ")
+	_, err = rp.StopVc()
+	if err != nil {
+		return err
+	}
+	fmt.Println("done!")
+	validatorCount, importErr := rp.ImportVcSlashingProtection(cfg)
+
+	fmt.Print("Restarting your Validator Client... ")
+	_, err = rp.RestartVc()
+	if err != nil {
+		fmt.Println()
+		fmt.Printf("%sWARNING: your Validator Client could not be restarted: %s\nPlease run `rocketpool service start` to restart it.%s\n", colorYellow, err.Error(), colorReset)
+	} else {
+		fmt.Println("done!")
+	}
+	if importErr != nil {
+		return importErr
+	}
+	fmt.Printf("Imported the slashing protection history of %d validators into your Validator Client.\n", validatorCount)
+	return nil
+
+}
@@ -106,3 +106,26 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 	http.DefaultTransport.(*http.Transport).MaxIdleConnsPerHost = MaxConcurrentEth1Requests
 
 }
+
+// Get the routes the daemon's API server serves; each one runs an API handler with a typed request instead of parsing command arguments
+func GetRoutes() []api.Route {
+	routes := []api.Route{}
+	routes = append(routes, auction.GetRoutes("auction")...)
+	routes = append(routes, faucet.GetRoutes("faucet")...)
+	routes = append(routes, minipool.GetRoutes("minipool")...)
+	routes = append(routes, network.GetRoutes("network")...)
+	routes = append(routes, node.GetRoutes("node")...)
+	routes = append(routes, odao.GetRoutes("odao")...)
+	routes = append(routes, queue.GetRoutes("queue")...)
+	routes = append(routes, wallet.GetRoutes("wallet")...)
+	routes = append(routes, apiservice.GetRoutes("service")...)
+	routes = append(routes, api.Route{
+		Path:       "wait",
+		Usage:      "Wait for a transaction to complete; takes a WaitForTransactionRequest",
+		NewRequest: func() interface{} { return &apitypes.WaitForTransactionRequest{} },
+		Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+			return waitForTransaction(c, request.(*apitypes.WaitForTransactionRequest).TxHash)
+		},
+	})
+	return routes
+}
@@ -23,10 +23,6 @@ func canBidOnLot(c *cli.Context, lotIndex uint64, amountWei *big.Int) (*api.CanB
 	if err := services.RequireRocketStorage(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -76,7 +72,7 @@ func canBidOnLot(c *cli.Context, lotIndex uint64, amountWei *big.Int) (*api.CanB
 
 	// Get gas estimate
 	wg.Go(func() error {
-		opts, err := w.GetNodeAccountTransactor()
+		opts, err := services.GetNodeAccountTransactor(c)
 		if err != nil {
 			return err
 		}
@@ -108,10 +104,6 @@ func bidOnLot(c *cli.Context, lotIndex uint64, amountWei *big.Int) (*api.BidOnLo
 	if err := services.RequireRocketStorage(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -121,7 +113,7 @@ func bidOnLot(c *cli.Context, lotIndex uint64, amountWei *big.Int) (*api.BidOnLo
 	response := api.BidOnLotResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +70,7 @@ func canClaimFromLot(c *cli.Context, lotIndex uint64) (*api.CanClaimFromLotRespo
 
 	// Get gas estimate
 	wg.Go(func() error {
-		opts, err := w.GetNodeAccountTransactor()
+		opts, err := services.GetNodeAccountTransactor(c)
 		if err != nil {
 			return err
 		}
@@ -101,10 +101,6 @@ func claimFromLot(c *cli.Context, lotIndex uint64) (*api.ClaimFromLotResponse, e
 	if err := services.RequireRocketStorage(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -114,7 +110,7 @@ func claimFromLot(c *cli.Context, lotIndex uint64) (*api.ClaimFromLotResponse, e
 	response := api.ClaimFromLotResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
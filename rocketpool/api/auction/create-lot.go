@@ -22,10 +22,6 @@ func canCreateLot(c *cli.Context) (*api.CanCreateLotResponse, error) {
 	if err := services.RequireRocketStorage(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -57,7 +53,7 @@ func canCreateLot(c *cli.Context) (*api.CanCreateLotResponse, error) {
 
 	// Get gas estimate
 	wg.Go(func() error {
-		opts, err := w.GetNodeAccountTransactor()
+		opts, err := services.GetNodeAccountTransactor(c)
 		if err != nil {
 			return err
 		}
@@ -88,10 +84,6 @@ func createLot(c *cli.Context) (*api.CreateLotResponse, error) {
 	if err := services.RequireRocketStorage(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -101,7 +93,7 @@ func createLot(c *cli.Context) (*api.CreateLotResponse, error) {
 	response := api.CreateLotResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -22,10 +22,6 @@ func canRecoverRplFromLot(c *cli.Context, lotIndex uint64) (*api.CanRecoverRPLFr
 	if err := services.RequireRocketStorage(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -75,7 +71,7 @@ func canRecoverRplFromLot(c *cli.Context, lotIndex uint64) (*api.CanRecoverRPLFr
 
 	// Get gas estimate
 	wg.Go(func() error {
-		opts, err := w.GetNodeAccountTransactor()
+		opts, err := services.GetNodeAccountTransactor(c)
 		if err != nil {
 			return err
 		}
@@ -106,10 +102,6 @@ func recoverRplFromLot(c *cli.Context, lotIndex uint64) (*api.RecoverRPLFromLotR
 	if err := services.RequireRocketStorage(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -119,7 +111,7 @@ func recoverRplFromLot(c *cli.Context, lotIndex uint64) (*api.RecoverRPLFromLotR
 	response := api.RecoverRPLFromLotResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
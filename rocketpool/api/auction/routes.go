@@ -0,0 +1,104 @@
+package auction
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+)
+
+// Get the routes the daemon's API server serves for the auction commands
+func GetRoutes(name string) []apiutils.Route {
+	return []apiutils.Route{
+		{
+			Path:  name + "/status",
+			Usage: "Get RPL auction status",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getStatus(c)
+			},
+		},
+		{
+			Path:  name + "/lots",
+			Usage: "Get RPL lots for auction",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getLots(c)
+			},
+		},
+		{
+			Path:       name + "/can-create-lot",
+			Usage:      "Check whether the node can create a new lot; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return canCreateLot(c)
+			},
+		},
+		{
+			Path:       name + "/create-lot",
+			Usage:      "Create a new lot; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return createLot(c)
+			},
+		},
+		{
+			Path:       name + "/can-bid-lot",
+			Usage:      "Check whether the node can bid on a lot; takes an AuctionBidLotRequest",
+			NewRequest: func() interface{} { return &api.AuctionBidLotRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.AuctionBidLotRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("bid amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				return canBidOnLot(c, r.LotIndex, r.AmountWei)
+			},
+		},
+		{
+			Path:       name + "/bid-lot",
+			Usage:      "Bid on a lot; takes an AuctionBidLotRequest",
+			NewRequest: func() interface{} { return &api.AuctionBidLotRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.AuctionBidLotRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("bid amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				return bidOnLot(c, r.LotIndex, r.AmountWei)
+			},
+		},
+		{
+			Path:       name + "/can-claim-lot",
+			Usage:      "Check whether the node can claim RPL from a lot; takes an AuctionLotRequest",
+			NewRequest: func() interface{} { return &api.AuctionLotRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.AuctionLotRequest)
+				return canClaimFromLot(c, r.LotIndex)
+			},
+		},
+		{
+			Path:       name + "/claim-lot",
+			Usage:      "Claim RPL from a lot; takes an AuctionLotRequest",
+			NewRequest: func() interface{} { return &api.AuctionLotRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.AuctionLotRequest)
+				return claimFromLot(c, r.LotIndex)
+			},
+		},
+		{
+			Path:       name + "/can-recover-lot",
+			Usage:      "Check whether the node can recover unclaimed RPL from a lot; takes an AuctionLotRequest",
+			NewRequest: func() interface{} { return &api.AuctionLotRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.AuctionLotRequest)
+				return canRecoverRplFromLot(c, r.LotIndex)
+			},
+		},
+		{
+			Path:       name + "/recover-lot",
+			Usage:      "Recover unclaimed RPL from a lot (returning it to the auction contract); takes an AuctionLotRequest",
+			NewRequest: func() interface{} { return &api.AuctionLotRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.AuctionLotRequest)
+				return recoverRplFromLot(c, r.LotIndex)
+			},
+		},
+	}
+}
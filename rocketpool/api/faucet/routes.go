@@ -0,0 +1,37 @@
+package faucet
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+)
+
+// Get the routes the daemon's API server serves for the faucet commands
+func GetRoutes(name string) []apiutils.Route {
+	return []apiutils.Route{
+		{
+			Path:  name + "/status",
+			Usage: "Get the faucet's status",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getStatus(c)
+			},
+		},
+		{
+			Path:       name + "/can-withdraw-rpl",
+			Usage:      "Check whether the node can withdraw legacy RPL from the faucet; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return canWithdrawRpl(c)
+			},
+		},
+		{
+			Path:       name + "/withdraw-rpl",
+			Usage:      "Withdraw legacy RPL from the faucet; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return withdrawRpl(c)
+			},
+		},
+	}
+}
@@ -102,7 +102,7 @@ func canWithdrawRpl(c *cli.Context) (*api.CanFaucetWithdrawRplResponse, error) {
 
 	if response.CanWithdraw {
 		// Get the gas estimate
-		opts, err := w.GetNodeAccountTransactor()
+		opts, err := services.GetNodeAccountTransactor(c)
 		if err != nil {
 			return nil, err
 		}
@@ -196,7 +196,7 @@ func withdrawRpl(c *cli.Context) (*api.FaucetWithdrawRplResponse, error) {
 	response.Amount = amount
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
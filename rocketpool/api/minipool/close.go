@@ -64,7 +64,7 @@ func getMinipoolCloseDetailsForNode(c *cli.Context) (*api.GetMinipoolCloseDetail
 	}
 
 	// Get the transaction opts
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -307,10 +307,6 @@ func closeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CloseMi
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -332,7 +328,7 @@ func closeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CloseMi
 	}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -34,6 +34,28 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "performance",
+				Usage:     "Get the recent attestation, proposal, and sync committee performance of the node's minipools",
+				UsageText: "rocketpool api minipool performance epochs",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					epochs, err := cliutils.ValidatePositiveUint("epochs", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getPerformance(c, epochs))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "can-stake",
 				Usage:     "Check whether the minipool is ready to be staked, moving from prelaunch to staking status",
@@ -253,6 +275,52 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "export-exits",
+				Usage:     "Get pre-signed voluntary exit messages for all of the node's staking minipools",
+				UsageText: "rocketpool api minipool export-exits",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(exportMinipoolExits(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "broadcast-exit",
+				Usage:     "Broadcast a signed voluntary exit message to the Beacon Chain",
+				UsageText: "rocketpool api minipool broadcast-exit validator-index epoch signature",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 3); err != nil {
+						return err
+					}
+					if _, err := cliutils.ValidateUint("validator index", c.Args().Get(0)); err != nil {
+						return err
+					}
+					epoch, err := cliutils.ValidateUint("epoch", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+					signature, err := cliutils.ValidateValidatorSignature("signature", c.Args().Get(2))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(broadcastMinipoolExit(c, c.Args().Get(0), epoch, signature))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "get-minipool-close-details-for-node",
 				Usage:     "Check all of the node's minipools for closure eligibility, and return the details of the closeable ones",
@@ -678,24 +746,50 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 			{
 				Name:      "import-key",
 				Usage:     "Import a validator private key for a vacant minipool",
-				UsageText: "rocketpool api minipool import-key minipool-address mnemonic",
+				UsageText: "rocketpool api minipool import-key minipool-address skip-liveness-check mnemonic",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
-					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+					if err := cliutils.ValidateArgCount(c, 3); err != nil {
 						return err
 					}
 					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
 					if err != nil {
 						return err
 					}
-					mnemonic, err := cliutils.ValidateWalletMnemonic("mnemonic", c.Args().Get(1))
+					skipLivenessCheck, err := cliutils.ValidateBool("skip-liveness-check", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+					mnemonic, err := cliutils.ValidateWalletMnemonic("mnemonic", c.Args().Get(2))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(importKey(c, minipoolAddress, skipLivenessCheck, mnemonic))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "load-key",
+				Usage:     "Load a minipool's validator key into the Validator Client, restarting it if the keymanager API isn't available",
+				UsageText: "rocketpool api minipool load-key minipool-address",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
 					if err != nil {
 						return err
 					}
 
 					// Run
-					api.PrintResponse(importKey(c, minipoolAddress, mnemonic))
+					api.PrintResponse(loadKey(c, minipoolAddress))
 					return nil
 
 				},
@@ -20,10 +20,6 @@ func canDelegateUpgrade(c *cli.Context, minipoolAddress common.Address) (*api.Ca
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -46,7 +42,7 @@ func canDelegateUpgrade(c *cli.Context, minipoolAddress common.Address) (*api.Ca
 	response.LatestDelegateAddress = *latestDelegateAddress
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -66,10 +62,6 @@ func delegateUpgrade(c *cli.Context, minipoolAddress common.Address) (*api.Deleg
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -85,7 +77,7 @@ func delegateUpgrade(c *cli.Context, minipoolAddress common.Address) (*api.Deleg
 	}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -114,10 +106,6 @@ func canDelegateRollback(c *cli.Context, minipoolAddress common.Address) (*api.C
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -150,7 +138,7 @@ func canDelegateRollback(c *cli.Context, minipoolAddress common.Address) (*api.C
 	response.RollbackAddress = rollbackAddress
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -170,10 +158,6 @@ func delegateRollback(c *cli.Context, minipoolAddress common.Address) (*api.Dele
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -199,7 +183,7 @@ func delegateRollback(c *cli.Context, minipoolAddress common.Address) (*api.Dele
 	}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -228,10 +212,6 @@ func canSetUseLatestDelegate(c *cli.Context, minipoolAddress common.Address, set
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -273,7 +253,7 @@ func canSetUseLatestDelegate(c *cli.Context, minipoolAddress common.Address, set
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -293,10 +273,6 @@ func setUseLatestDelegate(c *cli.Context, minipoolAddress common.Address, settin
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -338,7 +314,7 @@ func setUseLatestDelegate(c *cli.Context, minipoolAddress common.Address, settin
 	}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +54,7 @@ func canDissolveMinipool(c *cli.Context, minipoolAddress common.Address) (*api.C
 	response.InvalidStatus = !(status == types.Initialized || status == types.Prelaunch)
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -75,10 +75,6 @@ func dissolveMinipool(c *cli.Context, minipoolAddress common.Address) (*api.Diss
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -94,7 +90,7 @@ func dissolveMinipool(c *cli.Context, minipoolAddress common.Address) (*api.Diss
 	}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -162,7 +162,7 @@ func getDistributeBalanceDetails(c *cli.Context) (*api.GetDistributeBalanceDetai
 				}
 
 				// Get gas estimate
-				opts, err := w.GetNodeAccountTransactor()
+				opts, err := services.GetNodeAccountTransactor(c)
 				if err != nil {
 					return err
 				}
@@ -197,10 +197,6 @@ func distributeBalance(c *cli.Context, minipoolAddress common.Address) (*api.Clo
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -216,7 +212,7 @@ func distributeBalance(c *cli.Context, minipoolAddress common.Address) (*api.Clo
 	}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
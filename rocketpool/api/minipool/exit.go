@@ -5,11 +5,9 @@ import (
 	"github.com/rocket-pool/rocketpool-go/minipool"
 	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/urfave/cli"
-	eth2types "github.com/wealdtech/go-eth2-types/v2"
 
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/types/api"
-	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
 
 func canExitMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanExitMinipoolResponse, error) {
@@ -89,24 +87,12 @@ func exitMinipool(c *cli.Context, minipoolAddress common.Address) (*api.ExitMini
 		return nil, err
 	}
 
-	// Get validator private key
-	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
-	if err != nil {
-		return nil, err
-	}
-
 	// Get beacon head
 	head, err := bc.GetBeaconHead()
 	if err != nil {
 		return nil, err
 	}
 
-	// Get voluntary exit signature domain
-	signatureDomain, err := bc.GetDomainData(eth2types.DomainVoluntaryExit[:], head.Epoch, false)
-	if err != nil {
-		return nil, err
-	}
-
 	// Get validator index
 	validatorIndex, err := bc.GetValidatorIndex(validatorPubkey)
 	if err != nil {
@@ -114,7 +100,7 @@ func exitMinipool(c *cli.Context, minipoolAddress common.Address) (*api.ExitMini
 	}
 
 	// Get signed voluntary exit message
-	signature, err := validator.GetSignedExitMessage(validatorKey, validatorIndex, head.Epoch, signatureDomain)
+	signature, err := w.GetSignedExitMessage(validatorPubkey, validatorIndex, head.Epoch, bc)
 	if err != nil {
 		return nil, err
 	}
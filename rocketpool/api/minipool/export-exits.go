@@ -0,0 +1,132 @@
+package minipool
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+func exportMinipoolExits(c *cli.Context) (*api.ExportMinipoolExitsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ExportMinipoolExitsResponse{
+		Exits:   []api.MinipoolPresignedExit{},
+		Skipped: []common.Address{},
+	}
+
+	// Get the node's minipools
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := minipool.GetNodeMinipoolAddresses(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := rputils.GetMinipoolValidators(rp, bc, addresses, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get beacon head
+	head, err := bc.GetBeaconHead()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign an exit for each minipool whose validator is still able to exit
+	for _, address := range addresses {
+		mp, err := minipool.NewMinipool(rp, address, nil)
+		if err != nil {
+			return nil, err
+		}
+		status, err := mp.GetStatus(nil)
+		if err != nil {
+			return nil, err
+		}
+		validator := validators[address]
+		if status != types.Staking || !canPresignExit(validator) {
+			response.Skipped = append(response.Skipped, address)
+			continue
+		}
+
+		signature, err := w.GetPresignedExitMessage(validator.Pubkey, validator.Index, head.Epoch, bc)
+		if err != nil {
+			return nil, err
+		}
+		response.Exits = append(response.Exits, api.MinipoolPresignedExit{
+			Address:        address,
+			Pubkey:         validator.Pubkey,
+			ValidatorIndex: validator.Index,
+			Epoch:          head.Epoch,
+			Signature:      signature,
+		})
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func broadcastMinipoolExit(c *cli.Context, validatorIndex string, epoch uint64, signature types.ValidatorSignature) (*api.BroadcastMinipoolExitResponse, error) {
+
+	// Get services; the exit is already signed, so this doesn't need a wallet or a registered node
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.BroadcastMinipoolExitResponse{}
+
+	// Broadcast voluntary exit message
+	if err := bc.ExitValidator(validatorIndex, epoch, signature); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Check if a validator is on the Beacon Chain and hasn't started exiting yet
+func canPresignExit(validator beacon.ValidatorStatus) bool {
+	if !validator.Exists {
+		return false
+	}
+	switch validator.Status {
+	case beacon.ValidatorState_PendingInitialized, beacon.ValidatorState_PendingQueued, beacon.ValidatorState_ActiveOngoing:
+		return true
+	default:
+		return false
+	}
+}
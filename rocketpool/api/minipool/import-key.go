@@ -15,7 +15,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
 
-func importKey(c *cli.Context, minipoolAddress common.Address, mnemonic string) (*api.ImportKeyResponse, error) {
+func importKey(c *cli.Context, minipoolAddress common.Address, skipLivenessCheck bool, mnemonic string) (*api.ImportKeyResponse, error) {
 
 	// Get services
 	if err := services.RequireNodeRegistered(c); err != nil {
@@ -81,6 +81,23 @@ func importKey(c *cli.Context, minipoolAddress common.Address, mnemonic string)
 		return nil, fmt.Errorf("couldn't find the validator key for this mnemonic after %d tries", validatorLimit)
 	}
 
+	// Make sure the validator isn't still attesting with another Validator Client, or it would be slashed once the Smartnode's loads the key too
+	if !skipLivenessCheck {
+		bc, err := services.GetBeaconClient(c)
+		if err != nil {
+			return nil, err
+		}
+		liveValidators, err := validator.GetLiveValidators(bc, []types.ValidatorPubkey{pubkey}, validator.LivenessCheckEpochs)
+		if err != nil {
+			return nil, fmt.Errorf("error checking if the validator is still attesting: %w", err)
+		}
+		if epoch, isLive := liveValidators[pubkey]; isLive {
+			response.ValidatorIsLive = true
+			response.LastAttestationEpoch = epoch
+			return &response, nil
+		}
+	}
+
 	// Save the keystore to disk
 	derivationPath := fmt.Sprintf(validatorKeyPath, index)
 	err = w.StoreValidatorKey(validatorKey, derivationPath)
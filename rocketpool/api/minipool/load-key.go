@@ -0,0 +1,80 @@
+package minipool
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func loadKey(c *cli.Context, minipoolAddress common.Address) (*api.LoadKeyResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	d, err := services.GetDocker(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.LoadKeyResponse{}
+
+	// Create minipool
+	mp, err := minipool.NewMinipool(rp, minipoolAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate minipool owner
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMinipoolOwner(mp, nodeAccount.Address); err != nil {
+		return nil, err
+	}
+
+	// Get minipool validator pubkey
+	pubkey, err := minipool.GetMinipoolPubkey(rp, minipoolAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	emptyPubkey := types.ValidatorPubkey{}
+	if pubkey == emptyPubkey {
+		return nil, fmt.Errorf("minipool %s does not have a validator pubkey associated with it", minipoolAddress.Hex())
+	}
+
+	// Load the key into the VC, restarting it if the keymanager API isn't available
+	hotLoaded, err := keymanager.LoadValidatorKeys(cfg, bc, nil, d, w, []types.ValidatorPubkey{pubkey})
+	if err != nil {
+		return nil, fmt.Errorf("error loading the validator key into the validator client: %w", err)
+	}
+	response.Restarted = !hotLoaded
+
+	// Return response
+	return &response, nil
+}
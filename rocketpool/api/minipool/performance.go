@@ -0,0 +1,64 @@
+package minipool
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/performance"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getPerformance(c *cli.Context, epochs uint64) (*api.MinipoolPerformanceResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.MinipoolPerformanceResponse{
+		Minipools: []api.MinipoolPerformance{},
+	}
+
+	// Load the performance the node daemon has tracked
+	record, exists, err := performance.LoadRecord(cfg.Smartnode.GetValidatorPerformancePath(true))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return &response, nil
+	}
+	response.IsTracked = true
+	response.LatestEpoch = record.LatestEpoch
+
+	// Only include the requested epochs
+	response.StartEpoch = record.StartEpoch
+	if record.LatestEpoch >= epochs && record.LatestEpoch-epochs+1 > response.StartEpoch {
+		response.StartEpoch = record.LatestEpoch - epochs + 1
+	}
+	for _, minipool := range record.Minipools {
+		filtered := *minipool
+		filtered.Epochs = []api.ValidatorEpochPerformance{}
+		for _, epoch := range minipool.Epochs {
+			if epoch.Epoch >= response.StartEpoch {
+				filtered.Epochs = append(filtered.Epochs, epoch)
+			}
+		}
+		response.Minipools = append(response.Minipools, filtered)
+	}
+
+	// Sort by validator index
+	sort.Slice(response.Minipools, func(i, j int) bool {
+		first, _ := strconv.ParseUint(response.Minipools[i].ValidatorIndex, 10, 64)
+		second, _ := strconv.ParseUint(response.Minipools[j].ValidatorIndex, 10, 64)
+		return first < second
+	})
+
+	// Return response
+	return &response, nil
+
+}
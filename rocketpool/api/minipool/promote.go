@@ -92,7 +92,7 @@ func canPromoteMinipool(c *cli.Context, minipoolAddress common.Address) (*api.Ca
 		}
 
 		// Get transactor
-		opts, err := w.GetNodeAccountTransactor()
+		opts, err := services.GetNodeAccountTransactor(c)
 		if err != nil {
 			return nil, err
 		}
@@ -117,10 +117,6 @@ func promoteMinipool(c *cli.Context, minipoolAddress common.Address) (*api.Stake
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -140,7 +136,7 @@ func promoteMinipool(c *cli.Context, minipoolAddress common.Address) (*api.Stake
 	}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
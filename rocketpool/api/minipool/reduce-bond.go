@@ -21,10 +21,6 @@ func canBeginReduceBondAmount(c *cli.Context, minipoolAddress common.Address, ne
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -109,7 +105,7 @@ func canBeginReduceBondAmount(c *cli.Context, minipoolAddress common.Address, ne
 	response.CanReduce = !(response.BondReductionDisabled || response.MinipoolVersionTooLow || response.BalanceTooLow || response.InvalidBeaconState)
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -127,10 +123,6 @@ func beginReduceBondAmount(c *cli.Context, minipoolAddress common.Address, newBo
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -140,7 +132,7 @@ func beginReduceBondAmount(c *cli.Context, minipoolAddress common.Address, newBo
 	response := api.BeginReduceBondAmountResponse{}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -167,10 +159,6 @@ func canReduceBondAmount(c *cli.Context, minipoolAddress common.Address) (*api.C
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -188,7 +176,7 @@ func canReduceBondAmount(c *cli.Context, minipoolAddress common.Address) (*api.C
 	mpv3, success := minipool.GetMinipoolAsV3(mp)
 	if success {
 		// Get gas estimate
-		opts, err := w.GetNodeAccountTransactor()
+		opts, err := services.GetNodeAccountTransactor(c)
 		if err != nil {
 			return nil, err
 		}
@@ -209,10 +197,6 @@ func reduceBondAmount(c *cli.Context, minipoolAddress common.Address) (*api.Redu
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -232,7 +216,7 @@ func reduceBondAmount(c *cli.Context, minipoolAddress common.Address) (*api.Redu
 	}
 
 	// Get the node transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
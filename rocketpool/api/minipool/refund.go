@@ -54,7 +54,7 @@ func canRefundMinipool(c *cli.Context, minipoolAddress common.Address) (*api.Can
 	response.InsufficientRefundBalance = (refundBalance.Cmp(big.NewInt(0)) == 0)
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -75,10 +75,6 @@ func refundMinipool(c *cli.Context, minipoolAddress common.Address) (*api.Refund
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -94,7 +90,7 @@ func refundMinipool(c *cli.Context, minipoolAddress common.Address) (*api.Refund
 	}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -20,7 +20,6 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
-	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
 
 func getMinipoolRescueDissolvedDetailsForNode(c *cli.Context) (*api.GetMinipoolRescueDissolvedDetailsForNodeResponse, error) {
@@ -73,7 +72,7 @@ func getMinipoolRescueDissolvedDetailsForNode(c *cli.Context) (*api.GetMinipoolR
 			mi := mi
 			wg.Go(func() error {
 				address := addresses[mi]
-				mpDetails, err := getMinipoolRescueDissolvedDetails(rp, w, bc, address, nodeAccount.Address)
+				mpDetails, err := getMinipoolRescueDissolvedDetails(c, rp, w, bc, address, nodeAccount.Address)
 				if err == nil {
 					details[mi] = mpDetails
 				}
@@ -91,7 +90,7 @@ func getMinipoolRescueDissolvedDetailsForNode(c *cli.Context) (*api.GetMinipoolR
 
 }
 
-func getMinipoolRescueDissolvedDetails(rp *rocketpool.RocketPool, w *wallet.Wallet, bc beacon.Client, minipoolAddress common.Address, nodeAddress common.Address) (api.MinipoolRescueDissolvedDetails, error) {
+func getMinipoolRescueDissolvedDetails(c *cli.Context, rp *rocketpool.RocketPool, w *wallet.Wallet, bc beacon.Client, minipoolAddress common.Address, nodeAddress common.Address) (api.MinipoolRescueDissolvedDetails, error) {
 
 	// Create minipool
 	mp, err := minipool.NewMinipool(rp, minipoolAddress, nil)
@@ -184,7 +183,7 @@ func getMinipoolRescueDissolvedDetails(rp *rocketpool.RocketPool, w *wallet.Wall
 
 	// Get the simulated deposit TX
 	one := eth.EthToWei(1)
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return api.MinipoolRescueDissolvedDetails{}, err
 	}
@@ -250,21 +249,17 @@ func getDepositTx(rp *rocketpool.RocketPool, w *wallet.Wallet, bc beacon.Client,
 		return nil, err
 	}
 
-	// Get the validator key for the minipool
+	// Get the validator pubkey for the minipool
 	validatorPubkey, err := minipool.GetMinipoolPubkey(rp, mp.GetAddress(), nil)
 	if err != nil {
 		return nil, err
 	}
-	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
-	if err != nil {
-		return nil, err
-	}
 
 	// Get the deposit amount in gwei
 	amountGwei := big.NewInt(0).Div(amount, big.NewInt(1e9)).Uint64()
 
 	// Get validator deposit data
-	depositData, depositDataRoot, err := validator.GetDepositData(validatorKey, withdrawalCredentials, eth2Config, amountGwei)
+	depositData, depositDataRoot, err := w.GetDepositData(validatorPubkey, withdrawalCredentials, eth2Config, amountGwei)
 	if err != nil {
 		return nil, err
 	}
@@ -304,7 +299,7 @@ func rescueDissolvedMinipool(c *cli.Context, minipoolAddress common.Address, amo
 	response := api.RescueDissolvedMinipoolResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
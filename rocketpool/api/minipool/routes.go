@@ -0,0 +1,387 @@
+package minipool
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Get the routes the daemon's API server serves for the minipool commands
+func GetRoutes(name string) []apiutils.Route {
+	return []apiutils.Route{
+		{
+			Path:  name + "/status",
+			Usage: "Get a list of the node's minipools",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getStatus(c)
+			},
+		},
+		{
+			Path:       name + "/performance",
+			Usage:      "Get the recent attestation, proposal, and sync committee performance of the node's minipools; takes a MinipoolPerformanceRequest",
+			NewRequest: func() interface{} { return &api.MinipoolPerformanceRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolPerformanceRequest)
+				if err := apiutils.ValidatePositiveUint("epochs", r.Epochs); err != nil {
+					return nil, err
+				}
+				return getPerformance(c, r.Epochs)
+			},
+		},
+		{
+			Path:       name + "/can-stake",
+			Usage:      "Check whether the minipool is ready to be staked, moving from prelaunch to staking status; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return canStakeMinipool(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/stake",
+			Usage:      "Stake the minipool, moving it from prelaunch to staking status; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return stakeMinipool(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/can-promote",
+			Usage:      "Check whether a vacant minipool is ready to be promoted; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return canPromoteMinipool(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/promote",
+			Usage:      "Promote a vacant minipool; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return promoteMinipool(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/can-refund",
+			Usage:      "Check whether the node can refund ETH from the minipool; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return canRefundMinipool(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/refund",
+			Usage:      "Refund ETH belonging to the node from a minipool; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return refundMinipool(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/can-dissolve",
+			Usage:      "Check whether the minipool can be dissolved; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return canDissolveMinipool(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/dissolve",
+			Usage:      "Dissolve an initialized or prelaunch minipool; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return dissolveMinipool(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/can-exit",
+			Usage:      "Check whether the minipool can be exited from the beacon chain; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return canExitMinipool(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/exit",
+			Usage:      "Exit a staking minipool from the beacon chain; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return exitMinipool(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/export-exits",
+			Usage:      "Get pre-signed voluntary exit messages for all of the node's staking minipools; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return exportMinipoolExits(c)
+			},
+		},
+		{
+			Path:       name + "/broadcast-exit",
+			Usage:      "Broadcast a signed voluntary exit message to the Beacon Chain; takes a MinipoolBroadcastExitRequest",
+			NewRequest: func() interface{} { return &api.MinipoolBroadcastExitRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolBroadcastExitRequest)
+				if _, err := cliutils.ValidateUint("validator index", r.ValidatorIndex); err != nil {
+					return nil, err
+				}
+				return broadcastMinipoolExit(c, r.ValidatorIndex, r.Epoch, r.Signature)
+			},
+		},
+		{
+			Path:  name + "/get-minipool-close-details-for-node",
+			Usage: "Check all of the node's minipools for closure eligibility, and return the details of the closeable ones",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getMinipoolCloseDetailsForNode(c)
+			},
+		},
+		{
+			Path:       name + "/close",
+			Usage:      "Withdraw balance from a dissolved minipool and close it; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return closeMinipool(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/can-delegate-upgrade",
+			Usage:      "Check whether the minipool delegate can be upgraded; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return canDelegateUpgrade(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/delegate-upgrade",
+			Usage:      "Upgrade this minipool to the latest network delegate contract; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return delegateUpgrade(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/can-delegate-rollback",
+			Usage:      "Check whether the minipool delegate can be rolled back; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return canDelegateRollback(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/delegate-rollback",
+			Usage:      "Rollback the minipool to the previous delegate contract; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return delegateRollback(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/can-set-use-latest-delegate",
+			Usage:      "Check whether the 'always use latest delegate' toggle can be set; takes a MinipoolSetUseLatestDelegateRequest",
+			NewRequest: func() interface{} { return &api.MinipoolSetUseLatestDelegateRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolSetUseLatestDelegateRequest)
+				return canSetUseLatestDelegate(c, r.MinipoolAddress, r.Setting)
+			},
+		},
+		{
+			Path:       name + "/set-use-latest-delegate",
+			Usage:      "Set whether or not to ignore the minipool's current delegate, and always use the latest delegate instead; takes a MinipoolSetUseLatestDelegateRequest",
+			NewRequest: func() interface{} { return &api.MinipoolSetUseLatestDelegateRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolSetUseLatestDelegateRequest)
+				return setUseLatestDelegate(c, r.MinipoolAddress, r.Setting)
+			},
+		},
+		{
+			Path:       name + "/get-use-latest-delegate",
+			Usage:      "Gets the current setting of the 'always use latest delegate' toggle; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return getUseLatestDelegate(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/get-delegate",
+			Usage:      "Gets the address of the current delegate contract used by the minipool; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return getDelegate(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/get-previous-delegate",
+			Usage:      "Gets the address of the previous delegate contract that the minipool will use during a rollback; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return getPreviousDelegate(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/get-effective-delegate",
+			Usage:      "Gets the address of the effective delegate contract used by the minipool, which takes the UseLatestDelegate setting into account; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return getEffectiveDelegate(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/get-vanity-artifacts",
+			Usage:      "Gets the data necessary to search for vanity minipool addresses; takes a MinipoolVanityArtifactsRequest",
+			NewRequest: func() interface{} { return &api.MinipoolVanityArtifactsRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolVanityArtifactsRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("deposit amount", r.DepositAmount); err != nil {
+					return nil, err
+				}
+				return getVanityArtifacts(c, r.DepositAmount, r.NodeAddress)
+			},
+		},
+		{
+			Path:       name + "/can-begin-reduce-bond-amount",
+			Usage:      "Check whether the minipool can begin the bond reduction process; takes a MinipoolBeginReduceBondAmountRequest",
+			NewRequest: func() interface{} { return &api.MinipoolBeginReduceBondAmountRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolBeginReduceBondAmountRequest)
+				if err := apiutils.ValidateBigInt("new bond amount", r.NewBondAmountWei); err != nil {
+					return nil, err
+				}
+				return canBeginReduceBondAmount(c, r.MinipoolAddress, r.NewBondAmountWei)
+			},
+		},
+		{
+			Path:       name + "/begin-reduce-bond-amount",
+			Usage:      "Begin the bond reduction process for a minipool; takes a MinipoolBeginReduceBondAmountRequest",
+			NewRequest: func() interface{} { return &api.MinipoolBeginReduceBondAmountRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolBeginReduceBondAmountRequest)
+				if err := apiutils.ValidateBigInt("new bond amount", r.NewBondAmountWei); err != nil {
+					return nil, err
+				}
+				return beginReduceBondAmount(c, r.MinipoolAddress, r.NewBondAmountWei)
+			},
+		},
+		{
+			Path:       name + "/can-reduce-bond-amount",
+			Usage:      "Check if a minipool's bond can be reduced; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return canReduceBondAmount(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/reduce-bond-amount",
+			Usage:      "Reduce a minipool's bond; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return reduceBondAmount(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:  name + "/get-distribute-balance-details",
+			Usage: "Get the balance distribution details for all of the node's minipools",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getDistributeBalanceDetails(c)
+			},
+		},
+		{
+			Path:       name + "/distribute-balance",
+			Usage:      "Distribute a minipool's ETH balance; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return distributeBalance(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/import-key",
+			Usage:      "Import a validator private key for a vacant minipool; takes a MinipoolImportKeyRequest",
+			NewRequest: func() interface{} { return &api.MinipoolImportKeyRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolImportKeyRequest)
+				mnemonic, err := cliutils.ValidateWalletMnemonic("mnemonic", r.Mnemonic)
+				if err != nil {
+					return nil, err
+				}
+				return importKey(c, r.MinipoolAddress, r.SkipLivenessCheck, mnemonic)
+			},
+		},
+		{
+			Path:       name + "/load-key",
+			Usage:      "Load a minipool's validator key into the Validator Client, restarting it if the keymanager API isn't available; takes a MinipoolRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRequest)
+				return loadKey(c, r.MinipoolAddress)
+			},
+		},
+		{
+			Path:       name + "/can-change-withdrawal-creds",
+			Usage:      "Check whether a solo validator's withdrawal credentials can be changed to a minipool address; takes a MinipoolChangeWithdrawalCredsRequest",
+			NewRequest: func() interface{} { return &api.MinipoolChangeWithdrawalCredsRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolChangeWithdrawalCredsRequest)
+				mnemonic, err := cliutils.ValidateWalletMnemonic("mnemonic", r.Mnemonic)
+				if err != nil {
+					return nil, err
+				}
+				return canChangeWithdrawalCreds(c, r.MinipoolAddress, mnemonic)
+			},
+		},
+		{
+			Path:       name + "/change-withdrawal-creds",
+			Usage:      "Change a solo validator's withdrawal credentials to a minipool address; takes a MinipoolChangeWithdrawalCredsRequest",
+			NewRequest: func() interface{} { return &api.MinipoolChangeWithdrawalCredsRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolChangeWithdrawalCredsRequest)
+				mnemonic, err := cliutils.ValidateWalletMnemonic("mnemonic", r.Mnemonic)
+				if err != nil {
+					return nil, err
+				}
+				return changeWithdrawalCreds(c, r.MinipoolAddress, mnemonic)
+			},
+		},
+		{
+			Path:  name + "/get-rescue-dissolved-details-for-node",
+			Usage: "Check all of the node's minipools for rescue eligibility, and return the details of the rescuable ones",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getMinipoolRescueDissolvedDetailsForNode(c)
+			},
+		},
+		{
+			Path:       name + "/rescue-dissolved",
+			Usage:      "Rescue a dissolved minipool by depositing ETH for it to the Beacon deposit contract; takes a MinipoolRescueDissolvedRequest",
+			NewRequest: func() interface{} { return &api.MinipoolRescueDissolvedRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.MinipoolRescueDissolvedRequest)
+				if err := apiutils.ValidateBigInt("deposit amount", r.DepositAmount); err != nil {
+					return nil, err
+				}
+				return rescueDissolvedMinipool(c, r.MinipoolAddress, r.DepositAmount)
+			},
+		},
+	}
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
-	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
 
 func canStakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanStakeMinipoolResponse, error) {
@@ -98,15 +97,11 @@ func canStakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanS
 			return nil, err
 		}
 
-		// Get the validator key for the minipool
+		// Get the validator pubkey for the minipool
 		validatorPubkey, err := minipool.GetMinipoolPubkey(rp, mp.GetAddress(), nil)
 		if err != nil {
 			return nil, err
 		}
-		validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
-		if err != nil {
-			return nil, err
-		}
 
 		// Get the minipool type
 		depositType, err := minipool.GetMinipoolDepositType(rp, mp.GetAddress(), nil)
@@ -125,13 +120,13 @@ func canStakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanS
 		}
 
 		// Get validator deposit data
-		depositData, depositDataRoot, err := validator.GetDepositData(validatorKey, withdrawalCredentials, eth2Config, depositAmount)
+		depositData, depositDataRoot, err := w.GetDepositData(validatorPubkey, withdrawalCredentials, eth2Config, depositAmount)
 		if err != nil {
 			return nil, err
 		}
 
 		// Get transactor
-		opts, err := w.GetNodeAccountTransactor()
+		opts, err := services.GetNodeAccountTransactor(c)
 		if err != nil {
 			return nil, err
 		}
@@ -178,7 +173,7 @@ func stakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.StakeMi
 	}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -201,15 +196,11 @@ func stakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.StakeMi
 		return nil, err
 	}
 
-	// Get the validator key for the minipool
+	// Get the validator pubkey for the minipool
 	validatorPubkey, err := minipool.GetMinipoolPubkey(rp, mp.GetAddress(), nil)
 	if err != nil {
 		return nil, err
 	}
-	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
-	if err != nil {
-		return nil, err
-	}
 
 	// Get the minipool type
 	depositType, err := minipool.GetMinipoolDepositType(rp, mp.GetAddress(), nil)
@@ -228,7 +219,7 @@ func stakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.StakeMi
 	}
 
 	// Get validator deposit data
-	depositData, depositDataRoot, err := validator.GetDepositData(validatorKey, withdrawalCredentials, eth2Config, depositAmount)
+	depositData, depositDataRoot, err := w.GetDepositData(validatorPubkey, withdrawalCredentials, eth2Config, depositAmount)
 	if err != nil {
 		return nil, err
 	}
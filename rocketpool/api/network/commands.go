@@ -137,6 +137,29 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "verify-rewards-tree",
+				Usage:     "Regenerate the rewards tree for the given interval and compare it against the canonical one",
+				UsageText: "rocketpool api network verify-rewards-tree index",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					index, err := cliutils.ValidateUint("index", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(verifyRewardsTree(c, index))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "dao-proposals",
 				Aliases:   []string{"d"},
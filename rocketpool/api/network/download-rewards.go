@@ -41,9 +41,9 @@ func downloadRewardsFile(c *cli.Context, interval uint64) (*api.DownloadRewardsF
 	}
 
 	// Download the rewards file
-	err = rewards.DownloadRewardsFile(cfg, interval, intervalInfo.CID, true)
+	err = rewards.DownloadRewardsFile(cfg, interval, intervalInfo.CID, intervalInfo.MerkleRoot, true)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("no source provided a valid rewards tree file for interval %d:\n%w", interval, err)
 	}
 
 	// Return response
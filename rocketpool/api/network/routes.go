@@ -0,0 +1,102 @@
+package network
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+)
+
+// Get the routes the daemon's API server serves for the network commands
+func GetRoutes(name string) []apiutils.Route {
+	return []apiutils.Route{
+		{
+			Path:  name + "/node-fee",
+			Usage: "Get the current network node commission rate",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getNodeFee(c)
+			},
+		},
+		{
+			Path:  name + "/rpl-price",
+			Usage: "Get the current network RPL price in ETH",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getRplPrice(c)
+			},
+		},
+		{
+			Path:  name + "/stats",
+			Usage: "Get stats about the Rocket Pool network and its tokens",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getStats(c)
+			},
+		},
+		{
+			Path:  name + "/timezone-map",
+			Usage: "Get the table of node operators by timezone",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getTimezones(c)
+			},
+		},
+		{
+			Path:       name + "/can-generate-rewards-tree",
+			Usage:      "Check if the rewards tree for the provided interval can be generated; takes a NetworkRewardsTreeRequest",
+			NewRequest: func() interface{} { return &api.NetworkRewardsTreeRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NetworkRewardsTreeRequest)
+				return canGenerateRewardsTree(c, r.Index)
+			},
+		},
+		{
+			Path:       name + "/generate-rewards-tree",
+			Usage:      "Set a request marker for the watchtower to generate the rewards tree for the given interval; takes a NetworkRewardsTreeRequest",
+			NewRequest: func() interface{} { return &api.NetworkRewardsTreeRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NetworkRewardsTreeRequest)
+				return generateRewardsTree(c, r.Index)
+			},
+		},
+		{
+			Path:       name + "/verify-rewards-tree",
+			Usage:      "Regenerate the rewards tree for the given interval and compare it against the canonical one; takes a NetworkRewardsTreeRequest",
+			NewRequest: func() interface{} { return &api.NetworkRewardsTreeRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NetworkRewardsTreeRequest)
+				return verifyRewardsTree(c, r.Index)
+			},
+		},
+		{
+			Path:  name + "/dao-proposals",
+			Usage: "Get the currently active DAO proposals",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getActiveDAOProposals(c)
+			},
+		},
+		{
+			Path:       name + "/download-rewards-file",
+			Usage:      "Download a rewards info file from IPFS for the given interval; takes a NetworkDownloadRewardsFileRequest",
+			NewRequest: func() interface{} { return &api.NetworkDownloadRewardsFileRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NetworkDownloadRewardsFileRequest)
+				if err := apiutils.ValidatePositiveUint("interval", r.Interval); err != nil {
+					return nil, err
+				}
+				return downloadRewardsFile(c, r.Interval)
+			},
+		},
+		{
+			Path:  name + "/is-atlas-deployed",
+			Usage: "Checks if Atlas has been deployed yet.",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return isAtlasDeployed(c)
+			},
+		},
+		{
+			Path:  name + "/latest-delegate",
+			Usage: "Get the address of the latest minipool delegate contract.",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getLatestDelegate(c)
+			},
+		},
+	}
+}
@@ -0,0 +1,110 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rocket-pool/rocketpool-go/rewards"
+	"github.com/rocket-pool/smartnode/shared/services"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/eth1"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+	"github.com/urfave/cli"
+)
+
+func verifyRewardsTree(c *cli.Context, index uint64) (*api.NetworkVerifyRewardsTreeResponse, error) {
+
+	// Get services
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NetworkVerifyRewardsTreeResponse{}
+
+	// Make sure the interval has been finalized
+	currentIndexBig, err := rewards.GetRewardIndex(rp, nil)
+	if err != nil {
+		return nil, err
+	}
+	if currentIndexBig.Uint64() <= index {
+		return nil, fmt.Errorf("the current active rewards period is interval %d, so interval %d cannot be verified yet", currentIndexBig.Uint64(), index)
+	}
+
+	// Find the event for this interval
+	rewardsEvent, err := rprewards.GetRewardSnapshotEvent(rp, cfg, index, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting event for interval %d: %w", index, err)
+	}
+	response.CanonicalCID = rewardsEvent.MerkleTreeCID
+
+	// Get the canonical files
+	canonicalFile, _, err := rprewards.FetchRewardsFile(cfg, index, rewardsEvent.MerkleTreeCID, rewardsEvent.MerkleRoot)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading the canonical rewards file for interval %d:\n%w", index, err)
+	}
+	var canonicalPerformanceFile rprewards.IMinipoolPerformanceFile
+	performanceCid := canonicalFile.GetHeader().MinipoolPerformanceFileCID
+	if performanceCid != "" && performanceCid != "---" {
+		canonicalPerformanceFile, err = rprewards.FetchMinipoolPerformanceFile(cfg, index, performanceCid)
+		if err != nil {
+			return nil, fmt.Errorf("error downloading the canonical minipool performance file for interval %d:\n%w", index, err)
+		}
+	}
+
+	// Get a client that can provide the state at the end of the interval; progress is logged to stderr so it doesn't interfere with the response
+	logger := log.NewColorLogger(NormalLogger)
+	elBlockHeader, err := rp.Client.HeaderByNumber(context.Background(), rewardsEvent.ExecutionBlock)
+	if err != nil {
+		return nil, fmt.Errorf("error getting execution block %s: %w", rewardsEvent.ExecutionBlock.String(), err)
+	}
+	client, err := eth1.GetBestApiClient(rp, cfg, func(message string) { logger.Println(message) }, elBlockHeader.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	// Regenerate the tree
+	generationPrefix := fmt.Sprintf("[Interval %d Verification]", index)
+	m, err := state.NewNetworkStateManager(client, cfg, client.Client, bc, &logger)
+	if err != nil {
+		return nil, fmt.Errorf("error creating network state manager: %w", err)
+	}
+	networkState, err := m.GetStateForSlot(rewardsEvent.ConsensusBlock.Uint64())
+	if err != nil {
+		return nil, fmt.Errorf("error getting state for beacon slot %d: %w", rewardsEvent.ConsensusBlock.Uint64(), err)
+	}
+	treegen, err := rprewards.NewTreeGenerator(&logger, generationPrefix, client, cfg, bc, index, rewardsEvent.IntervalStartTime, rewardsEvent.IntervalEndTime, rewardsEvent.ConsensusBlock.Uint64(), elBlockHeader, rewardsEvent.IntervalsPassed.Uint64(), networkState, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Merkle tree generator: %w", err)
+	}
+	generatedFile, err := treegen.GenerateTree()
+	if err != nil {
+		return nil, fmt.Errorf("error generating Merkle tree: %w", err)
+	}
+
+	// Compare them
+	var generatedPerformanceFile rprewards.IMinipoolPerformanceFile
+	if canonicalPerformanceFile != nil {
+		generatedPerformanceFile = generatedFile.GetMinipoolPerformanceFile()
+	}
+	response.Comparison = rprewards.CompareRewardsFiles(canonicalFile, canonicalPerformanceFile, generatedFile, generatedPerformanceFile)
+	return &response, nil
+
+}
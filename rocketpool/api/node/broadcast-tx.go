@@ -0,0 +1,59 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func broadcastTransaction(c *cli.Context, signedTx string) (*api.BroadcastTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.BroadcastTransactionResponse{}
+
+	// Decode the transaction
+	txBytes, err := hexutil.Decode(signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing signed transaction [%s]: %w", signedTx, err)
+	}
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(txBytes); err != nil {
+		return nil, fmt.Errorf("Error decoding signed transaction: %w", err)
+	}
+
+	// Make sure it's for this network
+	chainID := big.NewInt(int64(cfg.Smartnode.GetChainID()))
+	if tx.ChainId().Cmp(chainID) != 0 {
+		return nil, fmt.Errorf("The transaction is for chain ID %s, but this node is configured for chain ID %s.", tx.ChainId().String(), chainID.String())
+	}
+
+	// Send it
+	if err := ec.SendTransaction(context.Background(), &tx); err != nil {
+		return nil, fmt.Errorf("Error broadcasting transaction: %w", err)
+	}
+	response.TxHash = tx.Hash()
+
+	// Return response
+	return &response, nil
+
+}
@@ -225,7 +225,7 @@ func canClaimRewards(c *cli.Context, indicesString string) (*api.CanNodeClaimRew
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -273,7 +273,7 @@ func claimRewards(c *cli.Context, indicesString string) (*api.NodeClaimRewardsRe
 	}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -331,7 +331,7 @@ func canClaimAndStakeRewards(c *cli.Context, indicesString string, stakeAmount *
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -373,7 +373,7 @@ func claimAndStakeRewards(c *cli.Context, indicesString string, stakeAmount *big
 	}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
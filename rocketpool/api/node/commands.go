@@ -627,11 +627,11 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Name:      "deposit",
 				Aliases:   []string{"d"},
 				Usage:     "Make a deposit and create a minipool, or just make and sign the transaction (when submit = false)",
-				UsageText: "rocketpool api node deposit amount min-fee salt use-credit-balance submit",
+				UsageText: "rocketpool api node deposit amount min-fee salt use-credit-balance skip-liveness-check submit",
 				Action: func(c *cli.Context) error {
 
 					// Validate args
-					if err := cliutils.ValidateArgCount(c, 5); err != nil {
+					if err := cliutils.ValidateArgCount(c, 6); err != nil {
 						return err
 					}
 					amountWei, err := cliutils.ValidatePositiveWeiAmount("deposit amount", c.Args().Get(0))
@@ -650,13 +650,17 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					if err != nil {
 						return err
 					}
-					submit, err := cliutils.ValidateBool("submit", c.Args().Get(4))
+					skipLivenessCheck, err := cliutils.ValidateBool("skip-liveness-check", c.Args().Get(4))
+					if err != nil {
+						return err
+					}
+					submit, err := cliutils.ValidateBool("submit", c.Args().Get(5))
 					if err != nil {
 						return err
 					}
 
 					// Run
-					response, err := nodeDeposit(c, amountWei, minNodeFee, salt, useCreditBalance, submit)
+					response, err := nodeDeposit(c, amountWei, minNodeFee, salt, useCreditBalance, skipLivenessCheck, submit)
 					if submit {
 						api.PrintResponse(response, err)
 					} // else nodeDeposit already printed the encoded transaction
@@ -830,6 +834,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "estimate-smoothing-pool-rewards",
+				Usage:     "Estimate the node's Smoothing Pool and collateral rewards for the current interval so far",
+				UsageText: "rocketpool api node estimate-smoothing-pool-rewards",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(estimateSmoothingPoolRewards(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "deposit-contract-info",
 				Usage:     "Get information about the deposit contract specified by Rocket Pool and the Beacon Chain client",
@@ -848,6 +870,25 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "broadcast-tx",
+				Usage:     "Broadcast a transaction that was signed on another machine. The TX must be serialized as a hex string.",
+				UsageText: "rocketpool api node broadcast-tx signed-tx",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					signedTx := c.Args().Get(0)
+
+					// Run
+					api.PrintResponse(broadcastTransaction(c, signedTx))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "sign",
 				Usage:     "Signs a transaction with the node's private key. The TX must be serialized as a hex string.",
@@ -888,6 +929,50 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "sign-typed-data",
+				Usage:     "Signs EIP-712 typed data with the node's private key.",
+				UsageText: "rocketpool api node sign-typed-data 'typed-data-json' allow-no-chain-id",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+
+					data := c.Args().Get(0)
+					allowNoChainID, err := cliutils.ValidateBool("allow-no-chain-id", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(signTypedData(c, data, allowNoChainID))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "sign-siwe",
+				Usage:     "Signs a Sign-In with Ethereum (EIP-4361) message with the node's private key.",
+				UsageText: "rocketpool api node sign-siwe 'message'",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					message := c.Args().Get(0)
+
+					// Run
+					api.PrintResponse(signSiweMessage(c, message))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "estimate-set-snapshot-delegate-gas",
 				Usage:     "Estimate the gas required to set a voting snapshot delegate",
@@ -1467,6 +1552,66 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+
+			{
+				Name:      "tx-list",
+				Usage:     "Get the node's transactions that haven't been mined yet",
+				UsageText: "rocketpool api node tx-list",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getPendingTransactions(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "can-cancel-tx",
+				Usage:     "Check whether a pending transaction can be cancelled",
+				UsageText: "rocketpool api node can-cancel-tx nonce",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					nonce, err := cliutils.ValidateUint("nonce", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canCancelTransaction(c, nonce))
+					return nil
+
+				},
+			},
+			{
+				Name:      "cancel-tx",
+				Usage:     "Cancel a pending transaction by replacing it with an empty transfer to the node account",
+				UsageText: "rocketpool api node cancel-tx nonce",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					nonce, err := cliutils.ValidateUint("nonce", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(cancelTransaction(c, nonce))
+					return nil
+
+				},
+			},
 		},
 	})
 }
@@ -172,7 +172,7 @@ func canNodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +214,16 @@ func canNodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt
 	pubKey := rptypes.BytesToValidatorPubkey(depositData.PublicKey)
 	signature := rptypes.BytesToValidatorSignature(depositData.Signature)
 
+	// Make sure the new validator key isn't already attesting somewhere else, which can happen if this wallet was restored on another machine
+	liveValidators, err := validator.GetLiveValidators(bc, []rptypes.ValidatorPubkey{pubKey}, validator.LivenessCheckEpochs)
+	if err != nil {
+		return nil, fmt.Errorf("error checking if the new validator key is already attesting: %w", err)
+	}
+	if epoch, isLive := liveValidators[pubKey]; isLive {
+		response.ValidatorKeyIsLive = true
+		response.LastAttestationEpoch = epoch
+	}
+
 	// Do a final sanity check
 	err = validateDepositInfo(eth2Config, uint64(depositAmount), pubKey, withdrawalCredentials, signature)
 	if err != nil {
@@ -257,7 +267,7 @@ func canNodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt
 
 }
 
-func nodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt *big.Int, useCreditBalance bool, submit bool) (*api.NodeDepositResponse, error) {
+func nodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt *big.Int, useCreditBalance bool, skipLivenessCheck bool, submit bool) (*api.NodeDepositResponse, error) {
 
 	// Get services
 	if err := services.RequireNodeRegistered(c); err != nil {
@@ -327,7 +337,7 @@ func nodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt *b
 	response.ScrubPeriod = scrubPeriod
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -349,6 +359,22 @@ func nodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt *b
 		opts.Value = amountWei
 	}
 
+	// Make sure the new validator key isn't already attesting somewhere else before saving it, which can happen if this wallet was restored on another machine
+	if !skipLivenessCheck {
+		nextKey, err := w.GetNextValidatorKey()
+		if err != nil {
+			return nil, err
+		}
+		nextPubkey := rptypes.BytesToValidatorPubkey(nextKey.PublicKey().Marshal())
+		liveValidators, err := validator.GetLiveValidators(bc, []rptypes.ValidatorPubkey{nextPubkey}, validator.LivenessCheckEpochs)
+		if err != nil {
+			return nil, fmt.Errorf("error checking if the new validator key is already attesting: %w", err)
+		}
+		if epoch, isLive := liveValidators[nextPubkey]; isLive {
+			return nil, fmt.Errorf("the new validator key %s attested in epoch %d, so it's already running in another Validator Client and using it for a minipool would get it slashed; if you're certain it isn't running anywhere else, you can skip this check with --skip-liveness-check", nextPubkey.Hex(), epoch)
+		}
+	}
+
 	// Create and save a new validator key
 	validatorKey, err := w.CreateValidatorKey()
 	if err != nil {
@@ -81,7 +81,7 @@ func getInitializeFeeDistributorGas(c *cli.Context) (*api.NodeInitializeFeeDistr
 	response.Distributor = distributor
 
 	// Get gas estimates
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -102,10 +102,6 @@ func initializeFeeDistributor(c *cli.Context) (*api.NodeInitializeFeeDistributor
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -115,7 +111,7 @@ func initializeFeeDistributor(c *cli.Context) (*api.NodeInitializeFeeDistributor
 	response := api.NodeInitializeFeeDistributorResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -196,7 +192,7 @@ func canDistribute(c *cli.Context) (*api.NodeCanDistributeResponse, error) {
 	// Get gas estimates
 	wg.Go(func() error {
 		var err error
-		opts, err := w.GetNodeAccountTransactor()
+		opts, err := services.GetNodeAccountTransactor(c)
 		if err != nil {
 			return err
 		}
@@ -254,7 +250,7 @@ func distribute(c *cli.Context) (*api.NodeDistributeResponse, error) {
 	}
 
 	// Get gas estimates
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
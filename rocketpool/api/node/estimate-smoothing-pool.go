@@ -0,0 +1,189 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/rocket-pool/rocketpool-go/rewards"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+func estimateSmoothingPoolRewards(c *cli.Context) (*api.NodeEstimateSmoothingPoolRewardsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeEstimateSmoothingPoolRewardsResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the state of the whole network, since the estimate depends on every other node as well; progress is logged to stderr
+	logger := log.NewColorLogger(color.FgWhite)
+	m, err := state.NewNetworkStateManager(rp, cfg, rp.Client, bc, &logger)
+	if err != nil {
+		return nil, fmt.Errorf("error creating network state manager: %w", err)
+	}
+	networkState, err := m.GetHeadState()
+	if err != nil {
+		return nil, fmt.Errorf("error getting network state: %w", err)
+	}
+	elBlockHeader, err := rp.Client.HeaderByNumber(context.Background(), big.NewInt(0).SetUint64(networkState.ElBlockNumber))
+	if err != nil {
+		return nil, fmt.Errorf("error getting execution block %d: %w", networkState.ElBlockNumber, err)
+	}
+
+	// Get the current interval's timing, treating the state's slot as the end of the interval
+	beaconConfig := networkState.BeaconConfig
+	genesisTime := time.Unix(int64(beaconConfig.GenesisTime), 0)
+	slotTime := genesisTime.Add(time.Duration(networkState.BeaconSlotNumber*beaconConfig.SecondsPerSlot) * time.Second)
+	currentIndex := networkState.NetworkDetails.RewardIndex
+	startTime := networkState.NetworkDetails.IntervalStart
+	intervalTime := networkState.NetworkDetails.IntervalDuration
+	intervalsPassed := slotTime.Sub(startTime) / intervalTime
+
+	response.Index = currentIndex
+	response.IntervalStartTime = startTime
+	response.IntervalEndTime = startTime.Add(intervalTime)
+	response.EstimateTime = slotTime
+	if nodeDetails, exists := networkState.NodeDetailsByAddress[nodeAccount.Address]; exists {
+		response.IsOptedIn = nodeDetails.SmoothingPoolRegistrationState
+	}
+
+	// Use the rolling record saved by the watchtower if there's one for this interval
+	rollingRecord, err := getCachedRollingRecord(c, m, currentIndex, networkState.BeaconSlotNumber)
+	if err != nil {
+		logger.Printlnf("WARNING: couldn't load a rolling record, assuming perfect attestation performance instead: %s", err.Error())
+	}
+	if rollingRecord != nil {
+		response.UsedRollingRecord = true
+		response.RollingRecordSlot = rollingRecord.LastDutiesSlot
+	}
+
+	// Estimate the rewards
+	treegen, err := rprewards.NewTreeGenerator(&logger, "[Estimate]", rp, cfg, bc, currentIndex, startTime, slotTime, networkState.BeaconSlotNumber, elBlockHeader, uint64(intervalsPassed), networkState, rollingRecord)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Merkle tree generator: %w", err)
+	}
+	rewardsFile, err := treegen.EstimateRewards()
+	if err != nil {
+		return nil, fmt.Errorf("error estimating rewards: %w", err)
+	}
+	response.RulesetVersion = treegen.GetGeneratorRulesetVersion()
+
+	// Get the totals and our node's share
+	totals := rewardsFile.GetHeader().TotalRewards
+	response.SmoothingPoolBalance = &totals.TotalSmoothingPoolEth.Int
+	response.PoolStakerSmoothingPoolEth = &totals.PoolStakerSmoothingPoolEth.Int
+	response.NodeOperatorSmoothingPoolEth = &totals.NodeOperatorSmoothingPoolEth.Int
+	response.TotalCollateralRpl = &totals.TotalCollateralRpl.Int
+	response.NodeSmoothingPoolEth = big.NewInt(0)
+	response.NodeCollateralRpl = big.NewInt(0)
+	nodeRewards, exists := rewardsFile.GetNodeRewardsInfo(nodeAccount.Address)
+	if exists {
+		response.NodeSmoothingPoolEth = &nodeRewards.GetSmoothingPoolEth().Int
+		response.NodeCollateralRpl = &nodeRewards.GetCollateralRpl().Int
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Load the latest rolling record for the current interval from disk, if the watchtower has saved one
+func getCachedRollingRecord(c *cli.Context, m *state.NetworkStateManager, currentIndex uint64, targetSlot uint64) (*rprewards.RollingRecord, error) {
+
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rolling records don't exist for the first interval, or if the watchtower never saved any
+	if currentIndex == 0 {
+		return nil, nil
+	}
+	_, err = os.Stat(cfg.Smartnode.GetRecordsPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error checking rolling records folder: %w", err)
+	}
+
+	// Get the start slot of the current interval
+	beaconConfig, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, fmt.Errorf("error getting beacon config: %w", err)
+	}
+	found, event, err := rewards.GetRewardsEvent(rp, currentIndex-1, cfg.Smartnode.GetPreviousRewardsPoolAddresses(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting event for rewards interval %d: %w", currentIndex-1, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("event for rewards interval %d not found", currentIndex-1)
+	}
+	startSlot, err := rprewards.GetStartSlotForInterval(event, bc, beaconConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error getting start slot for interval %d: %w", currentIndex, err)
+	}
+
+	// Load the record
+	logger := log.NewColorLogger(color.FgWhite)
+	recordMgr, err := rprewards.NewRollingRecordManager(&logger, &logger, cfg, rp, bc, m, startSlot, beaconConfig, currentIndex)
+	if err != nil {
+		return nil, fmt.Errorf("error creating rolling record manager: %w", err)
+	}
+	record, err := recordMgr.LoadBestRecordFromDisk(startSlot, targetSlot, currentIndex)
+	if err != nil {
+		return nil, fmt.Errorf("error loading rolling record: %w", err)
+	}
+
+	// A fresh record hasn't processed any duties yet, so it's no better than assuming perfect performance
+	if record.LastDutiesSlot < startSlot {
+		return nil, nil
+	}
+	return record, nil
+
+}
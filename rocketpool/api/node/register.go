@@ -62,7 +62,7 @@ func canRegisterNode(c *cli.Context, timezoneLocation string) (*api.CanRegisterN
 
 	// Get gas estimate
 	wg.Go(func() error {
-		opts, err := w.GetNodeAccountTransactor()
+		opts, err := services.GetNodeAccountTransactor(c)
 		if err != nil {
 			return err
 		}
@@ -93,10 +93,6 @@ func registerNode(c *cli.Context, timezoneLocation string) (*api.RegisterNodeRes
 	if err := services.RequireRocketStorage(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -106,7 +102,7 @@ func registerNode(c *cli.Context, timezoneLocation string) (*api.RegisterNodeRes
 	response := api.RegisterNodeResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,747 @@
+package node
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Get the routes the daemon's API server serves for the node commands
+func GetRoutes(name string) []apiutils.Route {
+	return []apiutils.Route{
+		{
+			Path:  name + "/status",
+			Usage: "Get the node's status",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getStatus(c)
+			},
+		},
+		{
+			Path:  name + "/sync",
+			Usage: "Get the sync progress of the eth1 and eth2 clients",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getSyncProgress(c)
+			},
+		},
+		{
+			Path:       name + "/can-register",
+			Usage:      "Check whether the node can be registered with Rocket Pool; takes a NodeTimezoneRequest",
+			NewRequest: func() interface{} { return &api.NodeTimezoneRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeTimezoneRequest)
+				timezoneLocation, err := cliutils.ValidateTimezoneLocation("timezone location", r.TimezoneLocation)
+				if err != nil {
+					return nil, err
+				}
+				return canRegisterNode(c, timezoneLocation)
+			},
+		},
+		{
+			Path:       name + "/register",
+			Usage:      "Register the node with Rocket Pool; takes a NodeTimezoneRequest",
+			NewRequest: func() interface{} { return &api.NodeTimezoneRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeTimezoneRequest)
+				timezoneLocation, err := cliutils.ValidateTimezoneLocation("timezone location", r.TimezoneLocation)
+				if err != nil {
+					return nil, err
+				}
+				return registerNode(c, timezoneLocation)
+			},
+		},
+		{
+			Path:       name + "/can-set-withdrawal-address",
+			Usage:      "Checks if the node can set its withdrawal address; takes a NodeSetWithdrawalAddressRequest",
+			NewRequest: func() interface{} { return &api.NodeSetWithdrawalAddressRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeSetWithdrawalAddressRequest)
+				return canSetWithdrawalAddress(c, r.WithdrawalAddress, r.Confirm)
+			},
+		},
+		{
+			Path:       name + "/set-withdrawal-address",
+			Usage:      "Set the node's withdrawal address; takes a NodeSetWithdrawalAddressRequest",
+			NewRequest: func() interface{} { return &api.NodeSetWithdrawalAddressRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeSetWithdrawalAddressRequest)
+				return setWithdrawalAddress(c, r.WithdrawalAddress, r.Confirm)
+			},
+		},
+		{
+			Path:       name + "/can-confirm-withdrawal-address",
+			Usage:      "Checks if the node can confirm its withdrawal address; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return canConfirmWithdrawalAddress(c)
+			},
+		},
+		{
+			Path:       name + "/confirm-withdrawal-address",
+			Usage:      "Confirms the node's withdrawal address if it was set back to the node address; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return confirmWithdrawalAddress(c)
+			},
+		},
+		{
+			Path:       name + "/can-set-timezone",
+			Usage:      "Checks if the node can set its timezone location; takes a NodeTimezoneRequest",
+			NewRequest: func() interface{} { return &api.NodeTimezoneRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeTimezoneRequest)
+				timezoneLocation, err := cliutils.ValidateTimezoneLocation("timezone location", r.TimezoneLocation)
+				if err != nil {
+					return nil, err
+				}
+				return canSetTimezoneLocation(c, timezoneLocation)
+			},
+		},
+		{
+			Path:       name + "/set-timezone",
+			Usage:      "Set the node's timezone location; takes a NodeTimezoneRequest",
+			NewRequest: func() interface{} { return &api.NodeTimezoneRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeTimezoneRequest)
+				timezoneLocation, err := cliutils.ValidateTimezoneLocation("timezone location", r.TimezoneLocation)
+				if err != nil {
+					return nil, err
+				}
+				return setTimezoneLocation(c, timezoneLocation)
+			},
+		},
+		{
+			Path:       name + "/can-swap-rpl",
+			Usage:      "Check whether the node can swap old RPL for new RPL; takes a NodeRplAmountRequest",
+			NewRequest: func() interface{} { return &api.NodeRplAmountRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeRplAmountRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("swap amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				return canNodeSwapRpl(c, r.AmountWei)
+			},
+		},
+		{
+			Path:       name + "/swap-rpl-approve-rpl",
+			Usage:      "Approve fixed-supply RPL for swapping to new RPL; takes a NodeRplAmountRequest",
+			NewRequest: func() interface{} { return &api.NodeRplAmountRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeRplAmountRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("swap amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				return approveFsRpl(c, r.AmountWei)
+			},
+		},
+		{
+			Path:       name + "/wait-and-swap-rpl",
+			Usage:      "Swap old RPL for new RPL, waiting for the approval TX hash to be included in a block first; takes a NodeWaitForRplApprovalRequest",
+			NewRequest: func() interface{} { return &api.NodeWaitForRplApprovalRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeWaitForRplApprovalRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("swap amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				return waitForApprovalAndSwapFsRpl(c, r.AmountWei, r.Hash)
+			},
+		},
+		{
+			Path:       name + "/get-swap-rpl-approval-gas",
+			Usage:      "Estimate the gas cost of legacy RPL interaction approval; takes a NodeRplAmountRequest",
+			NewRequest: func() interface{} { return &api.NodeRplAmountRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeRplAmountRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("approve amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				return getSwapApprovalGas(c, r.AmountWei)
+			},
+		},
+		{
+			Path:  name + "/swap-rpl-allowance",
+			Usage: "Get the node's legacy RPL allowance for new RPL contract",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return allowanceFsRpl(c)
+			},
+		},
+		{
+			Path:       name + "/swap-rpl",
+			Usage:      "Swap old RPL for new RPL; takes a NodeRplAmountRequest",
+			NewRequest: func() interface{} { return &api.NodeRplAmountRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeRplAmountRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("swap amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				return swapRpl(c, r.AmountWei)
+			},
+		},
+		{
+			Path:       name + "/can-stake-rpl",
+			Usage:      "Check whether the node can stake RPL; takes a NodeRplAmountRequest",
+			NewRequest: func() interface{} { return &api.NodeRplAmountRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeRplAmountRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("stake amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				return canNodeStakeRpl(c, r.AmountWei)
+			},
+		},
+		{
+			Path:       name + "/stake-rpl-approve-rpl",
+			Usage:      "Approve RPL for staking against the node; takes a NodeRplAmountRequest",
+			NewRequest: func() interface{} { return &api.NodeRplAmountRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeRplAmountRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("stake amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				return approveRpl(c, r.AmountWei)
+			},
+		},
+		{
+			Path:       name + "/wait-and-stake-rpl",
+			Usage:      "Stake RPL against the node, waiting for approval tx-hash to be included in a block first; takes a NodeWaitForRplApprovalRequest",
+			NewRequest: func() interface{} { return &api.NodeWaitForRplApprovalRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeWaitForRplApprovalRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("stake amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				return waitForApprovalAndStakeRpl(c, r.AmountWei, r.Hash)
+			},
+		},
+		{
+			Path:       name + "/get-stake-rpl-approval-gas",
+			Usage:      "Estimate the gas cost of new RPL interaction approval; takes a NodeRplAmountRequest",
+			NewRequest: func() interface{} { return &api.NodeRplAmountRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeRplAmountRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("approve amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				return getStakeApprovalGas(c, r.AmountWei)
+			},
+		},
+		{
+			Path:  name + "/stake-rpl-allowance",
+			Usage: "Get the node's RPL allowance for the staking contract",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return allowanceRpl(c)
+			},
+		},
+		{
+			Path:       name + "/stake-rpl",
+			Usage:      "Stake RPL against the node; takes a NodeRplAmountRequest",
+			NewRequest: func() interface{} { return &api.NodeRplAmountRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeRplAmountRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("stake amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				return stakeRpl(c, r.AmountWei)
+			},
+		},
+		{
+			Path:       name + "/can-set-stake-rpl-for-allowed",
+			Usage:      "Check whether the node can set allowed status for an address to stake RPL on behalf of themself; takes a NodeStakeRplForAllowedRequest",
+			NewRequest: func() interface{} { return &api.NodeStakeRplForAllowedRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeStakeRplForAllowedRequest)
+				return canSetStakeRplForAllowed(c, r.CallerAddress, r.Allowed)
+			},
+		},
+		{
+			Path:       name + "/set-stake-rpl-for-allowed",
+			Usage:      "Sets the allowed status for an address to stake RPL on behalf of your node; takes a NodeStakeRplForAllowedRequest",
+			NewRequest: func() interface{} { return &api.NodeStakeRplForAllowedRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeStakeRplForAllowedRequest)
+				return setStakeRplForAllowed(c, r.CallerAddress, r.Allowed)
+			},
+		},
+		{
+			Path:       name + "/can-withdraw-rpl",
+			Usage:      "Check whether the node can withdraw staked RPL; takes a NodeRplAmountRequest",
+			NewRequest: func() interface{} { return &api.NodeRplAmountRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeRplAmountRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("withdrawal amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				return canNodeWithdrawRpl(c, r.AmountWei)
+			},
+		},
+		{
+			Path:       name + "/withdraw-rpl",
+			Usage:      "Withdraw RPL staked against the node; takes a NodeRplAmountRequest",
+			NewRequest: func() interface{} { return &api.NodeRplAmountRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeRplAmountRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("withdrawal amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				return nodeWithdrawRpl(c, r.AmountWei)
+			},
+		},
+		{
+			Path:       name + "/can-deposit",
+			Usage:      "Check whether the node can make a deposit; takes a NodeCanDepositRequest",
+			NewRequest: func() interface{} { return &api.NodeCanDepositRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeCanDepositRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("deposit amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				if err := apiutils.ValidateFraction("minimum node fee", r.MinNodeFee); err != nil {
+					return nil, err
+				}
+				if err := apiutils.ValidateBigInt("salt", r.Salt); err != nil {
+					return nil, err
+				}
+				return canNodeDeposit(c, r.AmountWei, r.MinNodeFee, r.Salt)
+			},
+		},
+		{
+			Path:       name + "/deposit",
+			Usage:      "Make a deposit and create a minipool; takes a NodeDepositRequest",
+			NewRequest: func() interface{} { return &api.NodeDepositRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeDepositRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("deposit amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				if err := apiutils.ValidateFraction("minimum node fee", r.MinNodeFee); err != nil {
+					return nil, err
+				}
+				if err := apiutils.ValidateBigInt("salt", r.Salt); err != nil {
+					return nil, err
+				}
+				return nodeDeposit(c, r.AmountWei, r.MinNodeFee, r.Salt, r.UseCreditBalance, r.SkipLivenessCheck, true)
+			},
+		},
+		{
+			Path:       name + "/can-send",
+			Usage:      "Check whether the node can send ETH or tokens to an address; takes a NodeSendRequest",
+			NewRequest: func() interface{} { return &api.NodeSendRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeSendRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("send amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				token, err := cliutils.ValidateTokenType("token type", r.Token)
+				if err != nil {
+					return nil, err
+				}
+				return canNodeSend(c, r.AmountWei, token, r.ToAddress)
+			},
+		},
+		{
+			Path:       name + "/send",
+			Usage:      "Send ETH or tokens from the node account to an address; takes a NodeSendRequest",
+			NewRequest: func() interface{} { return &api.NodeSendRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeSendRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("send amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				token, err := cliutils.ValidateTokenType("token type", r.Token)
+				if err != nil {
+					return nil, err
+				}
+				return nodeSend(c, r.AmountWei, token, r.ToAddress)
+			},
+		},
+		{
+			Path:       name + "/can-burn",
+			Usage:      "Check whether the node can burn tokens for ETH; takes a NodeBurnRequest",
+			NewRequest: func() interface{} { return &api.NodeBurnRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeBurnRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("burn amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				token, err := cliutils.ValidateBurnableTokenType("token type", r.Token)
+				if err != nil {
+					return nil, err
+				}
+				return canNodeBurn(c, r.AmountWei, token)
+			},
+		},
+		{
+			Path:       name + "/burn",
+			Usage:      "Burn tokens for ETH; takes a NodeBurnRequest",
+			NewRequest: func() interface{} { return &api.NodeBurnRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeBurnRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("burn amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				token, err := cliutils.ValidateBurnableTokenType("token type", r.Token)
+				if err != nil {
+					return nil, err
+				}
+				return nodeBurn(c, r.AmountWei, token)
+			},
+		},
+		{
+			Path:       name + "/can-claim-rpl-rewards",
+			Usage:      "Check whether the node has RPL rewards available to claim; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return canNodeClaimRpl(c)
+			},
+		},
+		{
+			Path:       name + "/claim-rpl-rewards",
+			Usage:      "Claim available RPL rewards; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return nodeClaimRpl(c)
+			},
+		},
+		{
+			Path:  name + "/rewards",
+			Usage: "Get RPL rewards info",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getRewards(c)
+			},
+		},
+		{
+			Path:  name + "/estimate-smoothing-pool-rewards",
+			Usage: "Estimate the node's Smoothing Pool and collateral rewards for the current interval so far",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return estimateSmoothingPoolRewards(c)
+			},
+		},
+		{
+			Path:  name + "/deposit-contract-info",
+			Usage: "Get information about the deposit contract specified by Rocket Pool and the Beacon Chain client",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getDepositContractInfo(c)
+			},
+		},
+		{
+			Path:       name + "/broadcast-tx",
+			Usage:      "Broadcast a transaction that was signed on another machine. The TX must be serialized as a hex string.; takes a NodeBroadcastTransactionRequest",
+			NewRequest: func() interface{} { return &api.NodeBroadcastTransactionRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeBroadcastTransactionRequest)
+				return broadcastTransaction(c, r.SignedTx)
+			},
+		},
+		{
+			Path:       name + "/sign",
+			Usage:      "Signs a transaction with the node's private key. The TX must be serialized as a hex string.; takes a NodeSignRequest",
+			NewRequest: func() interface{} { return &api.NodeSignRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeSignRequest)
+				return sign(c, r.Data)
+			},
+		},
+		{
+			Path:       name + "/sign-message",
+			Usage:      "Signs an arbitrary message with the node's private key.; takes a NodeSignMessageRequest",
+			NewRequest: func() interface{} { return &api.NodeSignMessageRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeSignMessageRequest)
+				return signMessage(c, r.Message)
+			},
+		},
+		{
+			Path:       name + "/sign-typed-data",
+			Usage:      "Signs EIP-712 typed data with the node's private key.; takes a NodeSignTypedDataRequest",
+			NewRequest: func() interface{} { return &api.NodeSignTypedDataRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeSignTypedDataRequest)
+				return signTypedData(c, r.Data, r.AllowNoChainID)
+			},
+		},
+		{
+			Path:       name + "/sign-siwe",
+			Usage:      "Signs a Sign-In with Ethereum (EIP-4361) message with the node's private key.; takes a NodeSignMessageRequest",
+			NewRequest: func() interface{} { return &api.NodeSignMessageRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeSignMessageRequest)
+				return signSiweMessage(c, r.Message)
+			},
+		},
+		{
+			Path:       name + "/estimate-set-snapshot-delegate-gas",
+			Usage:      "Estimate the gas required to set a voting snapshot delegate; takes a NodeSetSnapshotDelegateRequest",
+			NewRequest: func() interface{} { return &api.NodeSetSnapshotDelegateRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeSetSnapshotDelegateRequest)
+				return estimateSetSnapshotDelegateGas(c, r.Delegate)
+			},
+		},
+		{
+			Path:       name + "/set-snapshot-delegate",
+			Usage:      "Set a voting snapshot delegate for the node; takes a NodeSetSnapshotDelegateRequest",
+			NewRequest: func() interface{} { return &api.NodeSetSnapshotDelegateRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeSetSnapshotDelegateRequest)
+				return setSnapshotDelegate(c, r.Delegate)
+			},
+		},
+		{
+			Path:       name + "/estimate-clear-snapshot-delegate-gas",
+			Usage:      "Estimate the gas required to clear the node's voting snapshot delegate; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return estimateClearSnapshotDelegateGas(c)
+			},
+		},
+		{
+			Path:       name + "/clear-snapshot-delegate",
+			Usage:      "Clear the node's voting snapshot delegate; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return clearSnapshotDelegate(c)
+			},
+		},
+		{
+			Path:  name + "/is-fee-distributor-initialized",
+			Usage: "Check if the fee distributor contract for this node is initialized and deployed",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return isFeeDistributorInitialized(c)
+			},
+		},
+		{
+			Path:       name + "/get-initialize-fee-distributor-gas",
+			Usage:      "Estimate the cost of initializing the fee distributor; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getInitializeFeeDistributorGas(c)
+			},
+		},
+		{
+			Path:       name + "/initialize-fee-distributor",
+			Usage:      "Initialize and deploy the fee distributor contract for this node; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return initializeFeeDistributor(c)
+			},
+		},
+		{
+			Path:       name + "/can-distribute",
+			Usage:      "Check if distributing ETH from the node's fee distributor is possible; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return canDistribute(c)
+			},
+		},
+		{
+			Path:       name + "/distribute",
+			Usage:      "Distribute ETH from the node's fee distributor; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return distribute(c)
+			},
+		},
+		{
+			Path:  name + "/get-rewards-info",
+			Usage: "Get info about your eligible rewards periods, including balances and Merkle proofs",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getRewardsInfo(c)
+			},
+		},
+		{
+			Path:       name + "/can-claim-rewards",
+			Usage:      "Check if the rewards for the given intervals can be claimed; takes a NodeClaimRewardsRequest",
+			NewRequest: func() interface{} { return &api.NodeClaimRewardsRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeClaimRewardsRequest)
+				return canClaimRewards(c, joinIndices(r.Indices))
+			},
+		},
+		{
+			Path:       name + "/claim-rewards",
+			Usage:      "Claim rewards for the given reward intervals; takes a NodeClaimRewardsRequest",
+			NewRequest: func() interface{} { return &api.NodeClaimRewardsRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeClaimRewardsRequest)
+				return claimRewards(c, joinIndices(r.Indices))
+			},
+		},
+		{
+			Path:       name + "/can-claim-and-stake-rewards",
+			Usage:      "Check if the rewards for the given intervals can be claimed, and RPL restaked automatically; takes a NodeClaimAndStakeRewardsRequest",
+			NewRequest: func() interface{} { return &api.NodeClaimAndStakeRewardsRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeClaimAndStakeRewardsRequest)
+				if err := apiutils.ValidateBigInt("stakeAmount", r.StakeAmount); err != nil {
+					return nil, err
+				}
+				return canClaimAndStakeRewards(c, joinIndices(r.Indices), r.StakeAmount)
+			},
+		},
+		{
+			Path:       name + "/claim-and-stake-rewards",
+			Usage:      "Claim rewards for the given reward intervals and restake RPL automatically; takes a NodeClaimAndStakeRewardsRequest",
+			NewRequest: func() interface{} { return &api.NodeClaimAndStakeRewardsRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeClaimAndStakeRewardsRequest)
+				if err := apiutils.ValidateBigInt("stakeAmount", r.StakeAmount); err != nil {
+					return nil, err
+				}
+				return claimAndStakeRewards(c, joinIndices(r.Indices), r.StakeAmount)
+			},
+		},
+		{
+			Path:  name + "/get-smoothing-pool-registration-status",
+			Usage: "Check whether or not the node is opted into the Smoothing Pool",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getSmoothingPoolRegistrationStatus(c)
+			},
+		},
+		{
+			Path:       name + "/can-set-smoothing-pool-status",
+			Usage:      "Check if the node's Smoothing Pool status can be changed; takes a NodeSetSmoothingPoolStatusRequest",
+			NewRequest: func() interface{} { return &api.NodeSetSmoothingPoolStatusRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeSetSmoothingPoolStatusRequest)
+				return canSetSmoothingPoolStatus(c, r.Status)
+			},
+		},
+		{
+			Path:       name + "/set-smoothing-pool-status",
+			Usage:      "Sets the node's Smoothing Pool opt-in status; takes a NodeSetSmoothingPoolStatusRequest",
+			NewRequest: func() interface{} { return &api.NodeSetSmoothingPoolStatusRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeSetSmoothingPoolStatusRequest)
+				return setSmoothingPoolStatus(c, r.Status)
+			},
+		},
+		{
+			Path:       name + "/resolve-ens-name",
+			Usage:      "Resolve an ENS name; takes a NodeResolveEnsNameRequest",
+			NewRequest: func() interface{} { return &api.NodeResolveEnsNameRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeResolveEnsNameRequest)
+				return resolveEnsName(c, r.Name)
+			},
+		},
+		{
+			Path:       name + "/reverse-resolve-ens-name",
+			Usage:      "Reverse resolve an address to an ENS name; takes a NodeReverseResolveEnsNameRequest",
+			NewRequest: func() interface{} { return &api.NodeReverseResolveEnsNameRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeReverseResolveEnsNameRequest)
+				address, err := cliutils.ValidateAddress("address", r.Address)
+				if err != nil {
+					return nil, err
+				}
+				return reverseResolveEnsName(c, address)
+			},
+		},
+		{
+			Path:       name + "/can-create-vacant-minipool",
+			Usage:      "Check whether a vacant minipool can be created for solo staker migration; takes a NodeCreateVacantMinipoolRequest",
+			NewRequest: func() interface{} { return &api.NodeCreateVacantMinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeCreateVacantMinipoolRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("deposit amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				if err := apiutils.ValidateFraction("minimum node fee", r.MinNodeFee); err != nil {
+					return nil, err
+				}
+				if err := apiutils.ValidateBigInt("salt", r.Salt); err != nil {
+					return nil, err
+				}
+				return canCreateVacantMinipool(c, r.AmountWei, r.MinNodeFee, r.Salt, r.Pubkey)
+			},
+		},
+		{
+			Path:       name + "/create-vacant-minipool",
+			Usage:      "Create a vacant minipool, which can be used to migrate a solo staker; takes a NodeCreateVacantMinipoolRequest",
+			NewRequest: func() interface{} { return &api.NodeCreateVacantMinipoolRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeCreateVacantMinipoolRequest)
+				if err := apiutils.ValidatePositiveWeiAmount("deposit amount", r.AmountWei); err != nil {
+					return nil, err
+				}
+				if err := apiutils.ValidateFraction("minimum node fee", r.MinNodeFee); err != nil {
+					return nil, err
+				}
+				if err := apiutils.ValidateBigInt("salt", r.Salt); err != nil {
+					return nil, err
+				}
+				return createVacantMinipool(c, r.AmountWei, r.MinNodeFee, r.Salt, r.Pubkey)
+			},
+		},
+		{
+			Path:  name + "/check-collateral",
+			Usage: "Check if the node is above the minimum collateralization threshold, including pending bond reductions",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return checkCollateral(c)
+			},
+		},
+		{
+			Path:  name + "/get-eth-balance",
+			Usage: "Get the ETH balance of the node address",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getNodeEthBalance(c)
+			},
+		},
+		{
+			Path:       name + "/can-send-message",
+			Usage:      "Estimates the gas for sending a zero-value message with a payload; takes a NodeSendMessageRequest",
+			NewRequest: func() interface{} { return &api.NodeSendMessageRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeSendMessageRequest)
+				return canSendMessage(c, r.Address, r.Message)
+			},
+		},
+		{
+			Path:       name + "/send-message",
+			Usage:      "Sends a zero-value message with a payload; takes a NodeSendMessageRequest",
+			NewRequest: func() interface{} { return &api.NodeSendMessageRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeSendMessageRequest)
+				return sendMessage(c, r.Address, r.Message)
+			},
+		},
+		{
+			Path:  name + "/tx-list",
+			Usage: "Get the node's transactions that haven't been mined yet",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getPendingTransactions(c)
+			},
+		},
+		{
+			Path:       name + "/can-cancel-tx",
+			Usage:      "Check whether a pending transaction can be cancelled; takes a NodeCancelTransactionRequest",
+			NewRequest: func() interface{} { return &api.NodeCancelTransactionRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeCancelTransactionRequest)
+				return canCancelTransaction(c, r.Nonce)
+			},
+		},
+		{
+			Path:       name + "/cancel-tx",
+			Usage:      "Cancel a pending transaction by replacing it with an empty transfer to the node account; takes a NodeCancelTransactionRequest",
+			NewRequest: func() interface{} { return &api.NodeCancelTransactionRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.NodeCancelTransactionRequest)
+				return cancelTransaction(c, r.Nonce)
+			},
+		},
+	}
+}
+
+// Join reward interval indices into the list the claim handlers take
+func joinIndices(indices []uint64) string {
+	indexStrings := make([]string, len(indices))
+	for i, index := range indices {
+		indexStrings[i] = strconv.FormatUint(index, 10)
+	}
+	return strings.Join(indexStrings, ",")
+}
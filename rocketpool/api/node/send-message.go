@@ -18,10 +18,6 @@ func canSendMessage(c *cli.Context, address common.Address, message []byte) (*ap
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	ec, err := services.GetEthClient(c)
 	if err != nil {
 		return nil, err
@@ -31,7 +27,7 @@ func canSendMessage(c *cli.Context, address common.Address, message []byte) (*ap
 	response := api.CanNodeSendMessageResponse{}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +62,7 @@ func sendMessage(c *cli.Context, address common.Address, message []byte) (*api.N
 	response := api.NodeSendMessageResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +45,7 @@ func canNodeSend(c *cli.Context, amountWei *big.Int, token string, to common.Add
 	}
 
 	// Get the sending opts
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -219,7 +219,7 @@ func nodeSend(c *cli.Context, amountWei *big.Int, token string, to common.Addres
 	response := api.NodeSendResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
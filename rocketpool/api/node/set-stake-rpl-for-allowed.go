@@ -17,10 +17,6 @@ func canSetStakeRplForAllowed(c *cli.Context, caller common.Address, allowed boo
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -30,7 +26,7 @@ func canSetStakeRplForAllowed(c *cli.Context, caller common.Address, allowed boo
 	response := api.CanSetStakeRplForAllowedResponse{}
 
 	// Get gas estimates
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -53,10 +49,6 @@ func setStakeRplForAllowed(c *cli.Context, caller common.Address, allowed bool)
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -66,7 +58,7 @@ func setStakeRplForAllowed(c *cli.Context, caller common.Address, allowed bool)
 	response := api.SetStakeRplForAllowedResponse{}
 
 	// Stake RPL
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
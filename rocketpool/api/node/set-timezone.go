@@ -18,10 +18,6 @@ func canSetTimezoneLocation(c *cli.Context, timezoneLocation string) (*api.CanSe
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -31,7 +27,7 @@ func canSetTimezoneLocation(c *cli.Context, timezoneLocation string) (*api.CanSe
 	response := api.CanSetNodeTimezoneResponse{}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -51,10 +47,6 @@ func setTimezoneLocation(c *cli.Context, timezoneLocation string) (*api.SetNodeT
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -64,7 +56,7 @@ func setTimezoneLocation(c *cli.Context, timezoneLocation string) (*api.SetNodeT
 	response := api.SetNodeTimezoneResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
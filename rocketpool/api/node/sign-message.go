@@ -3,6 +3,7 @@ package node
 import (
 	"encoding/hex"
 	"fmt"
+	"time"
 	_ "time/tzdata"
 
 	"github.com/urfave/cli"
@@ -10,6 +11,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	hexutils "github.com/rocket-pool/smartnode/shared/utils/hex"
+	"github.com/rocket-pool/smartnode/shared/utils/signing"
 )
 
 func signMessage(c *cli.Context, message string) (*api.NodeSignResponse, error) {
@@ -30,3 +32,65 @@ func signMessage(c *cli.Context, message string) (*api.NodeSignResponse, error)
 	return &response, nil
 
 }
+
+func signTypedData(c *cli.Context, data string, allowNoChainID bool) (*api.NodeSignResponse, error) {
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeSignResponse{}
+
+	// Parse the typed data and make sure it's for this network
+	typedData, err := signing.ParseTypedData([]byte(data))
+	if err != nil {
+		return nil, err
+	}
+	if err := signing.ValidateTypedDataChainID(typedData, w.GetChainID(), allowNoChainID); err != nil {
+		return nil, err
+	}
+
+	signedBytes, err := w.SignTypedData(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("Error signing typed data: %w", err)
+	}
+	response.SignedData = hexutils.AddPrefix(hex.EncodeToString(signedBytes))
+
+	// Return response
+	return &response, nil
+
+}
+
+func signSiweMessage(c *cli.Context, message string) (*api.NodeSignResponse, error) {
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeSignResponse{}
+
+	// Parse the message and make sure it's for the node account on this network
+	siwe, err := signing.ParseSiweMessage(message)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid Sign-In with Ethereum message: %w", err)
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if err := siwe.Validate(nodeAccount.Address, w.GetChainID(), time.Now()); err != nil {
+		return nil, err
+	}
+
+	signedBytes, err := w.SignMessage(message)
+	if err != nil {
+		return nil, fmt.Errorf("Error signing Sign-In with Ethereum message: %w", err)
+	}
+	response.SignedData = hexutils.AddPrefix(hex.EncodeToString(signedBytes))
+
+	// Return response
+	return &response, nil
+
+}
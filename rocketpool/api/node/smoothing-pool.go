@@ -9,10 +9,10 @@ import (
 	"github.com/rocket-pool/rocketpool-go/rewards"
 	rocketpoolapi "github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
-	"github.com/rocket-pool/smartnode/shared/utils/validator"
 	"github.com/urfave/cli"
 )
 
@@ -92,16 +92,12 @@ func canSetSmoothingPoolStatus(c *cli.Context, status bool) (*api.CanSetSmoothin
 	if err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 
 	// Response
 	response := api.CanSetSmoothingPoolRegistrationStatusResponse{}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +144,7 @@ func setSmoothingPoolStatus(c *cli.Context, status bool) (*api.SetSmoothingPoolR
 	response := api.SetSmoothingPoolRegistrationStatusResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -181,19 +177,19 @@ func setSmoothingPoolStatus(c *cli.Context, status bool) (*api.SetSmoothingPoolR
 			return nil, err
 		}
 
-		// Restart the VC
-		err = validator.RestartValidator(cfg, bc, nil, d)
+		// Apply it to the VC
+		_, err = keymanager.SetFeeRecipient(cfg, bc, nil, d, *smoothingPoolContract.Address)
 		if err != nil {
 			// Set the fee recipient back to the node distributor
 			err2 := rocketpool.UpdateFeeRecipientFile(distributor, cfg)
 			if err2 != nil {
-				return nil, fmt.Errorf("***WARNING***\nError restarting validator: [%s]\nError setting fee recipient back to your node's distributor: [%w]\nYour node now has the Smoothing Pool as its fee recipient, even though you aren't opted in!\nPlease visit the Rocket Pool Discord server for help with these errors, so it can be set back to your node's distributor.", err.Error(), err2)
+				return nil, fmt.Errorf("***WARNING***\nError applying the fee recipient to the validator: [%s]\nError setting fee recipient back to your node's distributor: [%w]\nYour node now has the Smoothing Pool as its fee recipient, even though you aren't opted in!\nPlease visit the Rocket Pool Discord server for help with these errors, so it can be set back to your node's distributor.", err.Error(), err2)
 			}
 
-			// Restart the VC but don't pay attention to the errors, since a restart error got us here in the first place
-			validator.RestartValidator(cfg, bc, nil, d)
+			// Apply it to the VC but don't pay attention to the errors, since an error got us here in the first place
+			keymanager.SetFeeRecipient(cfg, bc, nil, d, distributor)
 
-			return nil, fmt.Errorf("Error restarting validator after updating the fee recipient to the Smoothing Pool: [%w]\nYour fee recipient has been set back to your node's distributor contract.\nYou have not been opted into the Smoothing Pool.", err)
+			return nil, fmt.Errorf("Error applying the fee recipient to the validator after updating it to the Smoothing Pool: [%w]\nYour fee recipient has been set back to your node's distributor contract.\nYou have not been opted into the Smoothing Pool.", err)
 		}
 	}
 
@@ -49,7 +49,7 @@ func canNodeSwapRpl(c *cli.Context, amountWei *big.Int) (*api.CanNodeSwapRplResp
 	response.InsufficientBalance = (amountWei.Cmp(fixedSupplyRplBalance) > 0)
 
 	// Get gas estimates
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -114,10 +114,6 @@ func getSwapApprovalGas(c *cli.Context, amountWei *big.Int) (*api.NodeSwapRplApp
 	if err := services.RequireRocketStorage(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -133,7 +129,7 @@ func getSwapApprovalGas(c *cli.Context, amountWei *big.Int) (*api.NodeSwapRplApp
 	}
 
 	// Get gas estimates
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -154,10 +150,6 @@ func approveFsRpl(c *cli.Context, amountWei *big.Int) (*api.NodeSwapRplApproveRe
 	if err := services.RequireRocketStorage(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -173,7 +165,7 @@ func approveFsRpl(c *cli.Context, amountWei *big.Int) (*api.NodeSwapRplApproveRe
 	}
 
 	// Approve fixed-supply RPL allowance
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -222,10 +214,6 @@ func swapRpl(c *cli.Context, amountWei *big.Int) (*api.NodeSwapRplSwapResponse,
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -235,7 +223,7 @@ func swapRpl(c *cli.Context, amountWei *big.Int) (*api.NodeSwapRplSwapResponse,
 	response := api.NodeSwapRplSwapResponse{}
 
 	// Swap fixed-supply RPL for RPL
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
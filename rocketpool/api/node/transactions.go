@@ -0,0 +1,121 @@
+package node
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getPendingTransactions(c *cli.Context) (*api.NodeTransactionsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	txm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeTransactionsResponse{}
+
+	// Get the nonces
+	response.LatestNonce, response.PendingNonce, err = txm.GetNonces()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the journaled transactions
+	entries, err := txm.GetPendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+	response.Transactions = make([]api.PendingTransaction, len(entries))
+	for i, entry := range entries {
+		tx, err := entry.GetTransaction()
+		if err != nil {
+			return nil, err
+		}
+		response.Transactions[i] = api.PendingTransaction{
+			Nonce:          entry.Nonce,
+			Hash:           tx.Hash(),
+			To:             tx.To(),
+			Value:          tx.Value(),
+			GasLimit:       tx.Gas(),
+			MaxFee:         tx.GasFeeCap(),
+			MaxPriorityFee: tx.GasTipCap(),
+			FirstSent:      entry.FirstSent,
+			LastSent:       entry.LastSent,
+			Replacements:   entry.GetReplacementCount(),
+			IsCancellation: entry.IsCancellation,
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func canCancelTransaction(c *cli.Context, nonce uint64) (*api.CanCancelNodeTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	txm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanCancelNodeTransactionResponse{}
+
+	// Get the fees for the cancellation
+	response.MaxFee, response.MaxPriorityFee, err = txm.GetCancellationFees(nonce)
+	if err != nil {
+		return nil, err
+	}
+	response.GasLimit = txmanager.CancelGasLimit
+
+	// Return response
+	return &response, nil
+
+}
+
+func cancelTransaction(c *cli.Context, nonce uint64) (*api.CancelNodeTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	txm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CancelNodeTransactionResponse{}
+
+	// Replace the transaction
+	tx, err := txm.CancelTransaction(nonce)
+	if err != nil {
+		return nil, err
+	}
+	response.TxHash = tx.Hash()
+
+	// Return response
+	return &response, nil
+
+}
@@ -32,10 +32,6 @@ func estimateSetSnapshotDelegateGas(c *cli.Context, address common.Address) (*ap
 	if err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	ec, err := services.GetEthClient(c)
 	if err != nil {
 		return nil, err
@@ -52,7 +48,7 @@ func estimateSetSnapshotDelegateGas(c *cli.Context, address common.Address) (*ap
 	snapshotDelegationAddress := common.HexToAddress(addressString)
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -94,10 +90,6 @@ func setSnapshotDelegate(c *cli.Context, address common.Address) (*api.SetSnapsh
 	if err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	s, err := services.GetSnapshotDelegation(c)
 	if err != nil {
 		return nil, err
@@ -110,7 +102,7 @@ func setSnapshotDelegate(c *cli.Context, address common.Address) (*api.SetSnapsh
 	response := api.SetSnapshotDelegateResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -146,10 +138,6 @@ func estimateClearSnapshotDelegateGas(c *cli.Context) (*api.EstimateClearSnapsho
 	if err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	ec, err := services.GetEthClient(c)
 	if err != nil {
 		return nil, err
@@ -166,7 +154,7 @@ func estimateClearSnapshotDelegateGas(c *cli.Context) (*api.EstimateClearSnapsho
 	snapshotDelegationAddress := common.HexToAddress(addressString)
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -208,10 +196,6 @@ func clearSnapshotDelegate(c *cli.Context) (*api.ClearSnapshotDelegateResponse,
 	if err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	s, err := services.GetSnapshotDelegation(c)
 	if err != nil {
 		return nil, err
@@ -224,7 +208,7 @@ func clearSnapshotDelegate(c *cli.Context) (*api.ClearSnapshotDelegateResponse,
 	response := api.ClearSnapshotDelegateResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +31,7 @@ func canSetWithdrawalAddress(c *cli.Context, withdrawalAddress common.Address, c
 	response := api.CanSetNodeWithdrawalAddressResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +73,7 @@ func setWithdrawalAddress(c *cli.Context, withdrawalAddress common.Address, conf
 	response := api.SetNodeWithdrawalAddressResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +131,7 @@ func canConfirmWithdrawalAddress(c *cli.Context) (*api.CanConfirmNodeWithdrawalA
 	response := api.CanConfirmNodeWithdrawalAddressResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -179,7 +179,7 @@ func confirmWithdrawalAddress(c *cli.Context) (*api.ConfirmNodeWithdrawalAddress
 	response := api.ConfirmNodeWithdrawalAddressResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +69,7 @@ func canCancelProposal(c *cli.Context, proposalId uint64) (*api.CanCancelTNDAOPr
 
 	// Get gas estimate
 	wg.Go(func() error {
-		opts, err := w.GetNodeAccountTransactor()
+		opts, err := services.GetNodeAccountTransactor(c)
 		if err != nil {
 			return err
 		}
@@ -97,10 +97,6 @@ func cancelProposal(c *cli.Context, proposalId uint64) (*api.CancelTNDAOProposal
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -110,7 +106,7 @@ func cancelProposal(c *cli.Context, proposalId uint64) (*api.CancelTNDAOProposal
 	response := api.CancelTNDAOProposalResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -53,6 +53,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "penalties",
+				Usage:     "Get the illegal fee recipients detected by the watchtower",
+				UsageText: "rocketpool api odao penalties",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getPenalties(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "proposals",
 				Aliases:   []string{"p"},
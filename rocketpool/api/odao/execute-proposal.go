@@ -23,10 +23,6 @@ func canExecuteProposal(c *cli.Context, proposalId uint64) (*api.CanExecuteTNDAO
 	if err := services.RequireRocketStorage(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -58,7 +54,7 @@ func canExecuteProposal(c *cli.Context, proposalId uint64) (*api.CanExecuteTNDAO
 
 	// Get gas estimate
 	wg.Go(func() error {
-		opts, err := w.GetNodeAccountTransactor()
+		opts, err := services.GetNodeAccountTransactor(c)
 		if err != nil {
 			return err
 		}
@@ -89,10 +85,6 @@ func executeProposal(c *cli.Context, proposalId uint64) (*api.ExecuteTNDAOPropos
 	if err := services.RequireRocketStorage(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -102,7 +94,7 @@ func executeProposal(c *cli.Context, proposalId uint64) (*api.ExecuteTNDAOPropos
 	response := api.ExecuteTNDAOProposalResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
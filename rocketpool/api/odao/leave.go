@@ -58,7 +58,7 @@ func canLeave(c *cli.Context) (*api.CanLeaveTNDAOResponse, error) {
 
 	// Get gas estimate
 	wg.Go(func() error {
-		opts, err := w.GetNodeAccountTransactor()
+		opts, err := services.GetNodeAccountTransactor(c)
 		if err != nil {
 			return err
 		}
@@ -86,10 +86,6 @@ func leave(c *cli.Context, bondRefundAddress common.Address) (*api.LeaveTNDAORes
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -99,7 +95,7 @@ func leave(c *cli.Context, bondRefundAddress common.Address) (*api.LeaveTNDAORes
 	response := api.LeaveTNDAOResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,33 @@
+package odao
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/penalties"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getPenalties(c *cli.Context) (*api.TNDAOPenaltiesResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.TNDAOPenaltiesResponse{
+		SubmitPenalties: cfg.Smartnode.WatchtowerSubmitPenalties.Value.(bool),
+	}
+
+	// Load the violations the watchtower has recorded
+	response.Violations, err = penalties.LoadViolations(cfg.Smartnode.GetWatchtowerPenaltiesPath())
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -58,7 +58,7 @@ func canProposeInvite(c *cli.Context, memberAddress common.Address, memberId, me
 
 	// Get gas estimate
 	wg.Go(func() error {
-		opts, err := w.GetNodeAccountTransactor()
+		opts, err := services.GetNodeAccountTransactor(c)
 		if err != nil {
 			return err
 		}
@@ -87,10 +87,6 @@ func proposeInvite(c *cli.Context, memberAddress common.Address, memberId, membe
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -100,7 +96,7 @@ func proposeInvite(c *cli.Context, memberAddress common.Address, memberId, membe
 	response := api.ProposeTNDAOInviteResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -61,7 +61,7 @@ func canProposeKick(c *cli.Context, memberAddress common.Address, fineAmountWei
 
 	// Get gas estimate
 	wg.Go(func() error {
-		opts, err := w.GetNodeAccountTransactor()
+		opts, err := services.GetNodeAccountTransactor(c)
 		if err != nil {
 			return err
 		}
@@ -98,10 +98,6 @@ func proposeKick(c *cli.Context, memberAddress common.Address, fineAmountWei *bi
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -133,7 +129,7 @@ func proposeKick(c *cli.Context, memberAddress common.Address, fineAmountWei *bi
 	}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
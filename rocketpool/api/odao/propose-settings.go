@@ -59,7 +59,7 @@ func canProposeSettingMembersQuorum(c *cli.Context, quorum float64) (*api.CanPro
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -79,10 +79,6 @@ func proposeSettingMembersQuorum(c *cli.Context, quorum float64) (*api.ProposeTN
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -92,7 +88,7 @@ func proposeSettingMembersQuorum(c *cli.Context, quorum float64) (*api.ProposeTN
 	response := api.ProposeTNDAOSettingMembersQuorumResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +133,7 @@ func canProposeSettingMembersRplBond(c *cli.Context, bondAmountWei *big.Int) (*a
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -157,10 +153,6 @@ func proposeSettingMembersRplBond(c *cli.Context, bondAmountWei *big.Int) (*api.
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -170,7 +162,7 @@ func proposeSettingMembersRplBond(c *cli.Context, bondAmountWei *big.Int) (*api.
 	response := api.ProposeTNDAOSettingMembersRplBondResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -215,7 +207,7 @@ func canProposeSettingMinipoolUnbondedMax(c *cli.Context, unbondedMinipoolMax ui
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -235,10 +227,6 @@ func proposeSettingMinipoolUnbondedMax(c *cli.Context, unbondedMinipoolMax uint6
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -248,7 +236,7 @@ func proposeSettingMinipoolUnbondedMax(c *cli.Context, unbondedMinipoolMax uint6
 	response := api.ProposeTNDAOSettingMinipoolUnbondedMaxResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -293,7 +281,7 @@ func canProposeSettingProposalCooldown(c *cli.Context, proposalCooldownTimespan
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -313,10 +301,6 @@ func proposeSettingProposalCooldown(c *cli.Context, proposalCooldownTimespan uin
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -326,7 +310,7 @@ func proposeSettingProposalCooldown(c *cli.Context, proposalCooldownTimespan uin
 	response := api.ProposeTNDAOSettingProposalCooldownResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -371,7 +355,7 @@ func canProposeSettingProposalVoteTimespan(c *cli.Context, proposalVoteTimespan
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -391,10 +375,6 @@ func proposeSettingProposalVoteTimespan(c *cli.Context, proposalVoteTimespan uin
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -404,7 +384,7 @@ func proposeSettingProposalVoteTimespan(c *cli.Context, proposalVoteTimespan uin
 	response := api.ProposeTNDAOSettingProposalVoteTimespanResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -449,7 +429,7 @@ func canProposeSettingProposalVoteDelayTimespan(c *cli.Context, proposalDelayTim
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -469,10 +449,6 @@ func proposeSettingProposalVoteDelayTimespan(c *cli.Context, proposalDelayTimesp
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -482,7 +458,7 @@ func proposeSettingProposalVoteDelayTimespan(c *cli.Context, proposalDelayTimesp
 	response := api.ProposeTNDAOSettingProposalVoteDelayTimespanResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -527,7 +503,7 @@ func canProposeSettingProposalExecuteTimespan(c *cli.Context, proposalExecuteTim
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -547,10 +523,6 @@ func proposeSettingProposalExecuteTimespan(c *cli.Context, proposalExecuteTimesp
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -560,7 +532,7 @@ func proposeSettingProposalExecuteTimespan(c *cli.Context, proposalExecuteTimesp
 	response := api.ProposeTNDAOSettingProposalExecuteTimespanResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -605,7 +577,7 @@ func canProposeSettingProposalActionTimespan(c *cli.Context, proposalActionTimes
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -625,10 +597,6 @@ func proposeSettingProposalActionTimespan(c *cli.Context, proposalActionTimespan
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -638,7 +606,7 @@ func proposeSettingProposalActionTimespan(c *cli.Context, proposalActionTimespan
 	response := api.ProposeTNDAOSettingProposalActionTimespanResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -683,7 +651,7 @@ func canProposeSettingScrubPeriod(c *cli.Context, scrubPeriod uint64) (*api.CanP
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -703,10 +671,6 @@ func proposeSettingScrubPeriod(c *cli.Context, scrubPeriod uint64) (*api.Propose
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -716,7 +680,7 @@ func proposeSettingScrubPeriod(c *cli.Context, scrubPeriod uint64) (*api.Propose
 	response := api.ProposeTNDAOSettingScrubPeriodResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -761,7 +725,7 @@ func canProposeSettingPromotionScrubPeriod(c *cli.Context, promotionScrubPeriod
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -781,10 +745,6 @@ func proposeSettingPromotionScrubPeriod(c *cli.Context, promotionScrubPeriod uin
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -794,7 +754,7 @@ func proposeSettingPromotionScrubPeriod(c *cli.Context, promotionScrubPeriod uin
 	response := api.ProposeTNDAOSettingPromotionScrubPeriodResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -839,7 +799,7 @@ func canProposeSettingScrubPenaltyEnabled(c *cli.Context, enabled bool) (*api.Ca
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -859,10 +819,6 @@ func proposeSettingScrubPenaltyEnabled(c *cli.Context, enabled bool) (*api.Propo
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -872,7 +828,7 @@ func proposeSettingScrubPenaltyEnabled(c *cli.Context, enabled bool) (*api.Propo
 	response := api.ProposeTNDAOSettingScrubPeriodResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -917,7 +873,7 @@ func canProposeSettingBondReductionWindowStart(c *cli.Context, bondReductionWind
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -937,10 +893,6 @@ func proposeSettingBondReductionWindowStart(c *cli.Context, bondReductionWindowS
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -950,7 +902,7 @@ func proposeSettingBondReductionWindowStart(c *cli.Context, bondReductionWindowS
 	response := api.ProposeTNDAOSettingScrubPeriodResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -995,7 +947,7 @@ func canProposeSettingBondReductionWindowLength(c *cli.Context, bondReductionWin
 	}
 
 	// Get gas estimate
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
@@ -1015,10 +967,6 @@ func proposeSettingBondReductionWindowLength(c *cli.Context, bondReductionWindow
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -1028,7 +976,7 @@ func proposeSettingBondReductionWindowLength(c *cli.Context, bondReductionWindow
 	response := api.ProposeTNDAOSettingScrubPeriodResponse{}
 
 	// Get transactor
-	opts, err := w.GetNodeAccountTransactor()
+	opts, err := services.GetNodeAccountTransactor(c)
 	if err != nil {
 		return nil, err
 	}
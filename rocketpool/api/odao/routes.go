@@ -0,0 +1,499 @@
+package odao
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Get the routes the daemon's API server serves for the odao commands
+func GetRoutes(name string) []apiutils.Route {
+	return []apiutils.Route{
+		{
+			Path:  name + "/status",
+			Usage: "Get oracle DAO status",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getStatus(c)
+			},
+		},
+		{
+			Path:  name + "/members",
+			Usage: "Get the oracle DAO members",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getMembers(c)
+			},
+		},
+		{
+			Path:  name + "/penalties",
+			Usage: "Get the illegal fee recipients detected by the watchtower",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getPenalties(c)
+			},
+		},
+		{
+			Path:  name + "/proposals",
+			Usage: "Get the oracle DAO proposals",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getProposals(c)
+			},
+		},
+		{
+			Path:       name + "/proposal-details",
+			Usage:      "Get details of a proposal; takes a TNDAOProposalDetailsRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposalDetailsRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposalDetailsRequest)
+				return getProposal(c, r.Id)
+			},
+		},
+		{
+			Path:       name + "/can-propose-invite",
+			Usage:      "Check whether the node can propose inviting a new member; takes a TNDAOProposeInviteRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeInviteRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeInviteRequest)
+				memberId, err := cliutils.ValidateDAOMemberID("member ID", r.MemberId)
+				if err != nil {
+					return nil, err
+				}
+				return canProposeInvite(c, r.MemberAddress, memberId, r.MemberUrl)
+			},
+		},
+		{
+			Path:       name + "/propose-invite",
+			Usage:      "Propose inviting a new member; takes a TNDAOProposeInviteRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeInviteRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeInviteRequest)
+				memberId, err := cliutils.ValidateDAOMemberID("member ID", r.MemberId)
+				if err != nil {
+					return nil, err
+				}
+				return proposeInvite(c, r.MemberAddress, memberId, r.MemberUrl)
+			},
+		},
+		{
+			Path:       name + "/can-propose-leave",
+			Usage:      "Check whether the node can propose leaving the oracle DAO; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return canProposeLeave(c)
+			},
+		},
+		{
+			Path:       name + "/propose-leave",
+			Usage:      "Propose leaving the oracle DAO; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return proposeLeave(c)
+			},
+		},
+		{
+			Path:       name + "/can-propose-kick",
+			Usage:      "Check whether the node can propose kicking a member; takes a TNDAOProposeKickRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeKickRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeKickRequest)
+				if err := apiutils.ValidatePositiveOrZeroWeiAmount("fine amount", r.FineAmountWei); err != nil {
+					return nil, err
+				}
+				return canProposeKick(c, r.MemberAddress, r.FineAmountWei)
+			},
+		},
+		{
+			Path:       name + "/propose-kick",
+			Usage:      "Propose kicking a member; takes a TNDAOProposeKickRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeKickRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeKickRequest)
+				if err := apiutils.ValidatePositiveOrZeroWeiAmount("fine amount", r.FineAmountWei); err != nil {
+					return nil, err
+				}
+				return proposeKick(c, r.MemberAddress, r.FineAmountWei)
+			},
+		},
+		{
+			Path:       name + "/can-cancel-proposal",
+			Usage:      "Check whether the node can cancel a proposal; takes a TNDAOProposalRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposalRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposalRequest)
+				if err := apiutils.ValidatePositiveUint("proposal ID", r.ProposalId); err != nil {
+					return nil, err
+				}
+				return canCancelProposal(c, r.ProposalId)
+			},
+		},
+		{
+			Path:       name + "/cancel-proposal",
+			Usage:      "Cancel a proposal made by the node; takes a TNDAOProposalRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposalRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposalRequest)
+				if err := apiutils.ValidatePositiveUint("proposal ID", r.ProposalId); err != nil {
+					return nil, err
+				}
+				return cancelProposal(c, r.ProposalId)
+			},
+		},
+		{
+			Path:       name + "/can-vote-proposal",
+			Usage:      "Check whether the node can vote on a proposal; takes a TNDAOProposalRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposalRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposalRequest)
+				if err := apiutils.ValidatePositiveUint("proposal ID", r.ProposalId); err != nil {
+					return nil, err
+				}
+				return canVoteOnProposal(c, r.ProposalId)
+			},
+		},
+		{
+			Path:       name + "/vote-proposal",
+			Usage:      "Vote on a proposal; takes a TNDAOVoteProposalRequest",
+			NewRequest: func() interface{} { return &api.TNDAOVoteProposalRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOVoteProposalRequest)
+				if err := apiutils.ValidatePositiveUint("proposal ID", r.ProposalId); err != nil {
+					return nil, err
+				}
+				return voteOnProposal(c, r.ProposalId, r.Support)
+			},
+		},
+		{
+			Path:       name + "/can-execute-proposal",
+			Usage:      "Check whether the node can execute a proposal; takes a TNDAOProposalRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposalRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposalRequest)
+				if err := apiutils.ValidatePositiveUint("proposal ID", r.ProposalId); err != nil {
+					return nil, err
+				}
+				return canExecuteProposal(c, r.ProposalId)
+			},
+		},
+		{
+			Path:       name + "/execute-proposal",
+			Usage:      "Execute a proposal; takes a TNDAOProposalRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposalRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposalRequest)
+				if err := apiutils.ValidatePositiveUint("proposal ID", r.ProposalId); err != nil {
+					return nil, err
+				}
+				return executeProposal(c, r.ProposalId)
+			},
+		},
+		{
+			Path:       name + "/can-join",
+			Usage:      "Check whether the node can join the oracle DAO; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return canJoin(c)
+			},
+		},
+		{
+			Path:       name + "/join-approve-rpl",
+			Usage:      "Approves the RPL bond transfer prior to join the oracle DAO; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return approveRpl(c)
+			},
+		},
+		{
+			Path:       name + "/join",
+			Usage:      "Join the oracle DAO (requires an executed invite proposal); takes a TNDAOJoinRequest",
+			NewRequest: func() interface{} { return &api.TNDAOJoinRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOJoinRequest)
+				return waitForApprovalAndJoin(c, r.Hash)
+			},
+		},
+		{
+			Path:       name + "/can-leave",
+			Usage:      "Check whether the node can leave the oracle DAO; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return canLeave(c)
+			},
+		},
+		{
+			Path:       name + "/leave",
+			Usage:      "Leave the oracle DAO (requires an executed leave proposal); takes a TNDAOLeaveRequest",
+			NewRequest: func() interface{} { return &api.TNDAOLeaveRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOLeaveRequest)
+				return leave(c, r.BondRefundAddress)
+			},
+		},
+		{
+			Path:       name + "/can-propose-members-quorum",
+			Usage:      "Check whether the node can propose the members.quorum setting; takes a TNDAOProposeMembersQuorumRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeMembersQuorumRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeMembersQuorumRequest)
+				if err := apiutils.ValidateFraction("quorum", r.Quorum); err != nil {
+					return nil, err
+				}
+				return canProposeSettingMembersQuorum(c, r.Quorum)
+			},
+		},
+		{
+			Path:       name + "/propose-members-quorum",
+			Usage:      "Propose updating the members.quorum setting; takes a TNDAOProposeMembersQuorumRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeMembersQuorumRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeMembersQuorumRequest)
+				if err := apiutils.ValidateFraction("quorum", r.Quorum); err != nil {
+					return nil, err
+				}
+				return proposeSettingMembersQuorum(c, r.Quorum)
+			},
+		},
+		{
+			Path:       name + "/can-propose-members-rplbond",
+			Usage:      "Check whether the node can propose the members.rplbond setting; takes a TNDAOProposeMembersRplBondRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeMembersRplBondRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeMembersRplBondRequest)
+				if err := apiutils.ValidateBigInt("RPL bond amount", r.BondAmountWei); err != nil {
+					return nil, err
+				}
+				return canProposeSettingMembersRplBond(c, r.BondAmountWei)
+			},
+		},
+		{
+			Path:       name + "/propose-members-rplbond",
+			Usage:      "Propose updating the members.rplbond setting; takes a TNDAOProposeMembersRplBondRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeMembersRplBondRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeMembersRplBondRequest)
+				if err := apiutils.ValidateBigInt("RPL bond amount", r.BondAmountWei); err != nil {
+					return nil, err
+				}
+				return proposeSettingMembersRplBond(c, r.BondAmountWei)
+			},
+		},
+		{
+			Path:       name + "/can-propose-members-minipool-unbonded-max",
+			Usage:      "Check whether the node can propose the members.minipool.unbonded.max setting; takes a TNDAOProposeMinipoolUnbondedMaxRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeMinipoolUnbondedMaxRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeMinipoolUnbondedMaxRequest)
+				return canProposeSettingMinipoolUnbondedMax(c, r.UnbondedMinipoolMax)
+			},
+		},
+		{
+			Path:       name + "/propose-members-minipool-unbonded-max",
+			Usage:      "Propose updating the members.minipool.unbonded.max setting; takes a TNDAOProposeMinipoolUnbondedMaxRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeMinipoolUnbondedMaxRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeMinipoolUnbondedMaxRequest)
+				return proposeSettingMinipoolUnbondedMax(c, r.UnbondedMinipoolMax)
+			},
+		},
+		{
+			Path:       name + "/can-propose-proposal-cooldown",
+			Usage:      "Check whether the node can propose the proposal.cooldown setting; takes a TNDAOProposeProposalCooldownRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeProposalCooldownRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeProposalCooldownRequest)
+				return canProposeSettingProposalCooldown(c, r.ProposalCooldownBlocks)
+			},
+		},
+		{
+			Path:       name + "/propose-proposal-cooldown",
+			Usage:      "Propose updating the proposal.cooldown setting; takes a TNDAOProposeProposalCooldownRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeProposalCooldownRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeProposalCooldownRequest)
+				return proposeSettingProposalCooldown(c, r.ProposalCooldownBlocks)
+			},
+		},
+		{
+			Path:       name + "/can-propose-proposal-vote-timespan",
+			Usage:      "Check whether the node can propose the proposal.vote.time setting; takes a TNDAOProposeProposalVoteTimespanRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeProposalVoteTimespanRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeProposalVoteTimespanRequest)
+				return canProposeSettingProposalVoteTimespan(c, r.ProposalVoteTimespan)
+			},
+		},
+		{
+			Path:       name + "/propose-proposal-vote-timespan",
+			Usage:      "Propose updating the proposal.vote.time setting; takes a TNDAOProposeProposalVoteTimespanRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeProposalVoteTimespanRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeProposalVoteTimespanRequest)
+				return proposeSettingProposalVoteTimespan(c, r.ProposalVoteTimespan)
+			},
+		},
+		{
+			Path:       name + "/can-propose-proposal-vote-delay-timespan",
+			Usage:      "Check whether the node can propose the proposal.vote.delay.time setting; takes a TNDAOProposeProposalVoteDelayTimespanRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeProposalVoteDelayTimespanRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeProposalVoteDelayTimespanRequest)
+				return canProposeSettingProposalVoteDelayTimespan(c, r.ProposalDelayTimespan)
+			},
+		},
+		{
+			Path:       name + "/propose-proposal-vote-delay-timespan",
+			Usage:      "Propose updating the proposal.vote.delay.time setting; takes a TNDAOProposeProposalVoteDelayTimespanRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeProposalVoteDelayTimespanRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeProposalVoteDelayTimespanRequest)
+				return proposeSettingProposalVoteDelayTimespan(c, r.ProposalDelayTimespan)
+			},
+		},
+		{
+			Path:       name + "/can-propose-proposal-execute-timespan",
+			Usage:      "Check whether the node can propose the proposal.execute.time setting; takes a TNDAOProposeProposalExecuteTimespanRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeProposalExecuteTimespanRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeProposalExecuteTimespanRequest)
+				return canProposeSettingProposalExecuteTimespan(c, r.ProposalExecuteTimespan)
+			},
+		},
+		{
+			Path:       name + "/propose-proposal-execute-timespan",
+			Usage:      "Propose updating the proposal.execute.time setting; takes a TNDAOProposeProposalExecuteTimespanRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeProposalExecuteTimespanRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeProposalExecuteTimespanRequest)
+				return proposeSettingProposalExecuteTimespan(c, r.ProposalExecuteTimespan)
+			},
+		},
+		{
+			Path:       name + "/can-propose-proposal-action-timespan",
+			Usage:      "Check whether the node can propose the proposal.action.time setting; takes a TNDAOProposeProposalActionTimespanRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeProposalActionTimespanRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeProposalActionTimespanRequest)
+				return canProposeSettingProposalActionTimespan(c, r.ProposalActionTimespan)
+			},
+		},
+		{
+			Path:       name + "/propose-proposal-action-timespan",
+			Usage:      "Propose updating the proposal.action.time setting; takes a TNDAOProposeProposalActionTimespanRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeProposalActionTimespanRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeProposalActionTimespanRequest)
+				return proposeSettingProposalActionTimespan(c, r.ProposalActionTimespan)
+			},
+		},
+		{
+			Path:       name + "/can-propose-scrub-period",
+			Usage:      "Check whether the node can propose the minipool.scrub.period setting; takes a TNDAOProposeScrubPeriodRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeScrubPeriodRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeScrubPeriodRequest)
+				return canProposeSettingScrubPeriod(c, r.ScrubPeriod)
+			},
+		},
+		{
+			Path:       name + "/propose-scrub-period",
+			Usage:      "Propose updating the minipool.scrub.period setting; takes a TNDAOProposeScrubPeriodRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeScrubPeriodRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeScrubPeriodRequest)
+				return proposeSettingScrubPeriod(c, r.ScrubPeriod)
+			},
+		},
+		{
+			Path:       name + "/can-propose-promotion-scrub-period",
+			Usage:      "Check whether the node can propose the minipool.promotion.scrub.period setting; takes a TNDAOProposeScrubPeriodRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeScrubPeriodRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeScrubPeriodRequest)
+				return canProposeSettingPromotionScrubPeriod(c, r.ScrubPeriod)
+			},
+		},
+		{
+			Path:       name + "/propose-promotion-scrub-period",
+			Usage:      "Propose updating the minipool.promotion.scrub.period setting; takes a TNDAOProposeScrubPeriodRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeScrubPeriodRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeScrubPeriodRequest)
+				return proposeSettingPromotionScrubPeriod(c, r.ScrubPeriod)
+			},
+		},
+		{
+			Path:       name + "/can-propose-scrub-penalty-enabled",
+			Usage:      "Check whether the node can propose the minipool.scrub.penalty.enabled setting; takes a TNDAOProposeScrubPenaltyEnabledRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeScrubPenaltyEnabledRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeScrubPenaltyEnabledRequest)
+				return canProposeSettingScrubPenaltyEnabled(c, r.Enabled)
+			},
+		},
+		{
+			Path:       name + "/propose-scrub-penalty-enabled",
+			Usage:      "Propose updating the minipool.scrub.penalty.enabled setting; takes a TNDAOProposeScrubPenaltyEnabledRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeScrubPenaltyEnabledRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeScrubPenaltyEnabledRequest)
+				return proposeSettingScrubPenaltyEnabled(c, r.Enabled)
+			},
+		},
+		{
+			Path:       name + "/can-propose-bond-reduction-window-start",
+			Usage:      "Check whether the node can propose the minipool.bond.reduction.window.start setting; takes a TNDAOProposeBondReductionWindowStartRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeBondReductionWindowStartRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeBondReductionWindowStartRequest)
+				return canProposeSettingBondReductionWindowStart(c, r.WindowStart)
+			},
+		},
+		{
+			Path:       name + "/propose-bond-reduction-window-start",
+			Usage:      "Propose updating the minipool.bond.reduction.window.start setting; takes a TNDAOProposeBondReductionWindowStartRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeBondReductionWindowStartRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeBondReductionWindowStartRequest)
+				return proposeSettingBondReductionWindowStart(c, r.WindowStart)
+			},
+		},
+		{
+			Path:       name + "/can-propose-bond-reduction-window-length",
+			Usage:      "Check whether the node can propose the minipool.bond.reduction.window.length setting; takes a TNDAOProposeBondReductionWindowLengthRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeBondReductionWindowLengthRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeBondReductionWindowLengthRequest)
+				return canProposeSettingBondReductionWindowLength(c, r.WindowLength)
+			},
+		},
+		{
+			Path:       name + "/propose-bond-reduction-window-length",
+			Usage:      "Propose updating the minipool.bond.reduction.window.length setting; takes a TNDAOProposeBondReductionWindowLengthRequest",
+			NewRequest: func() interface{} { return &api.TNDAOProposeBondReductionWindowLengthRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.TNDAOProposeBondReductionWindowLengthRequest)
+				return proposeSettingBondReductionWindowLength(c, r.WindowLength)
+			},
+		},
+		{
+			Path:  name + "/get-member-settings",
+			Usage: "Get the ODAO settings related to ODAO members",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getMemberSettings(c)
+			},
+		},
+		{
+			Path:  name + "/get-proposal-settings",
+			Usage: "Get the ODAO settings related to ODAO proposals",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getProposalSettings(c)
+			},
+		},
+		{
+			Path:  name + "/get-minipool-settings",
+			Usage: "Get the ODAO settings related to minipools",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getMinipoolSettings(c)
+			},
+		},
+	}
+}
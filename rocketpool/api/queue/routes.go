@@ -0,0 +1,37 @@
+package queue
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+)
+
+// Get the routes the daemon's API server serves for the queue commands
+func GetRoutes(name string) []apiutils.Route {
+	return []apiutils.Route{
+		{
+			Path:  name + "/status",
+			Usage: "Get the deposit pool and minipool queue status",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getStatus(c)
+			},
+		},
+		{
+			Path:       name + "/can-process",
+			Usage:      "Check whether the deposit pool can be processed; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return canProcessQueue(c)
+			},
+		},
+		{
+			Path:       name + "/process",
+			Usage:      "Process the deposit pool; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return processQueue(c)
+			},
+		},
+	}
+}
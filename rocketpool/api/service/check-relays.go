@@ -0,0 +1,135 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/mevboost"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+// Checks which of the enabled MEV-boost relays are reachable and whether the node's validators are registered with them
+func checkRelays(c *cli.Context) (*api.CheckRelaysResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CheckRelaysResponse{
+		Relays: []api.RelayStatus{},
+	}
+	response.MevBoostEnabled = (cfg.EnableMevBoost.Value == true)
+	response.IsExternal = (cfg.MevBoost.Mode.Value.(cfgtypes.Mode) == cfgtypes.Mode_External)
+	if !response.MevBoostEnabled || response.IsExternal {
+		// The relays used by an externally managed MEV-boost client aren't known
+		return &response, nil
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the node's validators and the fee recipient they should be using
+	pubkeys, err := minipool.GetNodeValidatingMinipoolPubkeys(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting minipool pubkeys: %w", err)
+	}
+	response.ValidatorCount = len(pubkeys)
+	feeRecipientInfo, err := rputils.GetFeeRecipientInfoWithoutState(rp, bc, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting fee recipient info: %w", err)
+	}
+	if feeRecipientInfo.IsInSmoothingPool || feeRecipientInfo.IsInOptOutCooldown {
+		response.ExpectedFeeRecipient = feeRecipientInfo.SmoothingPoolAddress
+	} else {
+		response.ExpectedFeeRecipient = feeRecipientInfo.FeeDistributorAddress
+	}
+
+	// Check each relay
+	relays := cfg.MevBoost.GetEnabledMevRelays()
+	currentNetwork := cfg.Smartnode.Network.Value.(cfgtypes.Network)
+	response.Relays = make([]api.RelayStatus, len(relays))
+	var wg sync.WaitGroup
+	for i, relay := range relays {
+		response.Relays[i] = api.RelayStatus{
+			Name:                     relay.Name,
+			Url:                      relay.Urls[currentNetwork],
+			UnregisteredPubkeys:      []string{},
+			WrongFeeRecipientPubkeys: []string{},
+		}
+		wg.Add(1)
+		go func(status *api.RelayStatus) {
+			defer wg.Done()
+			checkRelay(status, pubkeys, response.ExpectedFeeRecipient)
+		}(&response.Relays[i])
+	}
+	wg.Wait()
+
+	// Return response
+	return &response, nil
+
+}
+
+// Check if a relay is reachable, and if so, the registration of each validator with it
+func checkRelay(status *api.RelayStatus, pubkeys []types.ValidatorPubkey, expectedFeeRecipient common.Address) {
+
+	client, err := mevboost.NewRelayClient(status.Url)
+	if err != nil {
+		status.Error = err.Error()
+		return
+	}
+	err = client.CheckStatus()
+	if err != nil {
+		status.Error = err.Error()
+		return
+	}
+	status.IsReachable = true
+
+	for _, pubkey := range pubkeys {
+		registration, isRegistered, err := client.GetValidatorRegistration(pubkey)
+		if err != nil {
+			status.RegistrationErrorCount++
+			if status.Error == "" {
+				status.Error = err.Error()
+			}
+			continue
+		}
+		if !isRegistered {
+			status.UnregisteredPubkeys = append(status.UnregisteredPubkeys, pubkey.Hex())
+			continue
+		}
+		status.RegisteredCount++
+		if registration.FeeRecipient != expectedFeeRecipient {
+			status.WrongFeeRecipientPubkeys = append(status.WrongFeeRecipientPubkeys, pubkey.Hex())
+		}
+	}
+
+}
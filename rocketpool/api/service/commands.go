@@ -52,6 +52,42 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "task-status",
+				Usage:     "Gets the run history of the node and watchtower daemon tasks",
+				UsageText: "rocketpool api service task-status",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getTaskStatus(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "check-relays",
+				Usage:     "Checks which of the enabled MEV-boost relays are reachable and whether the node's validators are registered with them",
+				UsageText: "rocketpool api service check-relays",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(checkRelays(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "restart-vc",
 				Usage:     "Restarts the validator client",
@@ -69,6 +105,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+
+			{
+				Name:      "stop-vc",
+				Usage:     "Stops the validator client",
+				UsageText: "rocketpool api service stop-vc",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(stopVc(c))
+					return nil
+
+				},
+			},
 		},
 	})
 }
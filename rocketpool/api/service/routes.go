@@ -0,0 +1,59 @@
+package service
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+)
+
+// Get the routes the daemon's API server serves for the service commands
+func GetRoutes(name string) []apiutils.Route {
+	return []apiutils.Route{
+		{
+			Path:       name + "/terminate-data-folder",
+			Usage:      "Deletes the data folder including the wallet file, password file, and all validator keys - don't use this unless you have a very good reason to do it (such as switching from Prater to Mainnet); takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return terminateDataFolder(c)
+			},
+		},
+		{
+			Path:  name + "/get-client-status",
+			Usage: "Gets the status of the configured Execution and Beacon clients",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getClientStatus(c)
+			},
+		},
+		{
+			Path:  name + "/task-status",
+			Usage: "Gets the run history of the node and watchtower daemon tasks",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getTaskStatus(c)
+			},
+		},
+		{
+			Path:  name + "/check-relays",
+			Usage: "Checks which of the enabled MEV-boost relays are reachable and whether the node's validators are registered with them",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return checkRelays(c)
+			},
+		},
+		{
+			Path:       name + "/restart-vc",
+			Usage:      "Restarts the validator client; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return restartVc(c)
+			},
+		},
+		{
+			Path:       name + "/stop-vc",
+			Usage:      "Stops the validator client; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return stopVc(c)
+			},
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+	"github.com/urfave/cli"
+)
+
+// Stops the Validator client
+func stopVc(c *cli.Context) (*api.StopVcResponse, error) {
+
+	// Get services
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	d, err := services.GetDocker(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.StopVcResponse{}
+
+	if err := validator.StopValidator(cfg, bc, nil, d); err != nil {
+		return nil, fmt.Errorf("error stopping validator client: %w", err)
+	}
+
+	// Return response
+	return &response, nil
+
+}
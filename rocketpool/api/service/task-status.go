@@ -0,0 +1,42 @@
+package service
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// The daemons that record their task status
+var taskDaemons = []string{"node", "watchtower"}
+
+// Gets the status of the node and watchtower daemon tasks
+func getTaskStatus(c *cli.Context) (*api.TaskStatusResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.TaskStatusResponse{
+		Daemons: []api.DaemonTaskStatus{},
+	}
+
+	// Load the status each daemon saved
+	for _, daemon := range taskDaemons {
+		status, exists, err := tasks.LoadStatus(cfg.Smartnode.GetTaskStatusPath(daemon, true))
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			response.Daemons = append(response.Daemons, status)
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
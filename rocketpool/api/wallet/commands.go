@@ -76,7 +76,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(initWallet(c))
+					api.PrintResponse(initWallet(c, c.String("derivation-path")))
 					return nil
 
 				},
@@ -114,7 +114,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(recoverWallet(c, mnemonic))
+					api.PrintResponse(recoverWallet(c, mnemonic, c.Bool("skip-validator-key-recovery"), c.String("derivation-path"), c.Uint("wallet-index")))
 					return nil
 
 				},
@@ -147,7 +147,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(searchAndRecoverWallet(c, mnemonic, address))
+					api.PrintResponse(searchAndRecoverWallet(c, mnemonic, address, c.Bool("skip-validator-key-recovery")))
 					return nil
 
 				},
@@ -204,7 +204,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(testRecoverWallet(c, mnemonic))
+					api.PrintResponse(testRecoverWallet(c, mnemonic, c.Bool("skip-validator-key-recovery"), c.String("derivation-path"), c.Uint("wallet-index")))
 					return nil
 
 				},
@@ -237,7 +237,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(testSearchAndRecoverWallet(c, mnemonic, address))
+					api.PrintResponse(testSearchAndRecoverWallet(c, mnemonic, address, c.Bool("skip-validator-key-recovery")))
 					return nil
 
 				},
@@ -262,6 +262,77 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "sign-tx",
+				Usage:     "Sign a transaction that was built for the node account on another machine with --unsigned-tx. The transaction can be its JSON form or its hex-encoded unsigned payload.",
+				UsageText: "rocketpool api wallet sign-tx unsigned-tx",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					unsignedTx := c.Args().Get(0)
+
+					// Run
+					api.PrintResponse(signTransaction(c, unsignedTx))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "get-slashing-protection",
+				Usage:     "Get the EIP-3076 slashing protection history saved alongside the validator keys",
+				UsageText: "rocketpool api wallet get-slashing-protection",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getSlashingProtection(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "save-slashing-protection",
+				Usage:     "Merge an EIP-3076 slashing protection interchange into the history saved alongside the validator keys",
+				UsageText: "rocketpool api wallet save-slashing-protection interchange",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(saveSlashingProtection(c, c.Args().Get(0)))
+					return nil
+
+				},
+			},
+			{
+				Name:      "save-vc-slashing-protection",
+				Usage:     "Merge the slashing protection database the Validator Client exported into the history saved alongside the validator keys",
+				UsageText: "rocketpool api wallet save-vc-slashing-protection export-file",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(saveVcSlashingProtection(c, c.Args().Get(0)))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "estimate-gas-set-ens-name",
 				Usage:     "Estimate the gas required to set the name for the node wallet's ENS reverse record",
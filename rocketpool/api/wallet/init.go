@@ -10,7 +10,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
-func initWallet(c *cli.Context) (*api.InitWalletResponse, error) {
+func initWallet(c *cli.Context, derivationPath string) (*api.InitWalletResponse, error) {
 
 	// Get services
 	if err := services.RequireNodePassword(c); err != nil {
@@ -30,7 +30,7 @@ func initWallet(c *cli.Context) (*api.InitWalletResponse, error) {
 	}
 
 	// Get the derivation path
-	path := c.String("derivation-path")
+	path := derivationPath
 	switch path {
 	case "":
 		path = wallet.DefaultNodeKeyPath
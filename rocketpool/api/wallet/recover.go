@@ -18,7 +18,7 @@ const (
 	findIterations uint = 100000
 )
 
-func recoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletResponse, error) {
+func recoverWallet(c *cli.Context, mnemonic string, skipValidatorKeyRecovery bool, derivationPath string, walletIndex uint) (*api.RecoverWalletResponse, error) {
 
 	// Get services
 	if err := services.RequireNodePassword(c); err != nil {
@@ -29,7 +29,7 @@ func recoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletResponse,
 		return nil, err
 	}
 	var rp *rocketpool.RocketPool
-	if !c.Bool("skip-validator-key-recovery") {
+	if !skipValidatorKeyRecovery {
 		if err := services.RequireRocketStorage(c); err != nil {
 			return nil, err
 		}
@@ -48,7 +48,7 @@ func recoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletResponse,
 	}
 
 	// Get the derivation path
-	path := c.String("derivation-path")
+	path := derivationPath
 	switch path {
 	case "":
 		path = wallet.DefaultNodeKeyPath
@@ -58,9 +58,6 @@ func recoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletResponse,
 		path = wallet.MyEtherWalletNodeKeyPath
 	}
 
-	// Get the wallet index
-	walletIndex := c.Uint("wallet-index")
-
 	// Recover wallet
 	if err := w.Recover(path, walletIndex, mnemonic); err != nil {
 		return nil, err
@@ -73,7 +70,7 @@ func recoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletResponse,
 	}
 	response.AccountAddress = nodeAccount.Address
 
-	if !c.Bool("skip-validator-key-recovery") {
+	if !skipValidatorKeyRecovery {
 		response.ValidatorKeys, err = walletutils.RecoverMinipoolKeys(c, rp, nodeAccount.Address, w, false)
 		if err != nil {
 			return nil, err
@@ -90,7 +87,7 @@ func recoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletResponse,
 
 }
 
-func searchAndRecoverWallet(c *cli.Context, mnemonic string, address common.Address) (*api.SearchAndRecoverWalletResponse, error) {
+func searchAndRecoverWallet(c *cli.Context, mnemonic string, address common.Address, skipValidatorKeyRecovery bool) (*api.SearchAndRecoverWalletResponse, error) {
 
 	// Get services
 	if err := services.RequireNodePassword(c); err != nil {
@@ -101,7 +98,7 @@ func searchAndRecoverWallet(c *cli.Context, mnemonic string, address common.Addr
 		return nil, err
 	}
 	var rp *rocketpool.RocketPool
-	if !c.Bool("skip-validator-key-recovery") {
+	if !skipValidatorKeyRecovery {
 		if err := services.RequireRocketStorage(c); err != nil {
 			return nil, err
 		}
@@ -171,7 +168,7 @@ func searchAndRecoverWallet(c *cli.Context, mnemonic string, address common.Addr
 	}
 	response.AccountAddress = nodeAccount.Address
 
-	if !c.Bool("skip-validator-key-recovery") {
+	if !skipValidatorKeyRecovery {
 		response.ValidatorKeys, err = walletutils.RecoverMinipoolKeys(c, rp, nodeAccount.Address, w, false)
 		if err != nil {
 			return nil, err
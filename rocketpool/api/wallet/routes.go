@@ -0,0 +1,164 @@
+package wallet
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Get the routes the daemon's API server serves for the wallet commands
+func GetRoutes(name string) []apiutils.Route {
+	return []apiutils.Route{
+		{
+			Path:  name + "/status",
+			Usage: "Get the node wallet status",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getStatus(c)
+			},
+		},
+		{
+			Path:       name + "/set-password",
+			Usage:      "Set the node wallet password; takes a WalletSetPasswordRequest",
+			NewRequest: func() interface{} { return &api.WalletSetPasswordRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.WalletSetPasswordRequest)
+				password, err := cliutils.ValidateNodePassword("wallet password", r.Password)
+				if err != nil {
+					return nil, err
+				}
+				return setPassword(c, password)
+			},
+		},
+		{
+			Path:       name + "/init",
+			Usage:      "Initialize the node wallet; takes a WalletInitRequest",
+			NewRequest: func() interface{} { return &api.WalletInitRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.WalletInitRequest)
+				return initWallet(c, r.DerivationPath)
+			},
+		},
+		{
+			Path:       name + "/recover",
+			Usage:      "Recover a node wallet from a mnemonic phrase; takes a WalletRecoverRequest",
+			NewRequest: func() interface{} { return &api.WalletRecoverRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.WalletRecoverRequest)
+				mnemonic, err := cliutils.ValidateWalletMnemonic("mnemonic", r.Mnemonic)
+				if err != nil {
+					return nil, err
+				}
+				return recoverWallet(c, mnemonic, r.SkipValidatorKeyRecovery, r.DerivationPath, r.WalletIndex)
+			},
+		},
+		{
+			Path:       name + "/search-and-recover",
+			Usage:      "Search for and recover a node wallet's derivation key and index using a mnemonic phrase and a well-known address.; takes a WalletSearchAndRecoverRequest",
+			NewRequest: func() interface{} { return &api.WalletSearchAndRecoverRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.WalletSearchAndRecoverRequest)
+				mnemonic, err := cliutils.ValidateWalletMnemonic("mnemonic", r.Mnemonic)
+				if err != nil {
+					return nil, err
+				}
+				return searchAndRecoverWallet(c, mnemonic, r.Address, r.SkipValidatorKeyRecovery)
+			},
+		},
+		{
+			Path:       name + "/rebuild",
+			Usage:      "Rebuild validator keystores from derived keys; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return rebuildWallet(c)
+			},
+		},
+		{
+			Path:       name + "/test-recovery",
+			Usage:      "Test recovery of a node wallet and its validator keys without actually saving the recovered files; takes a WalletRecoverRequest",
+			NewRequest: func() interface{} { return &api.WalletRecoverRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.WalletRecoverRequest)
+				mnemonic, err := cliutils.ValidateWalletMnemonic("mnemonic", r.Mnemonic)
+				if err != nil {
+					return nil, err
+				}
+				return testRecoverWallet(c, mnemonic, r.SkipValidatorKeyRecovery, r.DerivationPath, r.WalletIndex)
+			},
+		},
+		{
+			Path:       name + "/test-search-and-recover",
+			Usage:      "Test searching for and recovery of a node wallet's derivation key, index, and validator keys using a mnemonic phrase and a well-known address.; takes a WalletSearchAndRecoverRequest",
+			NewRequest: func() interface{} { return &api.WalletSearchAndRecoverRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.WalletSearchAndRecoverRequest)
+				mnemonic, err := cliutils.ValidateWalletMnemonic("mnemonic", r.Mnemonic)
+				if err != nil {
+					return nil, err
+				}
+				return testSearchAndRecoverWallet(c, mnemonic, r.Address, r.SkipValidatorKeyRecovery)
+			},
+		},
+		{
+			Path:       name + "/export",
+			Usage:      "Export the node wallet in JSON format; takes an ApiServerRequest",
+			NewRequest: func() interface{} { return &api.ApiServerRequest{} },
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return exportWallet(c)
+			},
+		},
+		{
+			Path:       name + "/sign-tx",
+			Usage:      "Sign a transaction that was built for the node account on another machine with --unsigned-tx. The transaction can be its JSON form or its hex-encoded unsigned payload.; takes a WalletSignTransactionRequest",
+			NewRequest: func() interface{} { return &api.WalletSignTransactionRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.WalletSignTransactionRequest)
+				return signTransaction(c, r.UnsignedTx)
+			},
+		},
+		{
+			Path:  name + "/get-slashing-protection",
+			Usage: "Get the EIP-3076 slashing protection history saved alongside the validator keys",
+			Handler: func(c *cli.Context, _ interface{}) (interface{}, error) {
+				return getSlashingProtection(c)
+			},
+		},
+		{
+			Path:       name + "/save-slashing-protection",
+			Usage:      "Merge an EIP-3076 slashing protection interchange into the history saved alongside the validator keys; takes a WalletSaveSlashingProtectionRequest",
+			NewRequest: func() interface{} { return &api.WalletSaveSlashingProtectionRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.WalletSaveSlashingProtectionRequest)
+				return saveSlashingProtection(c, r.Interchange)
+			},
+		},
+		{
+			Path:       name + "/save-vc-slashing-protection",
+			Usage:      "Merge the slashing protection database the Validator Client exported into the history saved alongside the validator keys; takes a WalletSaveVcSlashingProtectionRequest",
+			NewRequest: func() interface{} { return &api.WalletSaveVcSlashingProtectionRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.WalletSaveVcSlashingProtectionRequest)
+				return saveVcSlashingProtection(c, r.ExportFile)
+			},
+		},
+		{
+			Path:       name + "/estimate-gas-set-ens-name",
+			Usage:      "Estimate the gas required to set the name for the node wallet's ENS reverse record; takes a WalletSetEnsNameRequest",
+			NewRequest: func() interface{} { return &api.WalletSetEnsNameRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.WalletSetEnsNameRequest)
+				return setEnsName(c, r.Name, true)
+			},
+		},
+		{
+			Path:       name + "/set-ens-name",
+			Usage:      "Set a name to the node wallet's ENS reverse record; takes a WalletSetEnsNameRequest",
+			NewRequest: func() interface{} { return &api.WalletSetEnsNameRequest{} },
+			Handler: func(c *cli.Context, request interface{}) (interface{}, error) {
+				r := request.(*api.WalletSetEnsNameRequest)
+				return setEnsName(c, r.Name, false)
+			},
+		},
+	}
+}
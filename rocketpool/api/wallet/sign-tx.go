@@ -0,0 +1,57 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/unsignedtx"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func signTransaction(c *cli.Context, unsignedTx string) (*api.SignTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SignTransactionResponse{}
+
+	// Decode the transaction
+	tx, from, err := unsignedtx.Parse([]byte(unsignedTx))
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure it was built for this wallet
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if from != (common.Address{}) && from != nodeAccount.Address {
+		return nil, fmt.Errorf("The transaction was built for %s, but this node wallet's address is %s.", from.Hex(), nodeAccount.Address.Hex())
+	}
+
+	// Sign it
+	signedTx, err := w.SignNodeTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+	response.SignedTransaction, err = signedTx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("Error encoding signed transaction: %w", err)
+	}
+	response.TxHash = signedTx.Hash()
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,136 @@
+package wallet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+func getSlashingProtection(c *cli.Context) (*api.GetSlashingProtectionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.GetSlashingProtectionResponse{}
+
+	// Get the saved history
+	interchange, exists, err := w.ExportSlashingProtection(nil)
+	if err != nil {
+		return nil, err
+	}
+	response.Exists = exists
+	response.Interchange = interchange
+
+	// Return response
+	return &response, nil
+
+}
+
+func saveSlashingProtection(c *cli.Context, serializedInterchange string) (*api.SaveSlashingProtectionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SaveSlashingProtectionResponse{}
+
+	// Merge the interchange into the saved history
+	validatorCount, err := importSlashingProtection(c, []byte(serializedInterchange))
+	if err != nil {
+		return nil, err
+	}
+	response.ValidatorCount = validatorCount
+
+	// Return response
+	return &response, nil
+
+}
+
+func saveVcSlashingProtection(c *cli.Context, exportFile string) (*api.SaveSlashingProtectionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SaveSlashingProtectionResponse{}
+
+	// Make sure the export file is in the validators folder
+	validatorsPath := filepath.Clean(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()))
+	exportPath := filepath.Join(validatorsPath, exportFile)
+	if !strings.HasPrefix(exportPath, validatorsPath+string(filepath.Separator)) {
+		return nil, fmt.Errorf("slashing protection export file %s is not in the validators folder", exportFile)
+	}
+
+	// Read the interchange the Validator Client exported
+	bytes, err := os.ReadFile(exportPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the Validator Client's slashing protection export: %w", err)
+	}
+
+	// Merge it into the saved history
+	validatorCount, err := importSlashingProtection(c, bytes)
+	if err != nil {
+		return nil, err
+	}
+	response.ValidatorCount = validatorCount
+
+	// Clean up the export now that it's been saved
+	err = os.Remove(exportPath)
+	if err != nil {
+		return nil, fmt.Errorf("error removing the Validator Client's slashing protection export: %w", err)
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Merge a serialized interchange into the saved history, making sure it's for this network
+func importSlashingProtection(c *cli.Context, serializedInterchange []byte) (int, error) {
+
+	// Get services
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return 0, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return 0, err
+	}
+
+	// Parse the interchange
+	interchange, err := validator.ParseSlashingProtectionInterchange(serializedInterchange)
+	if err != nil {
+		return 0, err
+	}
+
+	// Merge it into the saved history
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return 0, fmt.Errorf("error getting Beacon config: %w", err)
+	}
+	return w.ImportSlashingProtection(interchange, eth2Config.GenesisValidatorsRoot)
+
+}
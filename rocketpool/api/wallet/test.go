@@ -13,7 +13,7 @@ import (
 	walletutils "github.com/rocket-pool/smartnode/shared/utils/wallet"
 )
 
-func testRecoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletResponse, error) {
+func testRecoverWallet(c *cli.Context, mnemonic string, skipValidatorKeyRecovery bool, derivationPath string, walletIndex uint) (*api.RecoverWalletResponse, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -21,7 +21,7 @@ func testRecoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletRespo
 		return nil, err
 	}
 	var rp *rocketpool.RocketPool
-	if !c.Bool("skip-validator-key-recovery") {
+	if !skipValidatorKeyRecovery {
 		if err := services.RequireRocketStorage(c); err != nil {
 			return nil, err
 		}
@@ -42,7 +42,7 @@ func testRecoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletRespo
 	response := api.RecoverWalletResponse{}
 
 	// Get the derivation path
-	path := c.String("derivation-path")
+	path := derivationPath
 	switch path {
 	case "":
 		path = wallet.DefaultNodeKeyPath
@@ -52,9 +52,6 @@ func testRecoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletRespo
 		path = wallet.MyEtherWalletNodeKeyPath
 	}
 
-	// Get the wallet index
-	walletIndex := c.Uint("wallet-index")
-
 	// Recover wallet
 	if err := w.TestRecovery(path, walletIndex, mnemonic); err != nil {
 		return nil, err
@@ -67,7 +64,7 @@ func testRecoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletRespo
 	}
 	response.AccountAddress = nodeAccount.Address
 
-	if !c.Bool("skip-validator-key-recovery") {
+	if !skipValidatorKeyRecovery {
 		response.ValidatorKeys, err = walletutils.RecoverMinipoolKeys(c, rp, nodeAccount.Address, w, true)
 		if err != nil {
 			return nil, err
@@ -79,7 +76,7 @@ func testRecoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletRespo
 
 }
 
-func testSearchAndRecoverWallet(c *cli.Context, mnemonic string, address common.Address) (*api.SearchAndRecoverWalletResponse, error) {
+func testSearchAndRecoverWallet(c *cli.Context, mnemonic string, address common.Address, skipValidatorKeyRecovery bool) (*api.SearchAndRecoverWalletResponse, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -87,7 +84,7 @@ func testSearchAndRecoverWallet(c *cli.Context, mnemonic string, address common.
 		return nil, err
 	}
 	var rp *rocketpool.RocketPool
-	if !c.Bool("skip-validator-key-recovery") {
+	if !skipValidatorKeyRecovery {
 		if err := services.RequireRocketStorage(c); err != nil {
 			return nil, err
 		}
@@ -159,7 +156,7 @@ func testSearchAndRecoverWallet(c *cli.Context, mnemonic string, address common.
 	}
 	response.AccountAddress = nodeAccount.Address
 
-	if !c.Bool("skip-validator-key-recovery") {
+	if !skipValidatorKeyRecovery {
 		response.ValidatorKeys, err = walletutils.RecoverMinipoolKeys(c, rp, nodeAccount.Address, w, true)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,435 @@
+package node
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/urfave/cli"
+
+	rpapi "github.com/rocket-pool/smartnode/rocketpool/api"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/simulation"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Config
+const (
+	ApiServerRoutePrefix string = "/api/v1/"
+
+	apiServerTokenBytes      int   = 32
+	apiServerMaxRequestBytes int64 = 16 * 1024 * 1024
+	apiServerCertLifetime          = 10 * 365 * 24 * time.Hour
+	apiServerDirMode               = 0700
+	apiServerSocketMode            = 0660
+	apiServerSecretFileMode        = 0600
+	apiServerPublicFileMode        = 0644
+)
+
+// The global flags an API server request can set for itself; the rest are inherited from the daemon
+var apiServerRequestFlags = map[string]bool{
+	"maxFee":     true,
+	"maxPrioFee": true,
+	"gasLimit":   true,
+	"nonce":      true,
+	"simulate":   true,
+}
+
+// A request body that carries its own transaction settings
+type apiServerSettingsRequest interface {
+	GetApiServerSettings() api.ApiServerSettings
+}
+
+// A long-lived HTTP server that runs the API handlers in this process, so callers don't have to start a new API process for every call
+type apiServer struct {
+	c      *cli.Context
+	log    log.ColorLogger
+	token  string
+	routes map[string]apiutils.Route
+
+	// Transaction simulations are recorded for the whole process, so requests run one at a time
+	lock sync.Mutex
+}
+
+// Run the API server on its unix socket, and over TLS if enabled
+func runApiServer(c *cli.Context, logger log.ColorLogger) error {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+
+	// Return if the API server is disabled
+	if cfg.Smartnode.EnableApiServer.Value == false {
+		return nil
+	}
+
+	// Create the API server's folder; only the daemon and the user that owns the data folder can get into it
+	tokenPath := cfg.Smartnode.GetApiServerTokenPath(true)
+	folder := filepath.Dir(tokenPath)
+	dataPath := filepath.Dir(folder)
+	err = os.MkdirAll(folder, apiServerDirMode)
+	if err != nil {
+		return fmt.Errorf("error creating API server folder: %w", err)
+	}
+	err = setApiServerFilePermissions(folder, apiServerDirMode, dataPath)
+	if err != nil {
+		return err
+	}
+
+	// Load the bearer token, creating it if it doesn't exist yet
+	token, err := loadApiServerToken(tokenPath, dataPath)
+	if err != nil {
+		return err
+	}
+
+	server := &apiServer{
+		c:      c,
+		log:    logger,
+		token:  token,
+		routes: map[string]apiutils.Route{},
+	}
+	for _, route := range rpapi.GetRoutes() {
+		server.routes[route.Path] = route
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(ApiServerRoutePrefix, server.handleRequest)
+	httpServer := &http.Server{
+		Handler: mux,
+	}
+
+	// Serve over TLS on a separate listener if enabled
+	errs := make(chan error, 2)
+	if cfg.Smartnode.EnableApiServerTls.Value == true {
+		certPath := cfg.Smartnode.GetApiServerCertPath(true)
+		keyPath := cfg.Smartnode.GetApiServerKeyPath(true)
+		err = createApiServerCert(certPath, keyPath)
+		if err != nil {
+			return err
+		}
+		address := net.JoinHostPort(cfg.Smartnode.ApiServerAddress.Value.(string), fmt.Sprint(cfg.Smartnode.ApiServerPort.Value.(uint16)))
+		listener, err := net.Listen("tcp", address)
+		if err != nil {
+			return fmt.Errorf("error listening on %s: %w", address, err)
+		}
+		logger.Printlnf("Starting API server on %s over TLS.", address)
+		go func() {
+			errs <- httpServer.ServeTLS(listener, certPath, keyPath)
+		}()
+	}
+
+	// Serve on the unix socket, replacing the one left behind by the last run
+	socketPath := cfg.Smartnode.GetApiServerSocketPath(true)
+	err = os.Remove(socketPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing old API server socket: %w", err)
+	}
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("error listening on %s: %w", socketPath, err)
+	}
+	err = setApiServerFilePermissions(socketPath, apiServerSocketMode, dataPath)
+	if err != nil {
+		return err
+	}
+	logger.Printlnf("Starting API server on %s.", socketPath)
+	go func() {
+		errs <- httpServer.Serve(listener)
+	}()
+
+	err = <-errs
+	return fmt.Errorf("Error running API server: %w", err)
+
+}
+
+// Handle a request to one of the API server's routes
+func (s *apiServer) handleRequest(w http.ResponseWriter, r *http.Request) {
+
+	w.Header().Set("Content-Type", "application/json")
+
+	// Check the bearer token
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, "Bearer ") || subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(authorization, "Bearer ")), []byte(s.token)) != 1 {
+		writeApiServerError(w, http.StatusUnauthorized, errors.New("missing or invalid bearer token"))
+		return
+	}
+
+	// List the routes
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, ApiServerRoutePrefix), "/")
+	if path == "" {
+		if r.Method != http.MethodGet {
+			writeApiServerError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+			return
+		}
+		s.writeRoutes(w)
+		return
+	}
+
+	// Find the route
+	route, exists := s.routes[path]
+	if !exists {
+		writeApiServerError(w, http.StatusNotFound, fmt.Errorf("unknown route %s", r.URL.Path))
+		return
+	}
+	if r.Method != getApiServerRouteMethod(route) {
+		writeApiServerError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+		return
+	}
+
+	// Parse the request
+	var request interface{}
+	var settings api.ApiServerSettings
+	if route.NewRequest != nil {
+		request = route.NewRequest()
+		decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, apiServerMaxRequestBytes))
+		decoder.DisallowUnknownFields()
+		err := decoder.Decode(request)
+		if err != nil {
+			writeApiServerError(w, http.StatusBadRequest, fmt.Errorf("error decoding request: %w", err))
+			return
+		}
+		if settingsRequest, ok := request.(apiServerSettingsRequest); ok {
+			settings = settingsRequest.GetApiServerSettings()
+		}
+	}
+
+	// Run the handler with its own context, so the request's settings only apply to it
+	c, err := s.getRequestContext(settings)
+	if err != nil {
+		writeApiServerError(w, http.StatusBadRequest, err)
+		return
+	}
+	w.Write(s.runHandler(c, route, request))
+
+}
+
+// Run a route's handler and encode its response
+func (s *apiServer) runHandler(c *cli.Context, route apiutils.Route, request interface{}) []byte {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	simulation.ClearResults()
+	response, err := route.Handler(c, request)
+	return apiutils.EncodeResponse(response, err)
+}
+
+// Create the context for a request, with the daemon's global flags and the request's transaction settings
+func (s *apiServer) getRequestContext(settings api.ApiServerSettings) (*cli.Context, error) {
+
+	flags := flag.NewFlagSet(s.c.App.Name, flag.ContinueOnError)
+	for _, appFlag := range s.c.App.Flags {
+		appFlag.Apply(flags)
+	}
+
+	// Inherit the daemon's flags, like the path of the settings file
+	for _, name := range s.c.GlobalFlagNames() {
+		if apiServerRequestFlags[name] || !s.c.GlobalIsSet(name) {
+			continue
+		}
+		err := flags.Set(name, fmt.Sprint(s.c.GlobalGeneric(name)))
+		if err != nil {
+			return nil, fmt.Errorf("error setting flag %s: %w", name, err)
+		}
+	}
+
+	// Apply the request's settings
+	requestFlags := map[string]string{}
+	if settings.MaxFee != 0 {
+		requestFlags["maxFee"] = fmt.Sprint(settings.MaxFee)
+	}
+	if settings.MaxPrioFee != 0 {
+		requestFlags["maxPrioFee"] = fmt.Sprint(settings.MaxPrioFee)
+	}
+	if settings.CustomNonce != nil {
+		requestFlags["nonce"] = settings.CustomNonce.String()
+	}
+	if settings.Simulate {
+		requestFlags["simulate"] = "true"
+	}
+	for name, value := range requestFlags {
+		err := flags.Set(name, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s setting: %w", name, err)
+		}
+	}
+
+	return cli.NewContext(s.c.App, flags, nil), nil
+
+}
+
+// Write the list of routes
+func (s *apiServer) writeRoutes(w http.ResponseWriter) {
+	response := api.ApiServerRoutesResponse{
+		Status: "success",
+		Routes: make([]api.ApiServerRoute, 0, len(s.routes)),
+	}
+	for _, route := range s.routes {
+		response.Routes = append(response.Routes, api.ApiServerRoute{
+			Path:   ApiServerRoutePrefix + route.Path,
+			Method: getApiServerRouteMethod(route),
+			Usage:  route.Usage,
+		})
+	}
+	sort.Slice(response.Routes, func(i, j int) bool {
+		return response.Routes[i].Path < response.Routes[j].Path
+	})
+	bytes, err := json.Marshal(response)
+	if err != nil {
+		writeApiServerError(w, http.StatusInternalServerError, fmt.Errorf("error serializing routes: %w", err))
+		return
+	}
+	w.Write(bytes)
+}
+
+// Get the HTTP method of a route; routes that take a request body are served over POST
+func getApiServerRouteMethod(route apiutils.Route) string {
+	if route.NewRequest == nil {
+		return http.MethodGet
+	}
+	return http.MethodPost
+}
+
+// Write an error that happened before the handler could run
+func writeApiServerError(w http.ResponseWriter, status int, err error) {
+	bytes, _ := json.Marshal(api.APIResponse{
+		Status: "error",
+		Error:  err.Error(),
+	})
+	w.WriteHeader(status)
+	w.Write(bytes)
+}
+
+// Load the API server's bearer token, creating a random one if it doesn't exist yet
+func loadApiServerToken(path string, dataPath string) (string, error) {
+
+	bytes, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("error reading API server token: %w", err)
+	}
+	token := strings.TrimSpace(string(bytes))
+
+	if os.IsNotExist(err) {
+		tokenBytes := make([]byte, apiServerTokenBytes)
+		_, err = rand.Read(tokenBytes)
+		if err != nil {
+			return "", fmt.Errorf("error generating API server token: %w", err)
+		}
+		token = hex.EncodeToString(tokenBytes)
+		err = os.WriteFile(path, []byte(token), apiServerSecretFileMode)
+		if err != nil {
+			return "", fmt.Errorf("error saving API server token: %w", err)
+		}
+	}
+
+	// The token can send transactions from the node wallet, so only the daemon and the CLI's user can read it
+	err = setApiServerFilePermissions(path, apiServerSecretFileMode, dataPath)
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+
+}
+
+// Set the permissions of one of the API server's files.
+// The daemon runs as root, but the CLI runs as the user that owns the data folder, so that user is given ownership of the file.
+func setApiServerFilePermissions(path string, mode os.FileMode, dataPath string) error {
+	err := os.Chmod(path, mode)
+	if err != nil {
+		return fmt.Errorf("error setting permissions of %s: %w", path, err)
+	}
+	if os.Geteuid() != 0 {
+		return nil
+	}
+
+	info, err := os.Stat(dataPath)
+	if err != nil {
+		return fmt.Errorf("error checking the owner of the data folder: %w", err)
+	}
+	owner, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil
+	}
+	err = os.Chown(path, int(owner.Uid), int(owner.Gid))
+	if err != nil {
+		return fmt.Errorf("error setting the owner of %s: %w", path, err)
+	}
+	return nil
+}
+
+// Create a self-signed certificate for serving the API over TLS if there isn't one yet; clients pin it instead of checking it against a CA
+func createApiServerCert(certPath string, keyPath string) error {
+
+	_, err := os.Stat(certPath)
+	if err == nil {
+		return nil
+	}
+	if !os.IsNotExist(err) {
+		return fmt.Errorf("error checking API server certificate: %w", err)
+	}
+
+	// Create the key
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("error generating API server key: %w", err)
+	}
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return fmt.Errorf("error serializing API server key: %w", err)
+	}
+
+	// Create the certificate
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return fmt.Errorf("error generating API server certificate serial number: %w", err)
+	}
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "Rocket Pool Smartnode API"},
+		NotBefore:             now,
+		NotAfter:              now.Add(apiServerCertLifetime),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return fmt.Errorf("error creating API server certificate: %w", err)
+	}
+
+	// Save them
+	err = os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), apiServerSecretFileMode)
+	if err != nil {
+		return fmt.Errorf("error saving API server key: %w", err)
+	}
+	err = os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes}), apiServerPublicFileMode)
+	if err != nil {
+		return fmt.Errorf("error saving API server certificate: %w", err)
+	}
+	return nil
+
+}
@@ -0,0 +1,47 @@
+package node
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Check pending transactions task
+type checkPendingTransactions struct {
+	c   *cli.Context
+	log log.ColorLogger
+	txm *txmanager.TransactionManager
+}
+
+// Create check pending transactions task
+func newCheckPendingTransactions(c *cli.Context, logger log.ColorLogger) (*checkPendingTransactions, error) {
+
+	// Get services
+	txm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &checkPendingTransactions{
+		c:   c,
+		log: logger,
+		txm: txm,
+	}, nil
+
+}
+
+// Resend dropped transactions and replace stuck ones
+func (t *checkPendingTransactions) run(state *state.NetworkState) error {
+
+	// Wait for eth client to sync
+	if err := services.WaitEthClientSynced(t.c, true); err != nil {
+		return err
+	}
+
+	return t.txm.CheckPendingTransactions(&t.log)
+
+}
@@ -0,0 +1,123 @@
+package collectors
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Represents the collector for the Execution and Consensus client pools
+type ClientPoolCollector struct {
+	// Whether each client is synced and taking requests
+	ready *prometheus.Desc
+
+	// Whether each client is the one currently taking requests
+	active *prometheus.Desc
+
+	// The latest block (EC) or slot (BN) each client reported
+	head *prometheus.Desc
+
+	// How far each client's head is behind the best one in the pool
+	headLag *prometheus.Desc
+
+	// The moving average of each client's response time
+	latency *prometheus.Desc
+
+	// The moving average of the fraction of each client's requests that failed
+	errorRate *prometheus.Desc
+
+	// The health score of each client
+	score *prometheus.Desc
+
+	// The Execution client manager
+	ecManager *services.ExecutionClientManager
+
+	// The Beacon client manager
+	bcManager *services.BeaconClientManager
+}
+
+// Create a new ClientPoolCollector instance
+func NewClientPoolCollector(ecManager *services.ExecutionClientManager, bcManager *services.BeaconClientManager) *ClientPoolCollector {
+	subsystem := "client_pool"
+	labels := []string{"client_type", "index", "endpoint"}
+	return &ClientPoolCollector{
+		ready: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "ready"),
+			"1 if the client is synced and can take requests, 0 if not",
+			labels, nil,
+		),
+		active: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "active"),
+			"1 if the client is the one currently taking requests, 0 if not",
+			labels, nil,
+		),
+		head: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "head"),
+			"The latest block (Execution) or slot (Consensus) the client reported",
+			labels, nil,
+		),
+		headLag: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "head_lag"),
+			"How far the client's head is behind the best client in the pool",
+			labels, nil,
+		),
+		latency: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "latency_milliseconds"),
+			"The moving average of the client's response time",
+			labels, nil,
+		),
+		errorRate: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "error_rate"),
+			"The moving average of the fraction of the client's requests that failed",
+			labels, nil,
+		),
+		score: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "score"),
+			"The client's health score, from 0 to 100",
+			labels, nil,
+		),
+		ecManager: ecManager,
+		bcManager: bcManager,
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *ClientPoolCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.ready
+	channel <- collector.active
+	channel <- collector.head
+	channel <- collector.headLag
+	channel <- collector.latency
+	channel <- collector.errorRate
+	channel <- collector.score
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *ClientPoolCollector) Collect(channel chan<- prometheus.Metric) {
+	collector.collectPool(channel, "execution", collector.ecManager.GetPoolStatus())
+	collector.collectPool(channel, "consensus", collector.bcManager.GetPoolStatus())
+}
+
+// Collect the metrics for a single pool
+func (collector *ClientPoolCollector) collectPool(channel chan<- prometheus.Metric, clientType string, status *api.ClientManagerStatus) {
+	for i, endpoint := range status.Endpoints {
+		index := fmt.Sprint(i)
+		channel <- prometheus.MustNewConstMetric(
+			collector.ready, prometheus.GaugeValue, boolToFloat(endpoint.Status.IsSynced), clientType, index, endpoint.Endpoint)
+		channel <- prometheus.MustNewConstMetric(
+			collector.active, prometheus.GaugeValue, boolToFloat(endpoint.IsActive), clientType, index, endpoint.Endpoint)
+		channel <- prometheus.MustNewConstMetric(
+			collector.head, prometheus.GaugeValue, float64(endpoint.Head), clientType, index, endpoint.Endpoint)
+		channel <- prometheus.MustNewConstMetric(
+			collector.headLag, prometheus.GaugeValue, float64(endpoint.HeadLag), clientType, index, endpoint.Endpoint)
+		channel <- prometheus.MustNewConstMetric(
+			collector.latency, prometheus.GaugeValue, endpoint.LatencyMs, clientType, index, endpoint.Endpoint)
+		channel <- prometheus.MustNewConstMetric(
+			collector.errorRate, prometheus.GaugeValue, endpoint.ErrorRate, clientType, index, endpoint.Endpoint)
+		channel <- prometheus.MustNewConstMetric(
+			collector.score, prometheus.GaugeValue, endpoint.Score, clientType, index, endpoint.Endpoint)
+	}
+}
+
+// Convert a flag to a gauge value
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,126 @@
+package collectors
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The recent performance of one of the node's validators
+type ValidatorPerformance struct {
+	MinipoolAddress       string
+	AttestationHitRate    float64
+	MissedAttestations    float64
+	Proposals             float64
+	MissedProposals       float64
+	SyncDuties            float64
+	SyncParticipationRate float64
+}
+
+// Represents the collector for the per-validator performance metrics
+type ValidatorPerformanceCollector struct {
+	// The fraction of attestation duties that were included
+	attestationHitRateDesc *prometheus.Desc
+
+	// The number of attestation duties that were missed
+	missedAttestationsDesc *prometheus.Desc
+
+	// The number of blocks that were proposed
+	proposalsDesc *prometheus.Desc
+
+	// The number of block proposals that were missed
+	missedProposalsDesc *prometheus.Desc
+
+	// The number of sync committee duties
+	syncDutiesDesc *prometheus.Desc
+
+	// The fraction of sync committee duties that were fulfilled
+	syncParticipationRateDesc *prometheus.Desc
+
+	// The number of epochs the metrics cover
+	epochsDesc *prometheus.Desc
+
+	// The latest performance of each validator, by validator index
+	Performance map[string]ValidatorPerformance
+
+	// The number of epochs the metrics cover
+	Epochs float64
+
+	// Mutex
+	UpdateLock *sync.Mutex
+}
+
+// Create a new ValidatorPerformanceCollector instance
+func NewValidatorPerformanceCollector() *ValidatorPerformanceCollector {
+	subsystem := "validator"
+	labels := []string{"validator", "minipool"}
+	return &ValidatorPerformanceCollector{
+		attestationHitRateDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "attestation_hit_rate"),
+			"The fraction of the validator's recent attestation duties that were included",
+			labels, nil,
+		),
+		missedAttestationsDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "missed_attestations"),
+			"The number of the validator's recent attestation duties that were missed",
+			labels, nil,
+		),
+		proposalsDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "proposals"),
+			"The number of blocks the validator recently proposed",
+			labels, nil,
+		),
+		missedProposalsDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "missed_proposals"),
+			"The number of block proposals the validator recently missed",
+			labels, nil,
+		),
+		syncDutiesDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "sync_committee_duties"),
+			"The number of slots the validator was recently on a sync committee for",
+			labels, nil,
+		),
+		syncParticipationRateDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "sync_committee_participation_rate"),
+			"The fraction of the validator's recent sync committee duties that were fulfilled",
+			labels, nil,
+		),
+		epochsDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "performance_epochs"),
+			"The number of recent epochs the validator performance metrics cover",
+			nil, nil,
+		),
+		Performance: map[string]ValidatorPerformance{},
+		UpdateLock:  &sync.Mutex{},
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *ValidatorPerformanceCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.attestationHitRateDesc
+	channel <- collector.missedAttestationsDesc
+	channel <- collector.proposalsDesc
+	channel <- collector.missedProposalsDesc
+	channel <- collector.syncDutiesDesc
+	channel <- collector.syncParticipationRateDesc
+	channel <- collector.epochsDesc
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *ValidatorPerformanceCollector) Collect(channel chan<- prometheus.Metric) {
+
+	// Sync
+	collector.UpdateLock.Lock()
+	defer collector.UpdateLock.Unlock()
+
+	// Update all of the metrics
+	channel <- prometheus.MustNewConstMetric(
+		collector.epochsDesc, prometheus.GaugeValue, collector.Epochs)
+	for index, performance := range collector.Performance {
+		channel <- prometheus.MustNewConstMetric(
+			collector.attestationHitRateDesc, prometheus.GaugeValue, performance.AttestationHitRate, index, performance.MinipoolAddress)
+		channel <- prometheus.MustNewConstMetric(
+			collector.missedAttestationsDesc, prometheus.GaugeValue, performance.MissedAttestations, index, performance.MinipoolAddress)
+		channel <- prometheus.MustNewConstMetric(
+			collector.proposalsDesc, prometheus.GaugeValue, performance.Proposals, index, performance.MinipoolAddress)
+		channel <- prometheus.MustNewConstMetric(
+			collector.missedProposalsDesc, prometheus.GaugeValue, performance.MissedProposals, index, performance.MinipoolAddress)
+		channel <- prometheus.MustNewConstMetric(
+			collector.syncDutiesDesc, prometheus.GaugeValue, performance.SyncDuties, index, performance.MinipoolAddress)
+		channel <- prometheus.MustNewConstMetric(
+			collector.syncParticipationRateDesc, prometheus.GaugeValue, performance.SyncParticipationRate, index, performance.MinipoolAddress)
+	}
+}
@@ -117,10 +117,10 @@ func (d *downloadRewardsTrees) run(state *state.NetworkState) error {
 		if err != nil {
 			return fmt.Errorf("error getting interval %d info: %w", missingInterval, err)
 		}
-		err = rprewards.DownloadRewardsFile(d.cfg, missingInterval, intervalInfo.CID, true)
+		err = rprewards.DownloadRewardsFile(d.cfg, missingInterval, intervalInfo.CID, intervalInfo.MerkleRoot, true)
 		if err != nil {
 			fmt.Println()
-			return err
+			return fmt.Errorf("no source provided a valid rewards tree file for interval %d:\n%w", missingInterval, err)
 		}
 		fmt.Println("done!")
 	}
@@ -11,6 +11,8 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
+	"github.com/rocket-pool/smartnode/shared/services/notifications"
 	rpsvc "github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/services/state"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
@@ -28,6 +30,7 @@ type manageFeeRecipient struct {
 	rp  *rocketpool.RocketPool
 	d   *client.Client
 	bc  beacon.Client
+	n   *notifications.Notifier
 }
 
 // Create manage fee recipient task
@@ -54,6 +57,10 @@ func newManageFeeRecipient(c *cli.Context, logger log.ColorLogger) (*manageFeeRe
 	if err != nil {
 		return nil, err
 	}
+	n, err := services.GetNotifier(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Return task
 	return &manageFeeRecipient{
@@ -64,6 +71,7 @@ func newManageFeeRecipient(c *cli.Context, logger log.ColorLogger) (*manageFeeRe
 		rp:  rp,
 		d:   d,
 		bc:  bc,
+		n:   n,
 	}, nil
 
 }
@@ -117,6 +125,7 @@ func (m *manageFeeRecipient) run(state *state.NetworkState) error {
 	// Regenerate the fee recipient files
 	err = rpsvc.UpdateFeeRecipientFile(correctFeeRecipient, m.cfg)
 	if err != nil {
+		updateErr := err
 		m.log.Println("***ERROR***")
 		m.log.Printlnf("Error updating fee recipient files: %s", err.Error())
 		m.log.Println("Shutting down the validator client for safety to prevent you from being penalized...")
@@ -125,18 +134,22 @@ func (m *manageFeeRecipient) run(state *state.NetworkState) error {
 		if err != nil {
 			return fmt.Errorf("error stopping validator client: %w", err)
 		}
+		m.n.Notify(&m.log, notifications.EventType_FeeRecipientCorrected, "Validator client stopped", fmt.Sprintf("Your Validator Client's fee recipient needed to be corrected to %s, but the fee recipient files couldn't be updated (%s). The Validator Client was stopped for safety to prevent you from being penalized.", correctFeeRecipient.Hex(), updateErr.Error()))
 		return nil
 	}
 
-	// Restart the VC
-	m.log.Println("Fee recipient files updated successfully! Restarting validator client...")
-	err = validator.RestartValidator(m.cfg, m.bc, &m.log, m.d)
+	// Apply it to the VC
+	m.log.Println("Fee recipient files updated successfully! Applying the new fee recipient to the validator client...")
+	_, err = keymanager.SetFeeRecipient(m.cfg, m.bc, &m.log, m.d, correctFeeRecipient)
 	if err != nil {
-		return fmt.Errorf("error restarting validator client: %w", err)
+		return fmt.Errorf("error applying the fee recipient to the validator client: %w", err)
 	}
 
 	// Log & return
-	m.log.Println("Successfully restarted, you are now validating safely.")
+	m.log.Println("Successfully updated, you are now validating safely.")
+	if fileExists {
+		m.n.Notify(&m.log, notifications.EventType_FeeRecipientCorrected, "Fee recipient corrected", fmt.Sprintf("Your Validator Client was using the wrong fee recipient, so it was corrected to %s.", correctFeeRecipient.Hex()))
+	}
 	return nil
 
 }
@@ -10,11 +10,12 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rocket-pool/smartnode/rocketpool/node/collectors"
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/urfave/cli"
 )
 
-func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *collectors.StateLocker) error {
+func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *collectors.StateLocker, validatorPerformanceCollector *collectors.ValidatorPerformanceCollector, runner *tasks.Runner) error {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -66,6 +67,8 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *colle
 	trustedNodeCollector := collectors.NewTrustedNodeCollector(rp, bc, nodeAccount.Address, cfg, stateLocker)
 	beaconCollector := collectors.NewBeaconCollector(rp, bc, ec, nodeAccount.Address, stateLocker)
 	smoothingPoolCollector := collectors.NewSmoothingPoolCollector(rp, ec, stateLocker)
+	taskCollector := tasks.NewTaskCollector(runner)
+	clientPoolCollector := collectors.NewClientPoolCollector(ec, bc)
 
 	// Set up Prometheus
 	registry := prometheus.NewRegistry()
@@ -78,6 +81,9 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *colle
 	registry.MustRegister(trustedNodeCollector)
 	registry.MustRegister(beaconCollector)
 	registry.MustRegister(smoothingPoolCollector)
+	registry.MustRegister(taskCollector)
+	registry.MustRegister(clientPoolCollector)
+	registry.MustRegister(validatorPerformanceCollector)
 
 	// Set up snapshot checking if enabled
 	votingId := cfg.Smartnode.GetVotingSnapshotID()
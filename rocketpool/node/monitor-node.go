@@ -0,0 +1,221 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/notifications"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// The event minipools emit when an Oracle DAO member votes to scrub them
+var scrubVotedEventId = crypto.Keccak256Hash([]byte("ScrubVoted(address,uint256)"))
+
+// Monitor node task; watches for problems that don't have a task of their own and sends notifications about them
+type monitorNode struct {
+	c        *cli.Context
+	log      log.ColorLogger
+	cfg      *config.RocketPoolConfig
+	w        *wallet.Wallet
+	rp       *rocketpool.RocketPool
+	ec       *services.ExecutionClientManager
+	bc       *services.BeaconClientManager
+	notifier *notifications.Notifier
+
+	// The state as of the last run, so notifications are only sent when something changes
+	ecOnFallback    bool
+	bcOnFallback    bool
+	lowBalance      bool
+	scrubCheckBlock uint64
+	seenScrubVotes  map[common.Address]map[common.Address]bool
+}
+
+// Create monitor node task
+func newMonitorNode(c *cli.Context, logger log.ColorLogger) (*monitorNode, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	notifier, err := services.GetNotifier(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &monitorNode{
+		c:              c,
+		log:            logger,
+		cfg:            cfg,
+		w:              w,
+		rp:             rp,
+		ec:             ec,
+		bc:             bc,
+		notifier:       notifier,
+		seenScrubVotes: map[common.Address]map[common.Address]bool{},
+	}, nil
+
+}
+
+// Monitor the node
+func (t *monitorNode) run(state *state.NetworkState) error {
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Check the clients
+	t.ecOnFallback = t.checkClientFallback("Execution", t.ec.GetPoolStatus(), t.ecOnFallback)
+	t.bcOnFallback = t.checkClientFallback("Consensus", t.bc.GetPoolStatus(), t.bcOnFallback)
+
+	// Check the node's ETH balance
+	t.checkEthBalance(nodeAccount.Address, state)
+
+	// Check for scrub votes against the node's minipools
+	err = t.checkScrubVotes(nodeAccount.Address, state)
+	if err != nil {
+		return fmt.Errorf("error checking for scrub votes: %w", err)
+	}
+
+	return nil
+
+}
+
+// Send a notification when requests move from the primary client to a fallback, or back again.
+// Returns whether a fallback client is active.
+func (t *monitorNode) checkClientFallback(clientType string, status *api.ClientManagerStatus, wasOnFallback bool) bool {
+
+	if !status.FallbackEnabled {
+		return false
+	}
+	activeIndex := -1
+	for i, endpoint := range status.Endpoints {
+		if endpoint.IsActive {
+			activeIndex = i
+			break
+		}
+	}
+	if activeIndex == -1 {
+		// No requests have been made yet
+		return wasOnFallback
+	}
+
+	onFallback := activeIndex > 0
+	if onFallback && !wasOnFallback {
+		primaryError := status.PrimaryClientStatus.Error
+		if primaryError == "" {
+			primaryError = "it isn't ready"
+		}
+		t.notifier.Notify(&t.log, notifications.EventType_ClientFallback, fmt.Sprintf("%s client fallback", clientType), fmt.Sprintf("Your primary %s client can't be used (%s), so the node is using the fallback client %s.", clientType, primaryError, status.Endpoints[activeIndex].Endpoint))
+	} else if !onFallback && wasOnFallback {
+		t.notifier.Notify(&t.log, notifications.EventType_ClientFallback, fmt.Sprintf("%s client recovered", clientType), fmt.Sprintf("Your primary %s client is ready again, so the node has switched back to it.", clientType))
+	}
+	return onFallback
+
+}
+
+// Send a notification when the node's ETH balance drops below the threshold for paying gas
+func (t *monitorNode) checkEthBalance(nodeAddress common.Address, state *state.NetworkState) {
+
+	nodeDetails, exists := state.NodeDetailsByAddress[nodeAddress]
+	if !exists || nodeDetails.BalanceETH == nil {
+		return
+	}
+	threshold := t.cfg.Smartnode.LowEthBalanceThreshold.Value.(float64)
+	balance := eth.WeiToEth(nodeDetails.BalanceETH)
+
+	lowBalance := balance < threshold
+	if lowBalance && !t.lowBalance {
+		t.log.Printlnf("WARNING: your node wallet only has %.6f ETH left to pay for gas.", balance)
+		t.notifier.Notify(&t.log, notifications.EventType_LowEthBalance, "Low ETH balance", fmt.Sprintf("Your node wallet only has %.6f ETH, which is below your threshold of %.6f ETH. Please send it more ETH so the node daemon can keep paying for gas.", balance, threshold))
+	}
+	t.lowBalance = lowBalance
+
+}
+
+// Send a notification for each new Oracle DAO scrub vote against one of the node's prelaunch minipools
+func (t *monitorNode) checkScrubVotes(nodeAddress common.Address, state *state.NetworkState) error {
+
+	// Get the node's prelaunch minipools
+	addresses := []common.Address{}
+	fromBlock := t.scrubCheckBlock + 1
+	for _, mpd := range state.MinipoolDetailsByNode[nodeAddress] {
+		if mpd.Status != types.Prelaunch {
+			continue
+		}
+		addresses = append(addresses, mpd.MinipoolAddress)
+
+		// Start from when the oldest one entered prelaunch the first time this runs
+		if t.scrubCheckBlock == 0 && mpd.StatusBlock != nil && (fromBlock == 1 || mpd.StatusBlock.Uint64() < fromBlock) {
+			fromBlock = mpd.StatusBlock.Uint64()
+		}
+	}
+	toBlock := state.ElBlockNumber
+	if len(addresses) == 0 || fromBlock > toBlock {
+		t.scrubCheckBlock = toBlock
+		return nil
+	}
+
+	// Get the scrub votes
+	intervalSize, err := t.cfg.GetEventLogInterval()
+	if err != nil {
+		return fmt.Errorf("error getting event log interval: %w", err)
+	}
+	logs, err := eth.GetLogs(t.rp, addresses, [][]common.Hash{{scrubVotedEventId}}, big.NewInt(int64(intervalSize)), new(big.Int).SetUint64(fromBlock), new(big.Int).SetUint64(toBlock), nil)
+	if err != nil {
+		return err
+	}
+	for _, eventLog := range logs {
+		if len(eventLog.Topics) < 2 {
+			continue
+		}
+		member := common.BytesToAddress(eventLog.Topics[1].Bytes())
+		votes, exists := t.seenScrubVotes[eventLog.Address]
+		if !exists {
+			votes = map[common.Address]bool{}
+			t.seenScrubVotes[eventLog.Address] = votes
+		}
+		if votes[member] {
+			continue
+		}
+		votes[member] = true
+
+		t.log.Printlnf("WARNING: Oracle DAO member %s voted to scrub minipool %s.", member.Hex(), eventLog.Address.Hex())
+		t.notifier.Notify(&t.log, notifications.EventType_ScrubVote, "Scrub vote against your minipool", fmt.Sprintf("Oracle DAO member %s voted to scrub minipool %s in block %d (%d vote(s) so far). If a majority of the Oracle DAO votes to scrub it, the minipool will be dissolved and your node will be penalized. Please check its withdrawal credentials.", member.Hex(), eventLog.Address.Hex(), eventLog.BlockNumber, len(votes)))
+	}
+
+	t.scrubCheckBlock = toBlock
+	return nil
+
+}
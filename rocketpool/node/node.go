@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"net/http"
@@ -15,8 +16,10 @@ import (
 
 	"github.com/rocket-pool/smartnode/rocketpool/node/collectors"
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/prysm"
@@ -39,9 +42,13 @@ const (
 	PromoteMinipoolsColor        = color.FgMagenta
 	ReduceBondAmountColor        = color.FgHiBlue
 	DistributeMinipoolsColor     = color.FgHiGreen
+	PendingTransactionsColor     = color.FgHiMagenta
+	ValidatorPerformanceColor    = color.FgCyan
 	ErrorColor                   = color.FgRed
 	WarningColor                 = color.FgYellow
 	UpdateColor                  = color.FgHiWhite
+	ApiServerColor               = color.FgWhite
+	MonitorNodeColor             = color.FgHiRed
 )
 
 // Register node command
@@ -109,6 +116,13 @@ func run(c *cli.Context) error {
 		return fmt.Errorf("error getting node account: %w", err)
 	}
 
+	// Let the transaction manager assign nonces, so transactions in flight aren't replaced by accident
+	txm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return err
+	}
+	txm.ManageNonces()
+
 	// Initialize loggers
 	errorLog := log.NewColorLogger(ErrorColor)
 	updateLog := log.NewColorLogger(UpdateColor)
@@ -119,6 +133,7 @@ func run(c *cli.Context) error {
 		return err
 	}
 	stateLocker := collectors.NewStateLocker()
+	validatorPerformanceCollector := collectors.NewValidatorPerformanceCollector()
 
 	// Initialize tasks
 	manageFeeRecipient, err := newManageFeeRecipient(c, log.NewColorLogger(ManageFeeRecipientColor))
@@ -145,6 +160,81 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	checkPendingTransactions, err := newCheckPendingTransactions(c, log.NewColorLogger(PendingTransactionsColor))
+	if err != nil {
+		return err
+	}
+	trackValidatorPerformance, err := newTrackValidatorPerformance(c, log.NewColorLogger(ValidatorPerformanceColor), validatorPerformanceCollector)
+	if err != nil {
+		return err
+	}
+	monitorNode, err := newMonitorNode(c, log.NewColorLogger(MonitorNodeColor))
+	if err != nil {
+		return err
+	}
+
+	// Register the tasks; each one runs on its interval, or sooner if the Beacon node emits one of its events
+	runner := tasks.NewRunner("node", cfg.Smartnode.GetTaskStatusPath("node", true), &errorLog, taskCooldown)
+	runner.Register(tasks.Task{
+		Name:       "manageFeeRecipient",
+		Interval:   tasksInterval,
+		NeedsState: true,
+		Events:     []beacon.EventTopic{beacon.EventTopic_Head, beacon.EventTopic_ChainReorg},
+		Run:        manageFeeRecipient.run,
+	})
+	runner.Register(tasks.Task{
+		Name:       "downloadRewardsTrees",
+		Interval:   tasksInterval,
+		NeedsState: true,
+		Events:     []beacon.EventTopic{beacon.EventTopic_FinalizedCheckpoint},
+		Run:        downloadRewardsTrees.run,
+	})
+	runner.Register(tasks.Task{
+		Name:       "stakePrelaunchMinipools",
+		Interval:   tasksInterval,
+		NeedsState: true,
+		Events:     []beacon.EventTopic{beacon.EventTopic_Head, beacon.EventTopic_ChainReorg},
+		Run:        stakePrelaunchMinipools.run,
+	})
+	runner.Register(tasks.Task{
+		Name:       "distributeMinipools",
+		Interval:   tasksInterval,
+		NeedsState: true,
+		Run:        distributeMinipools.run,
+	})
+	runner.Register(tasks.Task{
+		Name:       "reduceBonds",
+		Interval:   tasksInterval,
+		NeedsState: true,
+		Events:     []beacon.EventTopic{beacon.EventTopic_Head, beacon.EventTopic_ChainReorg},
+		Run:        reduceBonds.run,
+	})
+	runner.Register(tasks.Task{
+		Name:       "promoteMinipools",
+		Interval:   tasksInterval,
+		NeedsState: true,
+		Events:     []beacon.EventTopic{beacon.EventTopic_Head},
+		Run:        promoteMinipools.run,
+	})
+	runner.Register(tasks.Task{
+		Name:       "trackValidatorPerformance",
+		Interval:   tasksInterval,
+		NeedsState: true,
+		Events:     []beacon.EventTopic{beacon.EventTopic_FinalizedCheckpoint},
+		Run:        trackValidatorPerformance.run,
+	})
+	runner.Register(tasks.Task{
+		Name:       "monitorNode",
+		Interval:   tasksInterval,
+		NeedsState: true,
+		Run:        monitorNode.run,
+	})
+	runner.Register(tasks.Task{
+		Name:     "checkPendingTransactions",
+		Interval: time.Minute,
+		Run:      checkPendingTransactions.run,
+	})
+	runner.ListenForEvents(context.Background(), bc)
 
 	// Wait group to handle the various threads
 	wg := new(sync.WaitGroup)
@@ -156,11 +246,15 @@ func run(c *cli.Context) error {
 	// Run task loop
 	go func() {
 		for {
+			// Wait for the next task to be due or for a Beacon event
+			events := runner.Wait()
+
 			// Check the EC status
 			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
 			if err != nil {
 				errorLog.Println(err)
 				time.Sleep(taskCooldown)
+				runner.Requeue(events)
 				continue
 			}
 
@@ -169,72 +263,50 @@ func run(c *cli.Context) error {
 			if err != nil {
 				errorLog.Println(err)
 				time.Sleep(taskCooldown)
+				runner.Requeue(events)
 				continue
 			}
 
-			// Update the network state
-			updateTotalEffectiveStake := false
-			if time.Since(lastTotalEffectiveStakeTime) > totalEffectiveStakeCooldown {
-				updateTotalEffectiveStake = true
-				lastTotalEffectiveStakeTime = time.Now() // Even if the call below errors out, this will prevent contant errors related to this flag
-			}
-			state, totalEffectiveStake, err := updateNetworkState(m, &updateLog, nodeAccount.Address, updateTotalEffectiveStake)
+			// Run the due tasks, updating the network state first if any of them need it
+			err = runner.RunDue(events, func() (*state.NetworkState, error) {
+				updateTotalEffectiveStake := false
+				if time.Since(lastTotalEffectiveStakeTime) > totalEffectiveStakeCooldown {
+					updateTotalEffectiveStake = true
+					lastTotalEffectiveStakeTime = time.Now() // Even if the call below errors out, this will prevent contant errors related to this flag
+				}
+				state, totalEffectiveStake, err := updateNetworkState(m, &updateLog, nodeAccount.Address, updateTotalEffectiveStake)
+				if err != nil {
+					return nil, err
+				}
+				stateLocker.UpdateState(state, totalEffectiveStake)
+				return state, nil
+			})
 			if err != nil {
 				errorLog.Println(err)
 				time.Sleep(taskCooldown)
-				continue
+				runner.Requeue(events)
 			}
-			stateLocker.UpdateState(state, totalEffectiveStake)
-
-			// Manage the fee recipient for the node
-			if err := manageFeeRecipient.run(state); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
-
-			// Run the rewards download check
-			if err := downloadRewardsTrees.run(state); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
-
-			// Run the minipool stake check
-			if err := stakePrelaunchMinipools.run(state); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
-
-			// Run the balance distribution check
-			if err := distributeMinipools.run(state); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
-
-			// Run the reduce bond check
-			if err := reduceBonds.run(state); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
-
-			// Run the minipool promotion check
-			if err := promoteMinipools.run(state); err != nil {
-				errorLog.Println(err)
-			}
-
-			time.Sleep(tasksInterval)
 		}
 		wg.Done()
 	}()
 
 	// Run metrics loop
 	go func() {
-		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), stateLocker)
+		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), stateLocker, validatorPerformanceCollector, runner)
 		if err != nil {
 			errorLog.Println(err)
 		}
 		wg.Done()
 	}()
 
+	// Run the API server; it's optional, so the daemon keeps running if it stops
+	go func() {
+		err := runApiServer(c, log.NewColorLogger(ApiServerColor))
+		if err != nil {
+			errorLog.Println(err)
+		}
+	}()
+
 	// Wait for both threads to stop
 	wg.Wait()
 	return nil
@@ -21,6 +21,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/notifications"
 	"github.com/rocket-pool/smartnode/shared/services/state"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
@@ -35,6 +36,7 @@ type reduceBonds struct {
 	w              *wallet.Wallet
 	rp             *rocketpool.RocketPool
 	d              *client.Client
+	notifier       *notifications.Notifier
 	gasThreshold   float64
 	disabled       bool
 	maxFee         *big.Int
@@ -71,6 +73,10 @@ func newReduceBonds(c *cli.Context, logger log.ColorLogger) (*reduceBonds, error
 	if err != nil {
 		return nil, err
 	}
+	notifier, err := services.GetNotifier(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check if auto-bond-reduction is disabled
 	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
@@ -107,6 +113,7 @@ func newReduceBonds(c *cli.Context, logger log.ColorLogger) (*reduceBonds, error
 		w:              w,
 		rp:             rp,
 		d:              d,
+		notifier:       notifier,
 		gasThreshold:   gasThreshold,
 		disabled:       disabled,
 		maxFee:         maxFee,
@@ -415,6 +422,7 @@ func (t *reduceBonds) reduceBond(mpd *rpstate.NativeMinipoolDetails, windowStart
 
 	// Log
 	t.log.Printlnf("Successfully reduced bond for minipool %s.", mpd.MinipoolAddress.Hex())
+	t.notifier.Notify(&t.log, notifications.EventType_BondReduced, "Minipool bond reduced", fmt.Sprintf("The bond of minipool %s was reduced to %.6f ETH.", mpd.MinipoolAddress.Hex(), eth.WeiToEth(mpd.ReduceBondValue)))
 
 	// Return
 	return true, nil
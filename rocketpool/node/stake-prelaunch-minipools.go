@@ -20,11 +20,12 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
+	"github.com/rocket-pool/smartnode/shared/services/notifications"
 	"github.com/rocket-pool/smartnode/shared/services/state"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
-	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
 
 // Stake prelaunch minipools task
@@ -36,6 +37,7 @@ type stakePrelaunchMinipools struct {
 	rp             *rocketpool.RocketPool
 	bc             beacon.Client
 	d              *client.Client
+	notifier       *notifications.Notifier
 	gasThreshold   float64
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
@@ -66,6 +68,10 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
 	if err != nil {
 		return nil, err
 	}
+	notifier, err := services.GetNotifier(c)
+	if err != nil {
+		return nil, err
+	}
 
 	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
 
@@ -97,6 +103,7 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
 		rp:             rp,
 		bc:             bc,
 		d:              d,
+		notifier:       notifier,
 		gasThreshold:   gasThreshold,
 		maxFee:         maxFee,
 		maxPriorityFee: priorityFee,
@@ -140,7 +147,7 @@ func (t *stakePrelaunchMinipools) run(state *state.NetworkState) error {
 	t.log.Printlnf("%d minipool(s) are ready for staking...", len(minipools))
 
 	// Stake minipools
-	successCount := 0
+	stakedPubkeys := []rptypes.ValidatorPubkey{}
 	for _, mpd := range minipools {
 		success, err := t.stakeMinipool(mpd, state, opts)
 		if err != nil {
@@ -148,13 +155,13 @@ func (t *stakePrelaunchMinipools) run(state *state.NetworkState) error {
 			return err
 		}
 		if success {
-			successCount++
+			stakedPubkeys = append(stakedPubkeys, mpd.Pubkey)
 		}
 	}
 
-	// Restart validator process if any minipools were staked successfully
-	if successCount > 0 {
-		if err := validator.RestartValidator(t.cfg, t.bc, &t.log, t.d); err != nil {
+	// Load the new keys into the validator process if any minipools were staked successfully
+	if len(stakedPubkeys) > 0 {
+		if _, err := keymanager.LoadValidatorKeys(t.cfg, t.bc, &t.log, t.d, t.w, stakedPubkeys); err != nil {
 			return err
 		}
 	}
@@ -214,12 +221,8 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 	// Get minipool withdrawal credentials
 	withdrawalCredentials := mpd.WithdrawalCredentials
 
-	// Get the validator key for the minipool
+	// Get the validator pubkey for the minipool
 	validatorPubkey := mpd.Pubkey
-	validatorKey, err := t.w.GetValidatorKeyByPubkey(validatorPubkey)
-	if err != nil {
-		return false, err
-	}
 
 	// Get the minipool type
 	depositType := mpd.DepositType
@@ -235,7 +238,7 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 	}
 
 	// Get validator deposit data
-	depositData, depositDataRoot, err := validator.GetDepositData(validatorKey, withdrawalCredentials, state.BeaconConfig, depositAmount)
+	depositData, depositDataRoot, err := t.w.GetDepositData(validatorPubkey, withdrawalCredentials, state.BeaconConfig, depositAmount)
 	if err != nil {
 		return false, err
 	}
@@ -306,6 +309,7 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 
 	// Log
 	t.log.Printlnf("Successfully staked minipool %s.", mp.GetAddress().Hex())
+	t.notifier.Notify(&t.log, notifications.EventType_MinipoolStaked, "Minipool staked", fmt.Sprintf("Minipool %s was staked. Its validator (%s) will start attesting once it's activated on the Beacon Chain.", mp.GetAddress().Hex(), mpd.Pubkey.Hex()))
 
 	// Return
 	return true, nil
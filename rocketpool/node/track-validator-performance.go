@@ -0,0 +1,442 @@
+package node
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/rocketpool/node/collectors"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/notifications"
+	"github.com/rocket-pool/smartnode/shared/services/performance"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+const (
+	// The number of epochs of validator performance to keep (about a week)
+	performanceRetentionEpochs uint64 = 1575
+
+	// The number of epochs the validator performance metrics cover (about a day)
+	performanceMetricsEpochs uint64 = 225
+
+	// The most epochs to catch up on in one run, after the daemon has been offline
+	maxPerformanceCatchupEpochs uint64 = 32
+
+	// The number of attestations in a row a validator has to miss to be considered offline
+	validatorOfflineAttestations uint64 = 2
+
+	performanceLogPrefix string = "[Performance]"
+)
+
+// Track validator performance task
+type trackValidatorPerformance struct {
+	c            *cli.Context
+	log          log.ColorLogger
+	cfg          *config.RocketPoolConfig
+	w            *wallet.Wallet
+	bc           beacon.Client
+	beaconConfig beacon.Eth2Config
+	collector    *collectors.ValidatorPerformanceCollector
+	notifier     *notifications.Notifier
+	path         string
+
+	// The rolling record of the node's attestation duties
+	record *rprewards.RollingRecord
+
+	// The performance of each of the node's validators so far
+	performance *api.MinipoolPerformanceRecord
+
+	// Upcoming proposals, by slot, so they can be checked once the epoch is over
+	proposerDuties     map[uint64]string
+	proposerDutyEpochs map[uint64]bool
+
+	// The number of attestations in a row each validator has missed, by index
+	missedAttestations map[string]uint64
+}
+
+// Create track validator performance task
+func newTrackValidatorPerformance(c *cli.Context, logger log.ColorLogger, collector *collectors.ValidatorPerformanceCollector) (*trackValidatorPerformance, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	notifier, err := services.GetNotifier(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the Beacon config
+	beaconConfig, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load the performance that was tracked before the daemon restarted
+	path := cfg.Smartnode.GetValidatorPerformancePath(true)
+	record, exists, err := performance.LoadRecord(path)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		record = nil
+	}
+
+	// Return task
+	return &trackValidatorPerformance{
+		c:                  c,
+		log:                logger,
+		cfg:                cfg,
+		w:                  w,
+		bc:                 bc,
+		beaconConfig:       beaconConfig,
+		collector:          collector,
+		notifier:           notifier,
+		path:               path,
+		performance:        record,
+		proposerDuties:     map[uint64]string{},
+		proposerDutyEpochs: map[uint64]bool{},
+		missedAttestations: map[string]uint64{},
+	}, nil
+
+}
+
+// Track the performance of the node's validators
+func (t *trackValidatorPerformance) run(state *state.NetworkState) error {
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Get the node's validators
+	validators := map[string]common.Address{}
+	indices := []string{}
+	for _, mpd := range state.MinipoolDetailsByNode[nodeAccount.Address] {
+		validator := state.ValidatorDetails[mpd.Pubkey]
+		if validator.Exists {
+			validators[validator.Index] = mpd.MinipoolAddress
+			indices = append(indices, validator.Index)
+		}
+	}
+	if len(indices) == 0 {
+		return nil
+	}
+
+	// Attestations can be included up to an epoch late, so only process epochs that are at least an epoch old
+	slotsPerEpoch := t.beaconConfig.SlotsPerEpoch
+	headEpoch := state.BeaconSlotNumber / slotsPerEpoch
+	if headEpoch < 2 {
+		return nil
+	}
+	targetEpoch := headEpoch - 2
+
+	// Remember the upcoming proposals while they can still be queried
+	t.cacheProposerDuties(indices, headEpoch)
+	t.cacheProposerDuties(indices, headEpoch+1)
+
+	// Get the first epoch to process
+	startEpoch := targetEpoch
+	if t.performance != nil {
+		if t.performance.LatestEpoch >= targetEpoch {
+			t.updateMetrics()
+			return nil
+		}
+		startEpoch = t.performance.LatestEpoch + 1
+	}
+	if targetEpoch-startEpoch >= maxPerformanceCatchupEpochs {
+		t.log.Printlnf("%s Validator performance is %d epochs behind, skipping to epoch %d.", performanceLogPrefix, targetEpoch-startEpoch+1, targetEpoch-maxPerformanceCatchupEpochs+1)
+		startEpoch = targetEpoch - maxPerformanceCatchupEpochs + 1
+		t.record = nil
+	}
+	if t.performance == nil {
+		t.performance = &api.MinipoolPerformanceRecord{
+			StartEpoch: startEpoch,
+			Minipools:  map[string]*api.MinipoolPerformance{},
+		}
+	}
+	if t.record == nil {
+		t.record = rprewards.NewNodeRollingRecord(&t.log, performanceLogPrefix, t.bc, startEpoch*slotsPerEpoch, &t.beaconConfig)
+	}
+
+	// Process each epoch, saving as it goes so a long catch-up doesn't get lost
+	for epoch := startEpoch; epoch <= targetEpoch; epoch++ {
+		err = t.processEpoch(epoch, state, validators, indices)
+		if err != nil {
+			// Start a fresh record on the next run so a half-processed epoch isn't counted twice
+			t.record = nil
+			return fmt.Errorf("error processing validator performance for epoch %d: %w", epoch, err)
+		}
+		t.performance.LatestEpoch = epoch
+		t.prune()
+		err = performance.SaveRecord(t.path, t.performance)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Update the metrics
+	t.updateMetrics()
+	return nil
+
+}
+
+// Record the performance of the node's validators during an epoch
+func (t *trackValidatorPerformance) processEpoch(epoch uint64, state *state.NetworkState, validators map[string]common.Address, indices []string) error {
+
+	slotsPerEpoch := t.beaconConfig.SlotsPerEpoch
+	firstSlot := epoch * slotsPerEpoch
+	lastSlot := firstSlot + slotsPerEpoch - 1
+
+	// Get the attestation results
+	err := t.record.UpdateToSlot(lastSlot, state)
+	if err != nil {
+		return fmt.Errorf("error updating attestation record: %w", err)
+	}
+	attestations := t.record.TakeAttestationResults(lastSlot)
+
+	// Get the proposal duties
+	proposerDuties, err := t.getProposerDuties(indices, epoch)
+	if err != nil {
+		t.log.Printlnf("%s WARNING: couldn't get the proposer duties for epoch %d, so its proposals won't be tracked: %s", performanceLogPrefix, epoch, err.Error())
+		proposerDuties = map[uint64]string{}
+	}
+
+	// Get the sync committee positions
+	syncPositions, err := t.bc.GetValidatorSyncCommitteePositions(indices, epoch)
+	if err != nil {
+		t.log.Printlnf("%s WARNING: couldn't get the sync committee duties for epoch %d, so its sync committee participation won't be tracked: %s", performanceLogPrefix, epoch, err.Error())
+		syncPositions = map[string][]uint64{}
+	}
+	hasSyncDuties := false
+	for _, positions := range syncPositions {
+		if len(positions) > 0 {
+			hasSyncDuties = true
+			break
+		}
+	}
+
+	// Build the epoch's results
+	results := map[string]*api.ValidatorEpochPerformance{}
+	for _, index := range indices {
+		results[index] = &api.ValidatorEpochPerformance{
+			Epoch: epoch,
+		}
+	}
+	for index, duties := range attestations {
+		result, exists := results[index]
+		if !exists {
+			continue
+		}
+		for slot, included := range duties {
+			result.HasAttestationDuty = true
+			result.AttestationSlot = slot
+			result.AttestationIncluded = included
+		}
+	}
+
+	// Check the blocks for proposals and sync committee participation; sync duties are only needed during a sync committee
+	for slot := firstSlot; slot <= lastSlot; slot++ {
+		proposer, isProposer := proposerDuties[slot]
+		if !isProposer && !hasSyncDuties {
+			continue
+		}
+
+		block, exists, err := t.bc.GetBeaconBlock(fmt.Sprint(slot))
+		if err != nil {
+			return fmt.Errorf("error getting block %d: %w", slot, err)
+		}
+
+		if isProposer {
+			result := results[proposer]
+			if exists && block.ProposerIndex == proposer {
+				result.ProposalSlots = append(result.ProposalSlots, slot)
+			} else {
+				result.MissedProposalSlots = append(result.MissedProposalSlots, slot)
+			}
+		}
+
+		if exists && block.HasSyncAggregate {
+			for index, positions := range syncPositions {
+				result, exists := results[index]
+				if !exists {
+					continue
+				}
+				for _, position := range positions {
+					result.SyncDuties++
+					if block.SyncCommitteeBits.BitAt(position) {
+						result.SyncParticipation++
+					}
+				}
+			}
+		}
+	}
+
+	// Add the results that had any duties
+	for index, result := range results {
+		if !result.HasAttestationDuty && len(result.ProposalSlots) == 0 && len(result.MissedProposalSlots) == 0 && result.SyncDuties == 0 {
+			continue
+		}
+		minipool, exists := t.performance.Minipools[index]
+		if !exists {
+			address := validators[index]
+			minipool = &api.MinipoolPerformance{
+				Address:        address,
+				Pubkey:         state.MinipoolDetailsByAddress[address].Pubkey,
+				ValidatorIndex: index,
+				Epochs:         []api.ValidatorEpochPerformance{},
+			}
+			t.performance.Minipools[index] = minipool
+		}
+		minipool.Epochs = append(minipool.Epochs, *result)
+		if result.HasAttestationDuty && !result.AttestationIncluded {
+			t.log.Printlnf("%s Validator %s (minipool %s) missed its attestation for slot %d.", performanceLogPrefix, index, minipool.Address.Hex(), result.AttestationSlot)
+		}
+		if result.HasAttestationDuty {
+			t.checkValidatorOnline(index, minipool.Address, result)
+		}
+		for _, slot := range result.MissedProposalSlots {
+			t.log.Printlnf("%s Validator %s (minipool %s) missed its block proposal for slot %d.", performanceLogPrefix, index, minipool.Address.Hex(), slot)
+		}
+	}
+
+	return nil
+
+}
+
+// Send a notification when a validator starts or stops missing its attestations
+func (t *trackValidatorPerformance) checkValidatorOnline(index string, address common.Address, result *api.ValidatorEpochPerformance) {
+	missed := t.missedAttestations[index]
+	if result.AttestationIncluded {
+		if missed >= validatorOfflineAttestations {
+			t.notifier.Notify(&t.log, notifications.EventType_ValidatorOffline, "Validator back online", fmt.Sprintf("Validator %s (minipool %s) is attesting again, as of epoch %d.", index, address.Hex(), result.Epoch))
+		}
+		delete(t.missedAttestations, index)
+		return
+	}
+
+	missed++
+	t.missedAttestations[index] = missed
+	if missed == validatorOfflineAttestations {
+		t.notifier.Notify(&t.log, notifications.EventType_ValidatorOffline, "Validator offline", fmt.Sprintf("Validator %s (minipool %s) has missed its last %d attestations, most recently in epoch %d. Please check your Validator Client and Beacon Node.", index, address.Hex(), missed, result.Epoch))
+	}
+}
+
+// Remember the proposer duties for the node's validators in an epoch
+func (t *trackValidatorPerformance) cacheProposerDuties(indices []string, epoch uint64) {
+	if t.proposerDutyEpochs[epoch] {
+		return
+	}
+	slots, err := t.bc.GetValidatorProposerSlots(indices, epoch)
+	if err != nil {
+		t.log.Printlnf("%s WARNING: couldn't get the proposer duties for epoch %d: %s", performanceLogPrefix, epoch, err.Error())
+		return
+	}
+	for index, validatorSlots := range slots {
+		for _, slot := range validatorSlots {
+			t.proposerDuties[slot] = index
+		}
+	}
+	t.proposerDutyEpochs[epoch] = true
+}
+
+// Get the proposer duties for the node's validators in an epoch, by slot
+func (t *trackValidatorPerformance) getProposerDuties(indices []string, epoch uint64) (map[uint64]string, error) {
+
+	// Query the duties directly if they weren't cached while the epoch was current, which only some clients support
+	if !t.proposerDutyEpochs[epoch] {
+		slots, err := t.bc.GetValidatorProposerSlots(indices, epoch)
+		if err != nil {
+			return nil, err
+		}
+		for index, validatorSlots := range slots {
+			for _, slot := range validatorSlots {
+				t.proposerDuties[slot] = index
+			}
+		}
+	}
+
+	// Take the duties for this epoch out of the cache
+	slotsPerEpoch := t.beaconConfig.SlotsPerEpoch
+	duties := map[uint64]string{}
+	for slot, index := range t.proposerDuties {
+		if slot/slotsPerEpoch <= epoch {
+			if slot/slotsPerEpoch == epoch {
+				duties[slot] = index
+			}
+			delete(t.proposerDuties, slot)
+		}
+	}
+	for cachedEpoch := range t.proposerDutyEpochs {
+		if cachedEpoch <= epoch {
+			delete(t.proposerDutyEpochs, cachedEpoch)
+		}
+	}
+	return duties, nil
+
+}
+
+// Remove the epochs that are older than the retention window
+func (t *trackValidatorPerformance) prune() {
+	if t.performance.LatestEpoch < performanceRetentionEpochs {
+		return
+	}
+	oldestEpoch := t.performance.LatestEpoch - performanceRetentionEpochs + 1
+	if t.performance.StartEpoch < oldestEpoch {
+		t.performance.StartEpoch = oldestEpoch
+	}
+	for index, minipool := range t.performance.Minipools {
+		firstKept := sort.Search(len(minipool.Epochs), func(i int) bool {
+			return minipool.Epochs[i].Epoch >= oldestEpoch
+		})
+		minipool.Epochs = minipool.Epochs[firstKept:]
+		if len(minipool.Epochs) == 0 {
+			delete(t.performance.Minipools, index)
+		}
+	}
+}
+
+// Update the Prometheus metrics with the latest performance
+func (t *trackValidatorPerformance) updateMetrics() {
+	startEpoch := t.performance.StartEpoch
+	if t.performance.LatestEpoch >= performanceMetricsEpochs && t.performance.LatestEpoch-performanceMetricsEpochs+1 > startEpoch {
+		startEpoch = t.performance.LatestEpoch - performanceMetricsEpochs + 1
+	}
+
+	metrics := map[string]collectors.ValidatorPerformance{}
+	for index, minipool := range t.performance.Minipools {
+		summary := performance.Summarize(minipool.Epochs, startEpoch)
+		metrics[index] = collectors.ValidatorPerformance{
+			MinipoolAddress:       minipool.Address.Hex(),
+			AttestationHitRate:    summary.AttestationHitRate(),
+			MissedAttestations:    float64(len(summary.MissedAttestationSlots)),
+			Proposals:             float64(summary.Proposals),
+			MissedProposals:       float64(summary.MissedProposals),
+			SyncDuties:            float64(summary.SyncDuties),
+			SyncParticipationRate: summary.SyncParticipationRate(),
+		}
+	}
+
+	t.collector.UpdateLock.Lock()
+	defer t.collector.UpdateLock.Unlock()
+	t.collector.Performance = metrics
+	t.collector.Epochs = float64(t.performance.LatestEpoch - startEpoch + 1)
+}
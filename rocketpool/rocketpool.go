@@ -84,6 +84,14 @@ func main() {
 			Name:  "force-fallbacks",
 			Usage: "Set this to true if you know the primary EC or CC is offline and want to bypass its health checks, and just use the fallback EC and CC instead",
 		},
+		cli.BoolFlag{
+			Name:  "simulate",
+			Usage: "Set this to true to simulate every transaction the command estimates against the pending block, and include the expected reverts, balance changes and events in the response",
+		},
+		cli.BoolFlag{
+			Name:  "unsigned-tx",
+			Usage: "Set this to true to build the command's transactions without signing or sending them, and include them in the response so they can be signed on an offline machine",
+		},
 		cli.BoolFlag{
 			Name:  "use-protected-api",
 			Usage: "Set this to true to use the Flashbots Protect RPC instead of your local Execution Client. Useful to ensure your transactions aren't front-run.",
@@ -0,0 +1,90 @@
+package collectors
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The latest comparison between the watchtower's submission for a report and the other Oracle DAO members'
+type ConsensusStatus struct {
+	Block       float64
+	Agreeing    float64
+	Disagreeing float64
+	HeldBack    float64
+}
+
+// Represents the collector for the Oracle DAO consensus check metrics
+type ConsensusCollector struct {
+
+	// The block (or rewards interval) of the latest report that was checked
+	blockDesc *prometheus.Desc
+
+	// The number of other members whose submission matched ours
+	agreeingDesc *prometheus.Desc
+
+	// The number of other members whose submission didn't match ours
+	disagreeingDesc *prometheus.Desc
+
+	// Whether our vote was held back because it disagreed with the majority
+	heldBackDesc *prometheus.Desc
+
+	// The latest status of each report
+	Statuses map[string]ConsensusStatus
+
+	// Mutex
+	UpdateLock *sync.Mutex
+}
+
+// Create a new ConsensusCollector instance
+func NewConsensusCollector() *ConsensusCollector {
+	subsystem := "consensus"
+	return &ConsensusCollector{
+		blockDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "block"),
+			"The block (or rewards interval) of the latest report that was compared with the other members",
+			[]string{"report"}, nil,
+		),
+		agreeingDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "agreeing"),
+			"The number of other members whose submission matched ours",
+			[]string{"report"}, nil,
+		),
+		disagreeingDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "disagreeing"),
+			"The number of other members whose submission did not match ours",
+			[]string{"report"}, nil,
+		),
+		heldBackDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "held_back"),
+			"1 if our vote was held back because it disagreed with the majority, 0 otherwise",
+			[]string{"report"}, nil,
+		),
+		Statuses:   map[string]ConsensusStatus{},
+		UpdateLock: &sync.Mutex{},
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *ConsensusCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.blockDesc
+	channel <- collector.agreeingDesc
+	channel <- collector.disagreeingDesc
+	channel <- collector.heldBackDesc
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *ConsensusCollector) Collect(channel chan<- prometheus.Metric) {
+
+	// Sync
+	collector.UpdateLock.Lock()
+	defer collector.UpdateLock.Unlock()
+
+	// Update all of the metrics
+	for report, status := range collector.Statuses {
+		channel <- prometheus.MustNewConstMetric(
+			collector.blockDesc, prometheus.GaugeValue, status.Block, report)
+		channel <- prometheus.MustNewConstMetric(
+			collector.agreeingDesc, prometheus.GaugeValue, status.Agreeing, report)
+		channel <- prometheus.MustNewConstMetric(
+			collector.disagreeingDesc, prometheus.GaugeValue, status.Disagreeing, report)
+		channel <- prometheus.MustNewConstMetric(
+			collector.heldBackDesc, prometheus.GaugeValue, status.HeldBack, report)
+	}
+}
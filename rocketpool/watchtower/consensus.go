@@ -0,0 +1,338 @@
+package watchtower
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/rocketpool-go/rewards"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/collectors"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Settings
+const (
+	// The fewest other members that have to agree with each other before their submission is treated as a majority
+	consensusMinMajority int = 2
+
+	// Report names, used in logs and metrics
+	consensusReportBalances string = "balances"
+	consensusReportPrices   string = "prices"
+	consensusReportRewards  string = "rewards"
+)
+
+// A value in an Oracle DAO submission that the members need to agree on
+type consensusValue struct {
+	name  string
+	value *big.Int
+	exact bool // Exact values ignore the tolerance, e.g. Merkle roots and block numbers
+}
+
+// Compares the watchtower's submissions with the ones the other Oracle DAO members have already made for the same report
+type consensusChecker struct {
+	report    string
+	log       *log.ColorLogger
+	errLog    *log.ColorLogger
+	cfg       *config.RocketPoolConfig
+	rp        *rocketpool.RocketPool
+	collector *collectors.ConsensusCollector
+}
+
+// Create a new consensus checker for a report
+func newConsensusChecker(report string, logger *log.ColorLogger, errorLogger *log.ColorLogger, cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool, collector *collectors.ConsensusCollector) *consensusChecker {
+	return &consensusChecker{
+		report:    report,
+		log:       logger,
+		errLog:    errorLogger,
+		cfg:       cfg,
+		rp:        rp,
+		collector: collector,
+	}
+}
+
+// Check if the consensus check is enabled
+func (c *consensusChecker) isEnabled() bool {
+	return c.cfg.Smartnode.WatchtowerConsensusCheck.Value == true
+}
+
+// Compare our submission with the other members' and log any disagreements.
+// Returns false if our vote should be held back, which only happens in strict mode when a majority of the others disagree with us.
+func (c *consensusChecker) check(logPrefix string, target string, targetNumber uint64, nodeAddress common.Address, ours []consensusValue, others map[common.Address][]consensusValue) bool {
+
+	tolerance := c.cfg.Smartnode.WatchtowerConsensusTolerance.Value.(float64)
+	strict := c.cfg.Smartnode.WatchtowerConsensusStrict.Value == true
+
+	// Compare each member's latest submission with ours, and group the ones that disagree by what they submitted
+	agreeing := 0
+	disagreeing := 0
+	groups := map[string]int{}
+	for member, values := range others {
+		if member == nodeAddress {
+			continue
+		}
+		differences := compareConsensusValues(ours, values, tolerance)
+		if len(differences) == 0 {
+			agreeing++
+			continue
+		}
+		disagreeing++
+		groups[formatConsensusValues(values)]++
+		c.errLog.Printlnf("%s WARNING: member %s disagrees with our %s for %s: %s", logPrefix, member.Hex(), c.report, target, strings.Join(differences, ", "))
+	}
+
+	// Find the largest group that disagrees with us
+	largestGroup := 0
+	for _, count := range groups {
+		if count > largestGroup {
+			largestGroup = count
+		}
+	}
+	majorityDisagrees := largestGroup >= consensusMinMajority && largestGroup*2 > agreeing+disagreeing
+	heldBack := strict && majorityDisagrees
+
+	if agreeing+disagreeing == 0 {
+		c.log.Printlnf("%s No other members have submitted %s for %s yet.", logPrefix, c.report, target)
+	} else {
+		c.log.Printlnf("%s %d other member(s) agree and %d disagree with our %s for %s.", logPrefix, agreeing, disagreeing, c.report, target)
+	}
+	if majorityDisagrees {
+		c.errLog.Printlnf("%s WARNING: %d of the %d members that have already submitted %s for %s agree with each other but not with us. Please check your clients and local state.", logPrefix, largestGroup, agreeing+disagreeing, c.report, target)
+	}
+	if heldBack {
+		c.errLog.Printlnf("%s *** Strict consensus mode is enabled, so our vote is being held back. ***", logPrefix)
+	}
+
+	// Update the metrics
+	if c.collector != nil {
+		status := collectors.ConsensusStatus{
+			Block:       float64(targetNumber),
+			Agreeing:    float64(agreeing),
+			Disagreeing: float64(disagreeing),
+		}
+		if heldBack {
+			status.HeldBack = 1
+		}
+		c.collector.UpdateLock.Lock()
+		c.collector.Statuses[c.report] = status
+		c.collector.UpdateLock.Unlock()
+	}
+
+	return !heldBack
+
+}
+
+// Get the latest submission each member made for a block, from the contract's submission events
+func (c *consensusChecker) getSubmissions(contractName string, eventName string, fromBlock uint64, indexedFilter []common.Hash, parse func(values map[string]interface{}) (bool, []consensusValue, error)) (map[common.Address][]consensusValue, error) {
+
+	contract, err := c.rp.GetContract(contractName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s contract: %w", contractName, err)
+	}
+	event, exists := contract.ABI.Events[eventName]
+	if !exists {
+		return nil, fmt.Errorf("%s does not have a %s event", contractName, eventName)
+	}
+
+	// Get the event logs
+	intervalSize, err := c.cfg.GetEventLogInterval()
+	if err != nil {
+		return nil, fmt.Errorf("error getting event log interval: %w", err)
+	}
+	topicFilter := [][]common.Hash{{event.ID}, nil}
+	if indexedFilter != nil {
+		topicFilter = append(topicFilter, indexedFilter)
+	}
+	logs, err := eth.GetLogs(c.rp, []common.Address{*contract.Address}, topicFilter, big.NewInt(int64(intervalSize)), big.NewInt(0).SetUint64(fromBlock), nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s events: %w", eventName, err)
+	}
+
+	// Keep the latest submission for each member, since they can resubmit with different values
+	submissions := map[common.Address][]consensusValue{}
+	for _, eventLog := range logs {
+		member, values, err := unpackSubmissionLog(event.Inputs.UnpackIntoMap, eventLog)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding %s event in transaction %s: %w", eventName, eventLog.TxHash.Hex(), err)
+		}
+		matches, parsed, err := parse(values)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding %s event in transaction %s: %w", eventName, eventLog.TxHash.Hex(), err)
+		}
+		if matches {
+			submissions[member] = parsed
+		}
+	}
+	return submissions, nil
+
+}
+
+// Get the other members' network balance submissions for a block
+func (c *consensusChecker) getBalancesSubmissions(block uint64) (map[common.Address][]consensusValue, error) {
+	return c.getSubmissions("rocketNetworkBalances", "BalancesSubmitted", block, nil, func(values map[string]interface{}) (bool, []consensusValue, error) {
+		eventBlock, err := getBigValue(values, "block")
+		if err != nil || eventBlock.Uint64() != block {
+			return false, nil, err
+		}
+		totalEth, err := getBigValue(values, "totalEth")
+		if err != nil {
+			return false, nil, err
+		}
+		stakingEth, err := getBigValue(values, "stakingEth")
+		if err != nil {
+			return false, nil, err
+		}
+		rethSupply, err := getBigValue(values, "rethSupply")
+		if err != nil {
+			return false, nil, err
+		}
+		return true, getBalancesConsensusValues(totalEth, stakingEth, rethSupply), nil
+	})
+}
+
+// Get the other members' RPL price submissions for a block
+func (c *consensusChecker) getPricesSubmissions(block uint64) (map[common.Address][]consensusValue, error) {
+	return c.getSubmissions("rocketNetworkPrices", "PricesSubmitted", block, nil, func(values map[string]interface{}) (bool, []consensusValue, error) {
+		eventBlock, err := getBigValue(values, "block")
+		if err != nil || eventBlock.Uint64() != block {
+			return false, nil, err
+		}
+		rplPrice, err := getBigValue(values, "rplPrice")
+		if err != nil {
+			return false, nil, err
+		}
+		return true, getPricesConsensusValues(rplPrice), nil
+	})
+}
+
+// Get the other members' rewards snapshot submissions for an interval
+func (c *consensusChecker) getRewardsSubmissions(index *big.Int, executionBlock uint64) (map[common.Address][]consensusValue, error) {
+	indexBytes := common.Hash{}
+	index.FillBytes(indexBytes[:])
+	return c.getSubmissions("rocketRewardsPool", "RewardSnapshotSubmitted", executionBlock, []common.Hash{indexBytes}, func(values map[string]interface{}) (bool, []consensusValue, error) {
+		submissionValue := reflect.ValueOf(values["submission"])
+		submissionType := reflect.TypeOf(rewards.RewardSubmission{})
+		if !submissionValue.IsValid() || !submissionValue.CanConvert(submissionType) {
+			return false, nil, fmt.Errorf("unexpected submission format")
+		}
+		submission := submissionValue.Convert(submissionType).Interface().(rewards.RewardSubmission)
+		return true, getRewardsConsensusValues(submission), nil
+	})
+}
+
+// Compare our rewards snapshot with the other members' submissions for the same interval; returns false if our vote should be held back
+func (c *consensusChecker) checkRewards(logPrefix string, nodeAddress common.Address, submission rewards.RewardSubmission) bool {
+
+	if !c.isEnabled() {
+		return true
+	}
+	others, err := c.getRewardsSubmissions(submission.RewardIndex, submission.ExecutionBlock.Uint64())
+	if err != nil {
+		c.log.Printlnf("%s WARNING: couldn't compare the rewards snapshot with the other members: %s", logPrefix, err.Error())
+		return true
+	}
+	ours := getRewardsConsensusValues(submission)
+	index := submission.RewardIndex.Uint64()
+	return c.check(logPrefix, fmt.Sprintf("interval %d", index), index, nodeAddress, ours, others)
+
+}
+
+// Get the values that matter for network balance consensus
+func getBalancesConsensusValues(totalEth *big.Int, stakingEth *big.Int, rethSupply *big.Int) []consensusValue {
+	return []consensusValue{
+		{name: "total ETH", value: totalEth},
+		{name: "staking ETH", value: stakingEth},
+		{name: "rETH supply", value: rethSupply},
+	}
+}
+
+// Get the values that matter for RPL price consensus
+func getPricesConsensusValues(rplPrice *big.Int) []consensusValue {
+	return []consensusValue{
+		{name: "RPL price", value: rplPrice},
+	}
+}
+
+// Get the values that matter for rewards snapshot consensus
+func getRewardsConsensusValues(submission rewards.RewardSubmission) []consensusValue {
+	return []consensusValue{
+		{name: "Merkle root", value: common.Hash(submission.MerkleRoot).Big(), exact: true},
+		{name: "execution block", value: submission.ExecutionBlock, exact: true},
+		{name: "consensus block", value: submission.ConsensusBlock, exact: true},
+		{name: "intervals passed", value: submission.IntervalsPassed, exact: true},
+		{name: "treasury RPL", value: submission.TreasuryRPL},
+		{name: "user ETH", value: submission.UserETH},
+	}
+}
+
+// Compare two submissions, returning a description of each value that's further apart than the tolerance (in percent)
+func compareConsensusValues(ours []consensusValue, theirs []consensusValue, tolerance float64) []string {
+	differences := []string{}
+	for i, ourValue := range ours {
+		if i >= len(theirs) {
+			break
+		}
+		theirValue := theirs[i]
+		if ourValue.value == nil || theirValue.value == nil {
+			continue
+		}
+		if ourValue.value.Cmp(theirValue.value) == 0 {
+			continue
+		}
+		if !ourValue.exact && getPercentDifference(ourValue.value, theirValue.value) <= tolerance {
+			continue
+		}
+		differences = append(differences, fmt.Sprintf("%s is %s (ours is %s)", ourValue.name, theirValue.value.String(), ourValue.value.String()))
+	}
+	return differences
+}
+
+// Get how far a value is from a reference value, in percent
+func getPercentDifference(reference *big.Int, value *big.Int) float64 {
+	difference := new(big.Int).Sub(value, reference)
+	difference.Abs(difference)
+	if reference.Sign() == 0 {
+		if difference.Sign() == 0 {
+			return 0
+		}
+		return 100
+	}
+	ratio, _ := new(big.Float).Quo(new(big.Float).SetInt(difference), new(big.Float).SetInt(new(big.Int).Abs(reference))).Float64()
+	return ratio * 100
+}
+
+// Get a key for a submission so identical ones can be grouped together
+func formatConsensusValues(values []consensusValue) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = value.value.String()
+	}
+	return strings.Join(parts, "/")
+}
+
+// Get the submitting member and the values of a submission event
+func unpackSubmissionLog(unpack func(map[string]interface{}, []byte) error, eventLog types.Log) (common.Address, map[string]interface{}, error) {
+	if len(eventLog.Topics) < 2 {
+		return common.Address{}, nil, fmt.Errorf("missing the submitting member")
+	}
+	values := map[string]interface{}{}
+	if err := unpack(values, eventLog.Data); err != nil {
+		return common.Address{}, nil, err
+	}
+	return common.BytesToAddress(eventLog.Topics[1].Bytes()), values, nil
+}
+
+// Get a uint256 value from an unpacked event
+func getBigValue(values map[string]interface{}, name string) (*big.Int, error) {
+	value, ok := values[name].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("event is missing %s", name)
+	}
+	return value, nil
+}
@@ -241,6 +241,7 @@ func (t *generateRewardsTree) generateRewardsTreeImpl(rp *rocketpool.RocketPool,
 	root := common.BytesToHash(header.MerkleTree.Root())
 	if root != rewardsEvent.MerkleRoot {
 		t.log.Printlnf("%s WARNING: your Merkle tree had a root of %s, but the canonical Merkle tree's root was %s. This file will not be usable for claiming rewards.", generationPrefix, root.Hex(), rewardsEvent.MerkleRoot.Hex())
+		t.logCanonicalDifferences(index, generationPrefix, rewardsEvent, rewardsFile)
 	} else {
 		t.log.Printlnf("%s Your Merkle tree's root of %s matches the canonical root! You will be able to use this file for claiming rewards.", generationPrefix, header.MerkleRoot)
 	}
@@ -280,6 +281,35 @@ func (t *generateRewardsTree) generateRewardsTreeImpl(rp *rocketpool.RocketPool,
 
 }
 
+// Log how a generated tree differs from the canonical one, to help find where they diverged
+func (t *generateRewardsTree) logCanonicalDifferences(index uint64, generationPrefix string, rewardsEvent rewards.RewardsEvent, rewardsFile rprewards.IRewardsFile) {
+	canonicalFile, _, err := rprewards.FetchRewardsFile(t.cfg, index, rewardsEvent.MerkleTreeCID, rewardsEvent.MerkleRoot)
+	if err != nil {
+		t.log.Printlnf("%s Couldn't download the canonical tree to compare against: %s", generationPrefix, err.Error())
+		return
+	}
+	var canonicalPerformanceFile, generatedPerformanceFile rprewards.IMinipoolPerformanceFile
+	performanceCid := canonicalFile.GetHeader().MinipoolPerformanceFileCID
+	if performanceCid != "" && performanceCid != "---" {
+		canonicalPerformanceFile, err = rprewards.FetchMinipoolPerformanceFile(t.cfg, index, performanceCid)
+		if err != nil {
+			t.log.Printlnf("%s Couldn't download the canonical minipool performance file, only node rewards will be compared: %s", generationPrefix, err.Error())
+		} else {
+			generatedPerformanceFile = rewardsFile.GetMinipoolPerformanceFile()
+		}
+	}
+
+	comparison := rprewards.CompareRewardsFiles(canonicalFile, canonicalPerformanceFile, rewardsFile, generatedPerformanceFile)
+	t.log.Printlnf("%s Compared to the canonical tree, %d node(s) have different rewards and %d minipool(s) have different performance.", generationPrefix, len(comparison.NodeDifferences), len(comparison.MinipoolDifferences))
+	for _, diff := range comparison.NodeDifferences {
+		t.log.Printlnf("%s Node %s: collateral RPL %s vs. %s, oDAO RPL %s vs. %s, smoothing pool ETH %s vs. %s (canonical vs. generated)", generationPrefix, diff.Address.Hex(),
+			diff.CanonicalCollateralRpl, diff.GeneratedCollateralRpl, diff.CanonicalOracleDaoRpl, diff.GeneratedOracleDaoRpl, diff.CanonicalSmoothingPoolEth, diff.GeneratedSmoothingPoolEth)
+	}
+	for _, diff := range comparison.MinipoolDifferences {
+		t.log.Printlnf("%s Minipool %s: missed slots only in canonical %v, only in generated %v", generationPrefix, diff.Address.Hex(), diff.MissedOnlyInCanonicalSlots, diff.MissedOnlyInGeneratedSlots)
+	}
+}
+
 func (t *generateRewardsTree) handleError(err error) {
 	t.errLog.Println(err)
 	t.errLog.Println("*** Rewards tree generation failed. ***")
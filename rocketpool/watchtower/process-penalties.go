@@ -2,6 +2,7 @@ package watchtower
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"math/big"
 	"os"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/rocket-pool/rocketpool-go/dao/trustednode"
 	"github.com/rocket-pool/rocketpool-go/minipool"
@@ -22,12 +24,14 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/penalties"
 	"github.com/rocket-pool/smartnode/shared/services/state"
 	"github.com/urfave/cli"
 	"gopkg.in/yaml.v2"
 
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	apitypes "github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
@@ -43,7 +47,7 @@ type processPenalties struct {
 	cfg            *config.RocketPoolConfig
 	w              *wallet.Wallet
 	rp             *rocketpool.RocketPool
-	ec             rocketpool.ExecutionClient
+	ec             *services.ExecutionClientManager
 	bc             beacon.Client
 	lock           *sync.Mutex
 	isRunning      bool
@@ -53,6 +57,8 @@ type processPenalties struct {
 	beaconConfig   beacon.Eth2Config
 	m              *state.NetworkStateManager
 	s              *state.NetworkState
+	chainID        *big.Int
+	violations     []apitypes.PenaltyViolation
 }
 
 type penaltyState struct {
@@ -126,6 +132,7 @@ func newProcessPenalties(c *cli.Context, logger log.ColorLogger, errorLogger log
 		gasLimit:       0,
 		beaconConfig:   beaconConfig,
 		m:              m,
+		chainID:        new(big.Int).SetUint64(uint64(cfg.Smartnode.GetChainID())),
 	}, nil
 }
 
@@ -229,6 +236,13 @@ func (t *processPenalties) run() error {
 		}
 		smoothingPoolAddress := *smoothingPoolContract.Address
 
+		// Load the violations that have already been recorded
+		t.violations, err = penalties.LoadViolations(t.cfg.Smartnode.GetWatchtowerPenaltiesPath())
+		if err != nil {
+			t.handleError(fmt.Errorf("%s Error loading recorded penalties: %w", checkPrefix, err))
+			return
+		}
+
 		// Get latest block
 		head, headExists, err := t.bc.GetBeaconBlock("finalized")
 		if err != nil {
@@ -294,7 +308,7 @@ func (t *processPenalties) run() error {
 			if slotsSinceUpdate >= 10000 {
 				t.log.Printlnf("\t%s At block %d of %d...", checkPrefix, i, currentSlot)
 				slotsSinceUpdate = 0
-				s.LatestPenaltySlot = block.Slot
+				s.LatestPenaltySlot = i
 				err = s.saveState(watchtowerStatePath)
 				if err != nil {
 					t.handleError(fmt.Errorf("%s Error saving watchtower state file: %w", checkPrefix, err))
@@ -383,14 +397,10 @@ func (t *processPenalties) processBlock(block *beacon.BeaconBlock, smoothingPool
 	// Retrieve the rETH address
 	rethAddress := t.cfg.Smartnode.GetRethAddress()
 
-	// Ignore blocks that were sent to the smoothing pool
-	if smoothingPoolAddress != emptyAddress && block.FeeRecipient == smoothingPoolAddress {
-		return isIllegalFeeRecipient, nil
-	}
-
-	// Ignore blocks that were sent to the rETH address
-	if block.FeeRecipient == rethAddress {
-		return isIllegalFeeRecipient, nil
+	// Sending the fees to the smoothing pool or the rETH address is always allowed
+	allowedRecipients := []common.Address{rethAddress}
+	if smoothingPoolAddress != emptyAddress {
+		allowedRecipients = append(allowedRecipients, smoothingPoolAddress)
 	}
 
 	// Check if the user was opted into the smoothing pool for this block
@@ -399,76 +409,171 @@ func (t *processPenalties) processBlock(block *beacon.BeaconBlock, smoothingPool
 	}
 	isOptedIn, err := node.GetSmoothingPoolRegistrationState(t.rp, nodeAddress, &opts)
 	if err != nil {
-		t.log.Printlnf("*** WARNING: Couldn't check if node %s was opted into the smoothing pool for slot %d (execution block %d), skipping check... error: %w\n***", nodeAddress.Hex(), block.Slot, block.ExecutionBlockNumber, err)
+		t.log.Printlnf("*** WARNING: Couldn't check if node %s was opted into the smoothing pool for slot %d (execution block %d), skipping check... error: %s\n***", nodeAddress.Hex(), block.Slot, block.ExecutionBlockNumber, err.Error())
 		isOptedIn = false
 	}
 
-	// Check for smoothing pool theft
-	if isOptedIn && block.FeeRecipient != smoothingPoolAddress {
-		t.log.Println("=== SMOOTHING POOL THEFT DETECTED ===")
-		t.log.Printlnf("Beacon Block:  %d", block.Slot)
-		t.log.Printlnf("Minipool:      %s", minipoolAddress.Hex())
-		t.log.Printlnf("Node:          %s", nodeAddress.Hex())
-		t.log.Printlnf("FEE RECIPIENT: %s", block.FeeRecipient.Hex())
-		t.log.Println("=====================================")
-
-		isIllegalFeeRecipient = true
-		err = t.submitPenalty(minipoolAddress, block)
+	// Work out where the fees had to go
+	var violationType apitypes.PenaltyViolationType
+	expectedRecipient := smoothingPoolAddress
+	if isOptedIn {
+		violationType = apitypes.PenaltyViolationType_SmoothingPoolTheft
+	} else if t.optedOutLate(block, nodeAddress, &opts) {
+		// They opted out too late to keep the fees of this block, so the smoothing pool is still the only legal recipient
+		violationType = apitypes.PenaltyViolationType_LateOptOut
+	} else {
+		violationType = apitypes.PenaltyViolationType_IllegalFeeRecipient
+		expectedRecipient = distributorAddress
+		allowedRecipients = append(allowedRecipients, distributorAddress)
+	}
+
+	if isAllowedRecipient(block.FeeRecipient, allowedRecipients) {
+		return isIllegalFeeRecipient, nil
+	}
+
+	// Blocks built with MEV-Boost use the builder as the fee recipient and pay the proposer with a transaction instead
+	mevRecipient, mevAmount, hasMevPayment, err := t.getMevPayment(block)
+	if err != nil {
 		return isIllegalFeeRecipient, err
 	}
+	if hasMevPayment && isAllowedRecipient(mevRecipient, allowedRecipients) {
+		return isIllegalFeeRecipient, nil
+	}
 
-	// Make sure they didn't opt out in order to steal a block
-	if !isOptedIn {
-		// Get the opt out time
-		optOutTime, err := node.GetSmoothingPoolRegistrationChanged(t.rp, nodeAddress, &opts)
-		if err != nil {
-			t.log.Printlnf("*** WARNING: Couldn't check when node %s opted out of the smoothing pool for slot %d (execution block %d), skipping check... error: %w\n***", nodeAddress.Hex(), block.Slot, block.ExecutionBlockNumber, err)
-		} else if optOutTime != time.Unix(0, 0) {
-			// Get the time of the epoch before this one
-			blockEpoch := block.Slot / t.beaconConfig.SlotsPerEpoch
-			previousEpoch := blockEpoch - 1
-			genesisTime := time.Unix(int64(t.beaconConfig.GenesisTime), 0)
-			epochStartTime := genesisTime.Add(time.Second * time.Duration(t.beaconConfig.SecondsPerEpoch*previousEpoch))
-
-			// If they opted out after the start of the previous epoch, they cheated
-			if optOutTime.Sub(epochStartTime) > 0 {
-				t.log.Println("=== SMOOTHING POOL THEFT DETECTED ===")
-				t.log.Printlnf("Beacon Block:         %d", block.Slot)
-				t.log.Printlnf("Safe Opt Out Time:    %s", epochStartTime)
-				t.log.Printlnf("ACTUAL OPT OUT TIME:  %s", optOutTime)
-				t.log.Printlnf("Minipool:             %s", minipoolAddress.Hex())
-				t.log.Printlnf("Node:                 %s", nodeAddress.Hex())
-				t.log.Printlnf("FEE RECIPIENT:        %s", block.FeeRecipient.Hex())
-				t.log.Println("=====================================")
-
-				isIllegalFeeRecipient = true
-				err = t.submitPenalty(minipoolAddress, block)
-				return isIllegalFeeRecipient, err
-			}
-		}
+	// Record the violation
+	isIllegalFeeRecipient = true
+	violation := apitypes.PenaltyViolation{
+		Type:                 violationType,
+		Slot:                 block.Slot,
+		ExecutionBlockNumber: block.ExecutionBlockNumber,
+		ProposerIndex:        block.ProposerIndex,
+		MinipoolAddress:      minipoolAddress,
+		NodeAddress:          nodeAddress,
+		ExpectedRecipient:    expectedRecipient,
+		FeeRecipient:         block.FeeRecipient,
+		HasMevPayment:        hasMevPayment,
+		MevRecipient:         mevRecipient,
+		MevAmount:            mevAmount,
+		DetectedTime:         time.Now(),
+	}
+	t.printViolation(violation)
+	index := penalties.FindViolation(t.violations, violation.Slot, violation.MinipoolAddress)
+	if index == -1 {
+		t.violations = append(t.violations, violation)
+		index = len(t.violations) - 1
+	}
+	err = penalties.SaveViolations(t.cfg.Smartnode.GetWatchtowerPenaltiesPath(), t.violations)
+	if err != nil {
+		return isIllegalFeeRecipient, err
 	}
 
-	// Check for distributor address theft
-	if !isOptedIn && block.FeeRecipient != distributorAddress {
-		t.log.Println("=== ILLEGAL FEE RECIPIENT DETECTED ===")
-		t.log.Printlnf("Beacon Block:  %d", block.Slot)
-		t.log.Printlnf("Minipool:      %s", minipoolAddress.Hex())
-		t.log.Printlnf("Node:          %s", nodeAddress.Hex())
-		t.log.Printlnf("Distributor:   %s", distributorAddress.Hex())
-		t.log.Printlnf("FEE RECIPIENT: %s", block.FeeRecipient.Hex())
-		t.log.Println("======================================")
-
-		isIllegalFeeRecipient = true
-		err = t.submitPenalty(minipoolAddress, block)
+	// Submit the penalty if enabled
+	if t.violations[index].PenaltySubmitted {
+		return isIllegalFeeRecipient, nil
+	}
+	if !t.cfg.Smartnode.WatchtowerSubmitPenalties.Value.(bool) {
+		t.log.Println("Penalty submission is disabled, so the violation was only recorded.")
+		return isIllegalFeeRecipient, nil
+	}
+	hash, submitted, err := t.submitPenalty(minipoolAddress, block)
+	if err != nil {
 		return isIllegalFeeRecipient, err
 	}
+	if submitted {
+		t.violations[index].PenaltySubmitted = true
+		t.violations[index].PenaltyTxHash = hash
+		err = penalties.SaveViolations(t.cfg.Smartnode.GetWatchtowerPenaltiesPath(), t.violations)
+	}
+	return isIllegalFeeRecipient, err
+
+}
+
+// Check if a node opted out of the smoothing pool after the start of the epoch before the block, in order to steal it
+func (t *processPenalties) optedOutLate(block *beacon.BeaconBlock, nodeAddress common.Address, opts *bind.CallOpts) bool {
+
+	// Get the opt out time
+	optOutTime, err := node.GetSmoothingPoolRegistrationChanged(t.rp, nodeAddress, opts)
+	if err != nil {
+		t.log.Printlnf("*** WARNING: Couldn't check when node %s opted out of the smoothing pool for slot %d (execution block %d), skipping check... error: %s\n***", nodeAddress.Hex(), block.Slot, block.ExecutionBlockNumber, err.Error())
+		return false
+	}
+	if optOutTime == time.Unix(0, 0) {
+		return false
+	}
+
+	// Get the time of the epoch before this one
+	blockEpoch := block.Slot / t.beaconConfig.SlotsPerEpoch
+	previousEpoch := blockEpoch - 1
+	genesisTime := time.Unix(int64(t.beaconConfig.GenesisTime), 0)
+	epochStartTime := genesisTime.Add(time.Second * time.Duration(t.beaconConfig.SecondsPerEpoch*previousEpoch))
+
+	// If they opted out after the start of the previous epoch, they cheated
+	if optOutTime.Sub(epochStartTime) > 0 {
+		t.log.Printlnf("Node %s opted out of the smoothing pool at %s, after the safe opt out time of %s for slot %d.", nodeAddress.Hex(), optOutTime, epochStartTime, block.Slot)
+		return true
+	}
+	return false
+
+}
+
+// Get the MEV payment in a block built by MEV-Boost; it's the last transaction in the block, sent by the builder (the block's fee recipient)
+func (t *processPenalties) getMevPayment(block *beacon.BeaconBlock) (common.Address, *big.Int, bool, error) {
+
+	executionBlock, err := t.ec.BlockByNumber(context.Background(), new(big.Int).SetUint64(block.ExecutionBlockNumber))
+	if err != nil {
+		return common.Address{}, nil, false, fmt.Errorf("Error getting execution block %d for slot %d: %w", block.ExecutionBlockNumber, block.Slot, err)
+	}
+	txs := executionBlock.Transactions()
+	if len(txs) == 0 {
+		return common.Address{}, nil, false, nil
+	}
+	tx := txs[len(txs)-1]
+	if tx.To() == nil {
+		return common.Address{}, nil, false, nil
+	}
+
+	// Only count it if the builder sent it
+	sender, err := types.LatestSignerForChainID(t.chainID).Sender(tx)
+	if err != nil {
+		return common.Address{}, nil, false, fmt.Errorf("Error getting the sender of transaction %s: %w", tx.Hash().Hex(), err)
+	}
+	if sender != block.FeeRecipient {
+		return common.Address{}, nil, false, nil
+	}
+	return *tx.To(), tx.Value(), true, nil
 
-	// No cheating detected
-	return isIllegalFeeRecipient, nil
+}
 
+// Print the details of a violation
+func (t *processPenalties) printViolation(violation apitypes.PenaltyViolation) {
+	switch violation.Type {
+	case apitypes.PenaltyViolationType_SmoothingPoolTheft, apitypes.PenaltyViolationType_LateOptOut:
+		t.log.Println("=== SMOOTHING POOL THEFT DETECTED ===")
+	default:
+		t.log.Println("=== ILLEGAL FEE RECIPIENT DETECTED ===")
+	}
+	t.log.Printlnf("Beacon Block:       %d", violation.Slot)
+	t.log.Printlnf("Minipool:           %s", violation.MinipoolAddress.Hex())
+	t.log.Printlnf("Node:               %s", violation.NodeAddress.Hex())
+	t.log.Printlnf("Expected Recipient: %s", violation.ExpectedRecipient.Hex())
+	t.log.Printlnf("FEE RECIPIENT:      %s", violation.FeeRecipient.Hex())
+	if violation.HasMevPayment {
+		t.log.Printlnf("MEV RECIPIENT:      %s (%.6f ETH)", violation.MevRecipient.Hex(), eth.WeiToEth(violation.MevAmount))
+	}
+	t.log.Println("======================================")
 }
 
-func (t *processPenalties) submitPenalty(minipoolAddress common.Address, block *beacon.BeaconBlock) error {
+// Check if an address is one of the allowed fee recipients
+func isAllowedRecipient(recipient common.Address, allowedRecipients []common.Address) bool {
+	for _, allowedRecipient := range allowedRecipients {
+		if recipient == allowedRecipient {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *processPenalties) submitPenalty(minipoolAddress common.Address, block *beacon.BeaconBlock) (common.Hash, bool, error) {
 
 	// Check if this penalty has already been applied
 	blockNumberBuf := make([]byte, 32)
@@ -476,23 +581,23 @@ func (t *processPenalties) submitPenalty(minipoolAddress common.Address, block *
 	slotBig.FillBytes(blockNumberBuf)
 	penaltyExecuted, err := t.rp.RocketStorage.GetBool(nil, crypto.Keccak256Hash([]byte("network.penalties.executed"), minipoolAddress.Bytes(), blockNumberBuf))
 	if err != nil {
-		return fmt.Errorf("Could not check if penality has already been applied for block %d, minipool %s: %w", block.Slot, minipoolAddress.Hex(), err)
+		return common.Hash{}, false, fmt.Errorf("Could not check if penality has already been applied for block %d, minipool %s: %w", block.Slot, minipoolAddress.Hex(), err)
 	}
 	if penaltyExecuted {
 		t.log.Printlnf("NOTE: Minipool %s was already penalized on block %d, skipping...", minipoolAddress.Hex(), block.Slot)
-		return nil
+		return common.Hash{}, true, nil
 	}
 
 	// Get transactor
 	opts, err := t.w.GetNodeAccountTransactor()
 	if err != nil {
-		return err
+		return common.Hash{}, false, err
 	}
 
 	// Get the gas limit
 	gasInfo, err := network.EstimateSubmitPenaltyGas(t.rp, minipoolAddress, slotBig, opts)
 	if err != nil {
-		return fmt.Errorf("Could not estimate the gas required to submit penalty: %w", err)
+		return common.Hash{}, false, fmt.Errorf("Could not estimate the gas required to submit penalty: %w", err)
 	}
 	var gas *big.Int
 	if t.gasLimit != 0 {
@@ -506,13 +611,13 @@ func (t *processPenalties) submitPenalty(minipoolAddress common.Address, block *
 	if maxFee == nil || maxFee.Uint64() == 0 {
 		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
 		if err != nil {
-			return err
+			return common.Hash{}, false, err
 		}
 	}
 
 	// Print the gas info
 	if !api.PrintAndCheckGasInfo(gasInfo, false, 0, &t.log, maxFee, t.gasLimit) {
-		return nil
+		return common.Hash{}, false, nil
 	}
 
 	opts.GasFeeCap = maxFee
@@ -521,18 +626,18 @@ func (t *processPenalties) submitPenalty(minipoolAddress common.Address, block *
 
 	hash, err := network.SubmitPenalty(t.rp, minipoolAddress, slotBig, opts)
 	if err != nil {
-		return fmt.Errorf("Error submitting penalty against %s for block %d: %w", minipoolAddress.Hex(), block.Slot, err)
+		return common.Hash{}, false, fmt.Errorf("Error submitting penalty against %s for block %d: %w", minipoolAddress.Hex(), block.Slot, err)
 	}
 
 	// Print TX info and wait for it to be included in a block
 	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
 	if err != nil {
-		return err
+		return hash, false, err
 	}
 
 	// Log result
 	t.log.Printlnf("Submitted penalty against %s with fee recipient %s on block %d with tx %s", minipoolAddress.Hex(), block.FeeRecipient.Hex(), block.Slot, hash.Hex())
 
-	return nil
+	return hash, true, nil
 
 }
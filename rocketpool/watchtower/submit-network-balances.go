@@ -19,6 +19,7 @@ import (
 	"github.com/urfave/cli"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/collectors"
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower/utils"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
@@ -45,6 +46,7 @@ type submitNetworkBalances struct {
 	ec        rocketpool.ExecutionClient
 	rp        *rocketpool.RocketPool
 	bc        beacon.Client
+	consensus *consensusChecker
 	lock      *sync.Mutex
 	isRunning bool
 }
@@ -67,7 +69,7 @@ type minipoolBalanceDetails struct {
 }
 
 // Create submit network balances task
-func newSubmitNetworkBalances(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger) (*submitNetworkBalances, error) {
+func newSubmitNetworkBalances(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, consensusCollector *collectors.ConsensusCollector) (*submitNetworkBalances, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -102,6 +104,7 @@ func newSubmitNetworkBalances(c *cli.Context, logger log.ColorLogger, errorLogge
 		ec:        ec,
 		rp:        rp,
 		bc:        bc,
+		consensus: newConsensusChecker(consensusReportBalances, &logger, &errorLogger, cfg, rp, consensusCollector),
 		lock:      lock,
 		isRunning: false,
 	}, nil
@@ -226,6 +229,14 @@ func (t *submitNetworkBalances) run(state *state.NetworkState) error {
 			t.log.Printlnf("Have previously submitted out-of-date balances for block %d, trying again...", blockNumber)
 		}
 
+		// Compare with the other members' submissions
+		if !t.checkConsensus(logPrefix, nodeAccount.Address, balances) {
+			t.lock.Lock()
+			t.isRunning = false
+			t.lock.Unlock()
+			return
+		}
+
 		// Log
 		t.log.Println("Submitting balances...")
 
@@ -268,13 +279,7 @@ func (t *submitNetworkBalances) hasSubmittedBlockBalances(nodeAddress common.Add
 func (t *submitNetworkBalances) hasSubmittedSpecificBlockBalances(nodeAddress common.Address, blockNumber uint64, balances networkBalances) (bool, error) {
 
 	// Calculate total ETH balance
-	totalEth := big.NewInt(0)
-	totalEth.Sub(totalEth, balances.NodeCreditBalance)
-	totalEth.Add(totalEth, balances.DepositPool)
-	totalEth.Add(totalEth, balances.MinipoolsTotal)
-	totalEth.Add(totalEth, balances.RETHContract)
-	totalEth.Add(totalEth, balances.DistributorShareTotal)
-	totalEth.Add(totalEth, balances.SmoothingPoolShare)
+	totalEth := balances.getTotalEth()
 
 	blockNumberBuf := make([]byte, 32)
 	big.NewInt(int64(blockNumber)).FillBytes(blockNumberBuf)
@@ -292,6 +297,34 @@ func (t *submitNetworkBalances) hasSubmittedSpecificBlockBalances(nodeAddress co
 
 }
 
+// Compare our balances with the other members' submissions for the same block; returns false if our vote should be held back
+func (t *submitNetworkBalances) checkConsensus(logPrefix string, nodeAddress common.Address, balances networkBalances) bool {
+
+	if !t.consensus.isEnabled() {
+		return true
+	}
+	others, err := t.consensus.getBalancesSubmissions(balances.Block)
+	if err != nil {
+		t.log.Printlnf("%s WARNING: couldn't compare balances with the other members: %s", logPrefix, err.Error())
+		return true
+	}
+	ours := getBalancesConsensusValues(balances.getTotalEth(), balances.MinipoolsStaking, balances.RETHSupply)
+	return t.consensus.check(logPrefix, fmt.Sprintf("block %d", balances.Block), balances.Block, nodeAddress, ours, others)
+
+}
+
+// Get the total ETH balance of the network
+func (b networkBalances) getTotalEth() *big.Int {
+	totalEth := big.NewInt(0)
+	totalEth.Sub(totalEth, b.NodeCreditBalance)
+	totalEth.Add(totalEth, b.DepositPool)
+	totalEth.Add(totalEth, b.MinipoolsTotal)
+	totalEth.Add(totalEth, b.RETHContract)
+	totalEth.Add(totalEth, b.DistributorShareTotal)
+	totalEth.Add(totalEth, b.SmoothingPoolShare)
+	return totalEth
+}
+
 // Prints a message to the log
 func (t *submitNetworkBalances) printMessage(message string) {
 	t.log.Println(message)
@@ -489,13 +522,7 @@ func (t *submitNetworkBalances) getMinipoolBalanceDetails(mpd *rpstate.NativeMin
 func (t *submitNetworkBalances) submitBalances(balances networkBalances) error {
 
 	// Calculate total ETH balance
-	totalEth := big.NewInt(0)
-	totalEth.Sub(totalEth, balances.NodeCreditBalance)
-	totalEth.Add(totalEth, balances.DepositPool)
-	totalEth.Add(totalEth, balances.MinipoolsTotal)
-	totalEth.Add(totalEth, balances.RETHContract)
-	totalEth.Add(totalEth, balances.DistributorShareTotal)
-	totalEth.Add(totalEth, balances.SmoothingPoolShare)
+	totalEth := balances.getTotalEth()
 
 	ratio := eth.WeiToEth(totalEth) / eth.WeiToEth(balances.RETHSupply)
 	t.log.Printlnf("Total ETH = %s\n", totalEth)
@@ -20,6 +20,7 @@ import (
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/tokens"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/collectors"
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower/utils"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
@@ -48,6 +49,7 @@ type submitRewardsTree_Rolling struct {
 	genesisTime time.Time
 	recordMgr   *rprewards.RollingRecordManager
 	stateMgr    *state.NetworkStateManager
+	consensus   *consensusChecker
 	logPrefix   string
 
 	lock      *sync.Mutex
@@ -55,7 +57,7 @@ type submitRewardsTree_Rolling struct {
 }
 
 // Create submit rewards tree with rolling record support
-func newSubmitRewardsTree_Rolling(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, stateMgr *state.NetworkStateManager) (*submitRewardsTree_Rolling, error) {
+func newSubmitRewardsTree_Rolling(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, stateMgr *state.NetworkStateManager, consensusCollector *collectors.ConsensusCollector) (*submitRewardsTree_Rolling, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -134,6 +136,7 @@ func newSubmitRewardsTree_Rolling(c *cli.Context, logger log.ColorLogger, errorL
 		lock:        lock,
 		isRunning:   false,
 	}
+	task.consensus = newConsensusChecker(consensusReportRewards, &task.log, &task.errLog, cfg, rp, consensusCollector)
 
 	// Make a new rolling manager
 	recordMgr, err := rprewards.NewRollingRecordManager(&task.log, &task.errLog, cfg, rp, bc, stateMgr, startSlot, beaconCfg, currentIndex)
@@ -668,6 +671,15 @@ func (t *submitRewardsTree_Rolling) submitRewardsSnapshot(index *big.Int, consen
 		UserETH:         &rewardsFileHeader.TotalRewards.PoolStakerSmoothingPoolEth.Int,
 	}
 
+	// Compare with the other members' submissions
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	if !t.consensus.checkRewards(t.logPrefix, nodeAccount.Address, submission) {
+		return fmt.Errorf("the rewards snapshot for interval %s disagrees with the majority of the Oracle DAO members that have already submitted, so it was held back", index.String())
+	}
+
 	// Get the gas limit
 	gasInfo, err := rewards.EstimateSubmitRewardSnapshotGas(t.rp, submission, opts)
 	if err != nil {
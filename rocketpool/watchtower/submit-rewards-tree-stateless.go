@@ -20,6 +20,7 @@ import (
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/tokens"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/collectors"
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower/utils"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
@@ -50,10 +51,11 @@ type submitRewardsTree_Stateless struct {
 	isRunning        bool
 	generationPrefix string
 	m                *state.NetworkStateManager
+	consensus        *consensusChecker
 }
 
 // Create submit rewards Merkle Tree task
-func newSubmitRewardsTree_Stateless(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, m *state.NetworkStateManager) (*submitRewardsTree_Stateless, error) {
+func newSubmitRewardsTree_Stateless(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, m *state.NetworkStateManager, consensusCollector *collectors.ConsensusCollector) (*submitRewardsTree_Stateless, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -92,6 +94,7 @@ func newSubmitRewardsTree_Stateless(c *cli.Context, logger log.ColorLogger, erro
 		generationPrefix: "[Merkle Tree]",
 		m:                m,
 	}
+	generator.consensus = newConsensusChecker(consensusReportRewards, generator.log, generator.errLog, cfg, rp, consensusCollector)
 
 	return generator, nil
 }
@@ -464,6 +467,15 @@ func (t *submitRewardsTree_Stateless) submitRewardsSnapshot(index *big.Int, cons
 		UserETH:         &rewardsFileHeader.TotalRewards.PoolStakerSmoothingPoolEth.Int,
 	}
 
+	// Compare with the other members' submissions
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	if !t.consensus.checkRewards(t.generationPrefix, nodeAccount.Address, submission) {
+		return fmt.Errorf("the rewards snapshot for interval %s disagrees with the majority of the Oracle DAO members that have already submitted, so it was held back", index.String())
+	}
+
 	// Get the gas limit
 	gasInfo, err := rewards.EstimateSubmitRewardSnapshotGas(t.rp, submission, opts)
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
 	"github.com/urfave/cli"
 
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/collectors"
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower/utils"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
@@ -200,12 +201,13 @@ type submitRplPrice struct {
 	w         *wallet.Wallet
 	rp        *rocketpool.RocketPool
 	bc        beacon.Client
+	consensus *consensusChecker
 	lock      *sync.Mutex
 	isRunning bool
 }
 
 // Create submit RPL price task
-func newSubmitRplPrice(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger) (*submitRplPrice, error) {
+func newSubmitRplPrice(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, consensusCollector *collectors.ConsensusCollector) (*submitRplPrice, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -231,7 +233,7 @@ func newSubmitRplPrice(c *cli.Context, logger log.ColorLogger, errorLogger log.C
 
 	// Return task
 	lock := &sync.Mutex{}
-	return &submitRplPrice{
+	task := &submitRplPrice{
 		c:      c,
 		log:    logger,
 		errLog: errorLogger,
@@ -241,7 +243,9 @@ func newSubmitRplPrice(c *cli.Context, logger log.ColorLogger, errorLogger log.C
 		rp:     rp,
 		bc:     bc,
 		lock:   lock,
-	}, nil
+	}
+	task.consensus = newConsensusChecker(consensusReportPrices, &task.log, &task.errLog, cfg, rp, consensusCollector)
+	return task, nil
 
 }
 
@@ -388,6 +392,14 @@ func (t *submitRplPrice) run(state *state.NetworkState) error {
 			t.log.Printlnf("Have previously submitted out-of-date prices for block %d, trying again...", blockNumber)
 		}
 
+		// Compare with the other members' submissions
+		if !t.checkConsensus(logPrefix, nodeAccount.Address, blockNumber, rplPrice) {
+			t.lock.Lock()
+			t.isRunning = false
+			t.lock.Unlock()
+			return
+		}
+
 		// Log
 		t.log.Println("Submitting RPL price...")
 
@@ -417,6 +429,22 @@ func (t *submitRplPrice) handleError(err error) {
 	t.lock.Unlock()
 }
 
+// Compare our RPL price with the other members' submissions for the same block; returns false if our vote should be held back
+func (t *submitRplPrice) checkConsensus(logPrefix string, nodeAddress common.Address, blockNumber uint64, rplPrice *big.Int) bool {
+
+	if !t.consensus.isEnabled() {
+		return true
+	}
+	others, err := t.consensus.getPricesSubmissions(blockNumber)
+	if err != nil {
+		t.log.Printlnf("%s WARNING: couldn't compare the RPL price with the other members: %s", logPrefix, err.Error())
+		return true
+	}
+	ours := getPricesConsensusValues(rplPrice)
+	return t.consensus.check(logPrefix, fmt.Sprintf("block %d", blockNumber), blockNumber, nodeAddress, ours, others)
+
+}
+
 // Check whether prices for a block has already been submitted by the node
 func (t *submitRplPrice) hasSubmittedBlockPrices(nodeAddress common.Address, blockNumber uint64) (bool, error) {
 
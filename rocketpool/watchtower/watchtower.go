@@ -3,7 +3,6 @@ package watchtower
 import (
 	"fmt"
 	"math/big"
-	"math/rand"
 	"net/http"
 	"sync"
 	"time"
@@ -19,6 +18,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/tasks"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
@@ -86,6 +86,13 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	txm, err := services.GetTransactionManager(c)
+	if err != nil {
+		return err
+	}
+
+	// Let the transaction manager assign nonces, so transactions in flight aren't replaced by accident
+	txm.ManageNonces()
 
 	// Print the current mode
 	if cfg.IsNativeMode {
@@ -104,6 +111,7 @@ func run(c *cli.Context) error {
 	scrubCollector := collectors.NewScrubCollector()
 	bondReductionCollector := collectors.NewBondReductionCollector()
 	soloMigrationCollector := collectors.NewSoloMigrationCollector()
+	consensusCollector := collectors.NewConsensusCollector()
 
 	// Initialize error logger
 	errorLog := log.NewColorLogger(ErrorColor)
@@ -126,11 +134,11 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return fmt.Errorf("error during respond-to-challenges check: %w", err)
 	}
-	submitRplPrice, err := newSubmitRplPrice(c, log.NewColorLogger(SubmitRplPriceColor), errorLog)
+	submitRplPrice, err := newSubmitRplPrice(c, log.NewColorLogger(SubmitRplPriceColor), errorLog, consensusCollector)
 	if err != nil {
 		return fmt.Errorf("error during rpl price check: %w", err)
 	}
-	submitNetworkBalances, err := newSubmitNetworkBalances(c, log.NewColorLogger(SubmitNetworkBalancesColor), errorLog)
+	submitNetworkBalances, err := newSubmitNetworkBalances(c, log.NewColorLogger(SubmitNetworkBalancesColor), errorLog, consensusCollector)
 	if err != nil {
 		return fmt.Errorf("error during network balances check: %w", err)
 	}
@@ -145,20 +153,20 @@ func run(c *cli.Context) error {
 	var submitRewardsTree_Stateless *submitRewardsTree_Stateless
 	var submitRewardsTree_Rolling *submitRewardsTree_Rolling
 	if !useRollingRecords {
-		submitRewardsTree_Stateless, err = newSubmitRewardsTree_Stateless(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog, m)
+		submitRewardsTree_Stateless, err = newSubmitRewardsTree_Stateless(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog, m, consensusCollector)
 		if err != nil {
 			return fmt.Errorf("error during stateless rewards tree check: %w", err)
 		}
 	} else {
-		submitRewardsTree_Rolling, err = newSubmitRewardsTree_Rolling(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog, m)
+		submitRewardsTree_Rolling, err = newSubmitRewardsTree_Rolling(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog, m, consensusCollector)
 		if err != nil {
 			return fmt.Errorf("error during rolling rewards tree check: %w", err)
 		}
 	}
-	/*processPenalties, err := newProcessPenalties(c, log.NewColorLogger(ProcessPenaltiesColor), errorLog)
+	processPenalties, err := newProcessPenalties(c, log.NewColorLogger(ProcessPenaltiesColor), errorLog, m)
 	if err != nil {
 		return fmt.Errorf("error during penalties check: %w", err)
-	}*/
+	}
 	generateRewardsTree, err := newGenerateRewardsTree(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog, m)
 	if err != nil {
 		return fmt.Errorf("error during manual tree generation check: %w", err)
@@ -172,8 +180,99 @@ func run(c *cli.Context) error {
 		return fmt.Errorf("error during solo migration check: %w", err)
 	}
 
-	intervalDelta := maxTasksInterval - minTasksInterval
-	secondsDelta := intervalDelta.Seconds()
+	// Register the tasks; most of them only run while this node is in the Oracle DAO
+	var latestBlock beacon.BeaconBlock
+	isOnOdao := false
+	onOdao := func() bool {
+		return isOnOdao
+	}
+	runner := tasks.NewRunner("watchtower", cfg.Smartnode.GetTaskStatusPath("watchtower", true), &errorLog, taskCooldown)
+	runner.Register(tasks.Task{
+		Name:     "generateRewardsTree",
+		Interval: minTasksInterval,
+		Jitter:   maxTasksInterval - minTasksInterval,
+		Run: func(_ *state.NetworkState) error {
+			return generateRewardsTree.run()
+		},
+	})
+	runner.Register(tasks.Task{
+		Name:      "respondChallenges",
+		Interval:  minTasksInterval,
+		Jitter:    maxTasksInterval - minTasksInterval,
+		Condition: onOdao,
+		Run: func(_ *state.NetworkState) error {
+			return respondChallenges.run()
+		},
+	})
+	runner.Register(tasks.Task{
+		Name:       "submitNetworkBalances",
+		Interval:   minTasksInterval,
+		Jitter:     maxTasksInterval - minTasksInterval,
+		NeedsState: true,
+		Condition:  onOdao,
+		Run:        submitNetworkBalances.run,
+	})
+	runner.Register(tasks.Task{
+		Name:       "submitRewardsTree",
+		Interval:   minTasksInterval,
+		Jitter:     maxTasksInterval - minTasksInterval,
+		NeedsState: true, // The state is nil if this node isn't in the Oracle DAO
+		Run: func(state *state.NetworkState) error {
+			if !useRollingRecords {
+				return submitRewardsTree_Stateless.Run(isOnOdao, state, latestBlock.Slot)
+			}
+			return submitRewardsTree_Rolling.run(state)
+		},
+	})
+	runner.Register(tasks.Task{
+		Name:       "submitRplPrice",
+		Interval:   minTasksInterval,
+		Jitter:     maxTasksInterval - minTasksInterval,
+		NeedsState: true,
+		Condition:  onOdao,
+		Run:        submitRplPrice.run,
+	})
+	runner.Register(tasks.Task{
+		Name:       "dissolveTimedOutMinipools",
+		Interval:   minTasksInterval,
+		Jitter:     maxTasksInterval - minTasksInterval,
+		NeedsState: true,
+		Condition:  onOdao,
+		Run:        dissolveTimedOutMinipools.run,
+	})
+	runner.Register(tasks.Task{
+		Name:       "submitScrubMinipools",
+		Interval:   minTasksInterval,
+		Jitter:     maxTasksInterval - minTasksInterval,
+		NeedsState: true,
+		Condition:  onOdao,
+		Run:        submitScrubMinipools.run,
+	})
+	runner.Register(tasks.Task{
+		Name:       "cancelBondReductions",
+		Interval:   minTasksInterval,
+		Jitter:     maxTasksInterval - minTasksInterval,
+		NeedsState: true,
+		Condition:  onOdao,
+		Run:        cancelBondReductions.run,
+	})
+	runner.Register(tasks.Task{
+		Name:       "checkSoloMigrations",
+		Interval:   minTasksInterval,
+		Jitter:     maxTasksInterval - minTasksInterval,
+		NeedsState: true,
+		Condition:  onOdao,
+		Run:        checkSoloMigrations.run,
+	})
+	runner.Register(tasks.Task{
+		Name:      "processPenalties",
+		Interval:  minTasksInterval,
+		Jitter:    maxTasksInterval - minTasksInterval,
+		Condition: onOdao,
+		Run: func(_ *state.NetworkState) error {
+			return processPenalties.run()
+		},
+	})
 
 	// Wait group to handle the various threads
 	wg := new(sync.WaitGroup)
@@ -182,15 +281,15 @@ func run(c *cli.Context) error {
 	// Run task loop
 	go func() {
 		for {
-			// Randomize the next interval
-			randomSeconds := rand.Intn(int(secondsDelta))
-			interval := time.Duration(randomSeconds)*time.Second + minTasksInterval
+			// Wait for the next task to be due
+			events := runner.Wait()
 
 			// Check the EC status
 			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
 			if err != nil {
 				errorLog.Println(err)
 				time.Sleep(taskCooldown)
+				runner.Requeue(events)
 				continue
 			}
 
@@ -199,126 +298,47 @@ func run(c *cli.Context) error {
 			if err != nil {
 				errorLog.Println(err)
 				time.Sleep(taskCooldown)
+				runner.Requeue(events)
 				continue
 			}
 
 			// Get the Beacon block
 			//latestBlock, err := m.GetLatestFinalizedBeaconBlock()
-			latestBlock, err := m.GetLatestBeaconBlock()
+			latestBlock, err = m.GetLatestBeaconBlock()
 			if err != nil {
 				errorLog.Println(fmt.Errorf("error getting latest Beacon block: %w", err))
 				time.Sleep(taskCooldown)
+				runner.Requeue(events)
 				continue
 			}
 
 			// Check if on the Oracle DAO
-			isOnOdao, err := isOnOracleDAO(rp, nodeAccount.Address, latestBlock)
+			isOnOdao, err = isOnOracleDAO(rp, nodeAccount.Address, latestBlock)
 			if err != nil {
 				errorLog.Println(err)
 				time.Sleep(taskCooldown)
+				runner.Requeue(events)
 				continue
 			}
 
-			// Run the manual rewards tree generation
-			if err := generateRewardsTree.run(); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
-
-			if isOnOdao {
-				// Run the challenge check
-				if err := respondChallenges.run(); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				// Update the network state
-				state, err := updateNetworkState(m, &updateLog, latestBlock)
-				if err != nil {
-					errorLog.Println(err)
-					time.Sleep(taskCooldown)
-					continue
-				}
-
-				// Run the network balance submission check
-				if err := submitNetworkBalances.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				if !useRollingRecords {
-					// Run the rewards tree submission check
-					if err := submitRewardsTree_Stateless.Run(isOnOdao, state, latestBlock.Slot); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-				} else {
-					// Run the network balance and rewards tree submission check
-					if err := submitRewardsTree_Rolling.run(state); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-				}
-
-				// Run the price submission check
-				if err := submitRplPrice.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				// Run the minipool dissolve check
-				if err := dissolveTimedOutMinipools.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				// Run the minipool scrub check
-				if err := submitScrubMinipools.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				// Run the bond cancel check
-				if err := cancelBondReductions.run(state); err != nil {
-					errorLog.Println(err)
+			// Run the due tasks, updating the network state first if any of them need it
+			err = runner.RunDue(events, func() (*state.NetworkState, error) {
+				if !isOnOdao {
+					return nil, nil
 				}
+				return updateNetworkState(m, &updateLog, latestBlock)
+			})
+			if err != nil {
+				errorLog.Println(err)
 				time.Sleep(taskCooldown)
-
-				// Run the solo migration check
-				if err := checkSoloMigrations.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				/*time.Sleep(taskCooldown)
-
-				// Run the fee recipient penalty check
-				if err := processPenalties.run(); err != nil {
-					errorLog.Println(err)
-				}*/
-				// DISABLED until MEV-Boost can support it
-			} else {
-				/*
-				 */
-				if !useRollingRecords {
-					// Run the rewards tree submission check
-					if err := submitRewardsTree_Stateless.Run(isOnOdao, nil, latestBlock.Slot); err != nil {
-						errorLog.Println(err)
-					}
-				} else {
-					// Run the network balance and rewards tree submission check
-					if err := submitRewardsTree_Rolling.run(nil); err != nil {
-						errorLog.Println(err)
-					}
-				}
 			}
-
-			time.Sleep(interval)
 		}
 		wg.Done()
 	}()
 
 	// Run metrics loop
 	go func() {
-		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), scrubCollector, bondReductionCollector, soloMigrationCollector)
+		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), scrubCollector, bondReductionCollector, soloMigrationCollector, consensusCollector, runner)
 		if err != nil {
 			errorLog.Println(err)
 		}
@@ -1,8 +1,11 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/fatih/color"
@@ -15,14 +18,10 @@ import (
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
-// This is a proxy for multiple Beacon clients, providing natural fallback support if one of them fails.
+// This is a proxy for a pool of Beacon clients, sending requests to the healthiest one and providing natural fallback support if any of them fail.
 type BeaconClientManager struct {
-	primaryBc       beacon.Client
-	fallbackBc      beacon.Client
-	logger          log.ColorLogger
-	primaryReady    bool
-	fallbackReady   bool
-	ignoreSyncCheck bool
+	bcs  []beacon.Client
+	pool *clientPool
 }
 
 // This is a signature for a wrapped Beacon client function that only returns an error
@@ -57,34 +56,38 @@ func NewBeaconClientManager(cfg *config.RocketPoolConfig) (*BeaconClientManager,
 		return nil, fmt.Errorf("Unknown Consensus client mode '%v'", cfg.ConsensusClientMode.Value)
 	}
 
-	// Fallback CC
-	var fallbackProvider string
+	// Fallback CCs
+	providers := []string{primaryProvider}
 	if cfg.UseFallbackClients.Value == true {
+		var fallbackProvider string
+		var additionalProviders string
 		if cfg.IsNativeMode {
 			fallbackProvider = cfg.FallbackNormal.CcHttpUrl.Value.(string)
+			additionalProviders = cfg.FallbackNormal.AdditionalCcHttpUrls.Value.(string)
 		} else {
 			switch selectedCC {
 			case cfgtypes.ConsensusClient_Prysm:
 				fallbackProvider = cfg.FallbackPrysm.CcHttpUrl.Value.(string)
+				additionalProviders = cfg.FallbackPrysm.AdditionalCcHttpUrls.Value.(string)
 			default:
 				fallbackProvider = cfg.FallbackNormal.CcHttpUrl.Value.(string)
+				additionalProviders = cfg.FallbackNormal.AdditionalCcHttpUrls.Value.(string)
 			}
 		}
+		if fallbackProvider != "" {
+			providers = append(providers, fallbackProvider)
+		}
+		providers = append(providers, splitUrls(additionalProviders)...)
 	}
 
-	var primaryBc beacon.Client
-	var fallbackBc beacon.Client
-	primaryBc = client.NewStandardHttpClient(primaryProvider)
-	if fallbackProvider != "" {
-		fallbackBc = client.NewStandardHttpClient(fallbackProvider)
+	bcs := make([]beacon.Client, len(providers))
+	for i, provider := range providers {
+		bcs[i] = client.NewStandardHttpClient(provider)
 	}
 
 	return &BeaconClientManager{
-		primaryBc:     primaryBc,
-		fallbackBc:    fallbackBc,
-		logger:        log.NewColorLogger(color.FgHiBlue),
-		primaryReady:  true,
-		fallbackReady: fallbackBc != nil,
+		bcs:  bcs,
+		pool: newClientPool("Beacon", providers, MaxBcHeadLag, log.NewColorLogger(color.FgHiBlue)),
 	}, nil
 
 }
@@ -236,6 +239,28 @@ func (m *BeaconClientManager) GetValidatorProposerDuties(indices []string, epoch
 	return result.(map[string]uint64), nil
 }
 
+// Get the slots that validators are scheduled to propose in an epoch
+func (m *BeaconClientManager) GetValidatorProposerSlots(indices []string, epoch uint64) (map[string][]uint64, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetValidatorProposerSlots(indices, epoch)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(map[string][]uint64), nil
+}
+
+// Get the positions of validators in the sync committee for an epoch
+func (m *BeaconClientManager) GetValidatorSyncCommitteePositions(indices []string, epoch uint64) (map[string][]uint64, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetValidatorSyncCommitteePositions(indices, epoch)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(map[string][]uint64), nil
+}
+
 // Get the Beacon chain's domain data
 func (m *BeaconClientManager) GetDomainData(domainType []byte, epoch uint64, useGenesisFork bool) ([]byte, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
@@ -247,6 +272,17 @@ func (m *BeaconClientManager) GetDomainData(domainType []byte, epoch uint64, use
 	return result.([]byte), nil
 }
 
+// Get the fork info for a state
+func (m *BeaconClientManager) GetFork(stateId string) (beacon.Fork, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetFork(stateId)
+	})
+	if err != nil {
+		return beacon.Fork{}, err
+	}
+	return result.(beacon.Fork), nil
+}
+
 // Voluntarily exit a validator
 func (m *BeaconClientManager) ExitValidator(validatorIndex string, epoch uint64, signature types.ValidatorSignature) error {
 	err := m.runFunction0(func(client beacon.Client) error {
@@ -296,55 +332,66 @@ func (m *BeaconClientManager) ChangeWithdrawalCredentials(validatorIndex string,
 	return nil
 }
 
+// Subscribe to the Beacon chain's event stream
+func (m *BeaconClientManager) SubscribeToEvents(ctx context.Context, topics []beacon.EventTopic) (<-chan beacon.BeaconEvent, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.SubscribeToEvents(ctx, topics)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(<-chan beacon.BeaconEvent), nil
+}
+
 /// ==================
 /// Internal Functions
 /// ==================
 
 func (m *BeaconClientManager) CheckStatus() *api.ClientManagerStatus {
 
-	status := &api.ClientManagerStatus{
-		FallbackEnabled: m.fallbackBc != nil,
-	}
-
 	// Ignore the sync check and just use the predefined settings if requested
-	if m.ignoreSyncCheck {
-		status.PrimaryClientStatus.IsWorking = m.primaryReady
-		status.PrimaryClientStatus.IsSynced = m.primaryReady
-		if status.FallbackEnabled {
-			status.FallbackClientStatus.IsWorking = m.fallbackReady
-			status.FallbackClientStatus.IsSynced = m.fallbackReady
-		}
-		return status
+	if m.pool.ignoreSyncCheck {
+		return m.pool.getIgnoredSyncStatus()
 	}
 
-	// Get the primary BC status
-	status.PrimaryClientStatus = checkBcStatus(m.primaryBc)
-
-	// Get the fallback BC status if applicable
-	if status.FallbackEnabled {
-		status.FallbackClientStatus = checkBcStatus(m.fallbackBc)
+	// Check all of the clients at once
+	statuses := make([]api.ClientStatus, len(m.bcs))
+	heads := make([]uint64, len(m.bcs))
+	latencies := make([]time.Duration, len(m.bcs))
+	var wg sync.WaitGroup
+	for i := range m.bcs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			start := time.Now()
+			statuses[i], heads[i] = checkBcStatus(m.bcs[i])
+			latencies[i] = time.Since(start)
+		}(i)
 	}
+	wg.Wait()
 
-	// Flag the ready clients
-	m.primaryReady = (status.PrimaryClientStatus.IsWorking && status.PrimaryClientStatus.IsSynced)
-	m.fallbackReady = (status.FallbackEnabled && status.FallbackClientStatus.IsWorking && status.FallbackClientStatus.IsSynced)
+	m.pool.updateStatus(statuses, heads, latencies)
+	return m.pool.getStatus()
 
-	return status
+}
 
+// Get the status of every client in the pool based on the latest checks and requests, without contacting them
+func (m *BeaconClientManager) GetPoolStatus() *api.ClientManagerStatus {
+	return m.pool.getStatus()
 }
 
-// Check the client status
-func checkBcStatus(client beacon.Client) api.ClientStatus {
+// Check the client status and get its head slot
+func checkBcStatus(client beacon.Client) (api.ClientStatus, uint64) {
 
 	status := api.ClientStatus{}
 
-	// Get the fallback's sync progress
+	// Get the client's sync progress
 	syncStatus, err := client.GetSyncStatus()
 	if err != nil {
 		status.Error = fmt.Sprintf("Sync progress check failed with [%s]", err.Error())
 		status.IsSynced = false
 		status.IsWorking = false
-		return status
+		return status, 0
 	}
 
 	// Return the sync status
@@ -357,133 +404,56 @@ func checkBcStatus(client beacon.Client) api.ClientStatus {
 		status.IsSynced = false
 		status.SyncProgress = syncStatus.Progress
 	}
-	return status
+	return status, syncStatus.HeadSlot
 
 }
 
-// Attempts to run a function progressively through each client until one succeeds or they all fail.
+// Attempts to run a function on each client in order of health until one succeeds or they all fail.
 func (m *BeaconClientManager) runFunction0(function bcFunction0) error {
-
-	// Check if we can use the primary
-	if m.primaryReady {
-		// Try to run the function on the primary
-		err := function(m.primaryBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
-				m.primaryReady = false
-				return m.runFunction0(function)
-			}
-			// If it's a different error, just return it
-			return err
-		}
-		// If there's no error, return the result
-		return nil
-	}
-
-	if m.fallbackReady {
-		// Try to run the function on the fallback
-		err := function(m.fallbackBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
-				m.fallbackReady = false
-				return fmt.Errorf("all Beacon clients failed")
-			}
-
-			// If it's a different error, just return it
-			return err
-		}
-		// If there's no error, return the result
-		return nil
-	}
-
-	return fmt.Errorf("no Beacon clients were ready")
+	_, _, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
+		return nil, nil, function(client)
+	})
+	return err
 }
 
-// Attempts to run a function progressively through each client until one succeeds or they all fail.
+// Attempts to run a function on each client in order of health until one succeeds or they all fail.
 func (m *BeaconClientManager) runFunction1(function bcFunction1) (interface{}, error) {
-
-	// Check if we can use the primary
-	if m.primaryReady {
-		// Try to run the function on the primary
-		result, err := function(m.primaryBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
-				m.primaryReady = false
-				return m.runFunction1(function)
-			}
-			// If it's a different error, just return it
-			return nil, err
-		}
-		// If there's no error, return the result
-		return result, nil
-	}
-
-	if m.fallbackReady {
-		// Try to run the function on the fallback
-		result, err := function(m.fallbackBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
-				m.fallbackReady = false
-				return nil, fmt.Errorf("all Beacon clients failed")
-			}
-			// If it's a different error, just return it
-			return nil, err
-		}
-		// If there's no error, return the result
-		return result, nil
-	}
-
-	return nil, fmt.Errorf("no Beacon clients were ready")
-
+	result, _, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
+		result, err := function(client)
+		return result, nil, err
+	})
+	return result, err
 }
 
-// Attempts to run a function progressively through each client until one succeeds or they all fail.
+// Attempts to run a function on each client in order of health until one succeeds or they all fail.
 func (m *BeaconClientManager) runFunction2(function bcFunction2) (interface{}, interface{}, error) {
 
-	// Check if we can use the primary
-	if m.primaryReady {
-		// Try to run the function on the primary
-		result1, result2, err := function(m.primaryBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
-				m.primaryReady = false
-				return m.runFunction2(function)
-			}
-			// If it's a different error, just return it
-			return nil, nil, err
-		}
-		// If there's no error, return the result
-		return result1, result2, nil
+	order := m.pool.getOrder()
+	if len(order) == 0 {
+		return nil, nil, fmt.Errorf("no Beacon clients were ready")
 	}
 
-	if m.fallbackReady {
-		// Try to run the function on the fallback
-		result1, result2, err := function(m.fallbackBc)
+	for _, i := range order {
+		start := time.Now()
+		result1, result2, err := function(m.bcs[i])
 		if err != nil {
 			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
-				m.fallbackReady = false
-				return nil, nil, fmt.Errorf("all Beacon clients failed")
+				// If it's disconnected, log it and try the next one
+				m.pool.recordDisconnect(i, err)
+				continue
 			}
-			// If it's a different error, just return it
+
+			// If it's a different error, the client still responded so just return it
+			m.pool.recordSuccess(i, time.Since(start))
 			return nil, nil, err
 		}
+
 		// If there's no error, return the result
+		m.pool.recordSuccess(i, time.Since(start))
 		return result1, result2, nil
 	}
 
-	return nil, nil, fmt.Errorf("no Beacon clients were ready")
+	return nil, nil, fmt.Errorf("all Beacon clients failed")
 
 }
 
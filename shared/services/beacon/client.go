@@ -1,6 +1,8 @@
 package beacon
 
 import (
+	"context"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/rocket-pool/rocketpool-go/types"
@@ -16,9 +18,11 @@ type ValidatorStatusOptions struct {
 type SyncStatus struct {
 	Syncing  bool
 	Progress float64
+	HeadSlot uint64
 }
 type Eth2Config struct {
 	GenesisForkVersion           []byte
+	CapellaForkVersion           []byte
 	GenesisValidatorsRoot        []byte
 	GenesisEpoch                 uint64
 	GenesisTime                  uint64
@@ -31,6 +35,11 @@ type Eth2DepositContract struct {
 	ChainID uint64
 	Address common.Address
 }
+type Fork struct {
+	PreviousVersion []byte
+	CurrentVersion  []byte
+	Epoch           uint64
+}
 type BeaconHead struct {
 	Epoch                  uint64
 	FinalizedEpoch         uint64
@@ -63,6 +72,8 @@ type BeaconBlock struct {
 	Attestations         []AttestationInfo
 	FeeRecipient         common.Address
 	ExecutionBlockNumber uint64
+	HasSyncAggregate     bool
+	SyncCommitteeBits    bitfield.Bitvector512
 }
 
 // Committees is an interface as an optimization- since committees responses
@@ -112,6 +123,35 @@ const (
 	Unknown
 )
 
+// Beacon chain event stream topics (https://ethereum.github.io/beacon-APIs/#/Events/eventstream)
+type EventTopic string
+
+const (
+	EventTopic_Head                EventTopic = "head"
+	EventTopic_FinalizedCheckpoint EventTopic = "finalized_checkpoint"
+	EventTopic_ChainReorg          EventTopic = "chain_reorg"
+)
+
+// An event received from the Beacon node's event stream
+type BeaconEvent struct {
+	Topic EventTopic
+
+	// The slot of the new head (head and chain_reorg only)
+	Slot uint64
+
+	// The epoch of the checkpoint or reorg (finalized_checkpoint and chain_reorg only)
+	Epoch uint64
+
+	// The root of the new head or finalized block
+	Block common.Hash
+
+	// True if this head is the first slot of a new epoch (head only)
+	EpochTransition bool
+
+	// The number of slots that were reorged (chain_reorg only)
+	Depth uint64
+}
+
 type ValidatorState string
 
 const (
@@ -141,10 +181,14 @@ type Client interface {
 	GetValidatorIndex(pubkey types.ValidatorPubkey) (string, error)
 	GetValidatorSyncDuties(indices []string, epoch uint64) (map[string]bool, error)
 	GetValidatorProposerDuties(indices []string, epoch uint64) (map[string]uint64, error)
+	GetValidatorProposerSlots(indices []string, epoch uint64) (map[string][]uint64, error)
+	GetValidatorSyncCommitteePositions(indices []string, epoch uint64) (map[string][]uint64, error)
 	GetDomainData(domainType []byte, epoch uint64, useGenesisFork bool) ([]byte, error)
+	GetFork(stateId string) (Fork, error)
 	ExitValidator(validatorIndex string, epoch uint64, signature types.ValidatorSignature) error
 	Close() error
 	GetEth1DataForEth2Block(blockId string) (Eth1Data, bool, error)
 	GetCommitteesForEpoch(epoch *uint64) (Committees, error)
 	ChangeWithdrawalCredentials(validatorIndex string, fromBlsPubkey types.ValidatorPubkey, toExecutionAddress common.Address, signature types.ValidatorSignature) error
+	SubscribeToEvents(ctx context.Context, topics []EventTopic) (<-chan BeaconEvent, error)
 }
@@ -1,7 +1,9 @@
 package client
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -13,6 +15,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/goccy/go-json"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
 	"github.com/rocket-pool/rocketpool-go/types"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
@@ -42,6 +45,7 @@ const (
 	RequestValidatorSyncDuties             = "/eth/v1/validator/duties/sync/%s"
 	RequestValidatorProposerDuties         = "/eth/v1/validator/duties/proposer/%s"
 	RequestWithdrawalCredentialsChangePath = "/eth/v1/beacon/pool/bls_to_execution_changes"
+	RequestEventsPath                      = "/eth/v1/events?topics=%s"
 
 	MaxRequestValidatorsCount     = 600
 	threadLimit               int = 12
@@ -85,6 +89,7 @@ func (c *StandardHttpClient) GetSyncStatus() (beacon.SyncStatus, error) {
 	return beacon.SyncStatus{
 		Syncing:  syncStatus.Data.IsSyncing,
 		Progress: progress,
+		HeadSlot: uint64(syncStatus.Data.HeadSlot),
 	}, nil
 
 }
@@ -119,6 +124,7 @@ func (c *StandardHttpClient) GetEth2Config() (beacon.Eth2Config, error) {
 	// Return response
 	return beacon.Eth2Config{
 		GenesisForkVersion:           genesis.Data.GenesisForkVersion,
+		CapellaForkVersion:           eth2Config.Data.CapellaForkVersion,
 		GenesisValidatorsRoot:        genesis.Data.GenesisValidatorsRoot,
 		GenesisEpoch:                 0,
 		GenesisTime:                  uint64(genesis.Data.GenesisTime),
@@ -367,6 +373,74 @@ func (c *StandardHttpClient) GetValidatorProposerDuties(indices []string, epoch
 	return proposerMap, nil
 }
 
+// Get the slots that each of the given validators is scheduled to propose in the given epoch
+func (c *StandardHttpClient) GetValidatorProposerSlots(indices []string, epoch uint64) (map[string][]uint64, error) {
+
+	// Perform the request
+	responseBody, status, err := c.getRequest(fmt.Sprintf(RequestValidatorProposerDuties, strconv.FormatUint(epoch, 10)))
+	if err != nil {
+		return nil, fmt.Errorf("Could not get validator proposer duties: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Could not get validator proposer duties: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+
+	var response ProposerDutiesResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("Could not decode validator proposer duties data: %w", err)
+	}
+
+	// Map the results
+	slotMap := make(map[string][]uint64, len(indices))
+	for _, index := range indices {
+		slotMap[index] = []uint64{}
+	}
+	for _, duty := range response.Data {
+		slots, exists := slotMap[duty.ValidatorIndex]
+		if exists {
+			slotMap[duty.ValidatorIndex] = append(slots, uint64(duty.Slot))
+		}
+	}
+
+	return slotMap, nil
+}
+
+// Get the positions of each of the given validators in the sync committee for the given epoch; validators that aren't in it have no positions
+func (c *StandardHttpClient) GetValidatorSyncCommitteePositions(indices []string, epoch uint64) (map[string][]uint64, error) {
+
+	// Perform the post request
+	responseBody, status, err := c.postRequest(fmt.Sprintf(RequestValidatorSyncDuties, strconv.FormatUint(epoch, 10)), indices)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get validator sync duties: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Could not get validator sync duties: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+
+	var response SyncDutiesResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("Could not decode validator sync duties data: %w", err)
+	}
+
+	// Map the results
+	positionMap := make(map[string][]uint64, len(indices))
+	for _, index := range indices {
+		positionMap[index] = []uint64{}
+	}
+	for _, duty := range response.Data {
+		positions, exists := positionMap[duty.ValidatorIndex]
+		if !exists {
+			continue
+		}
+		for _, position := range duty.SyncCommitteeIndices {
+			positions = append(positions, uint64(position))
+		}
+		positionMap[duty.ValidatorIndex] = positions
+	}
+
+	return positionMap, nil
+}
+
 // Get a validator's index
 func (c *StandardHttpClient) GetValidatorIndex(pubkey types.ValidatorPubkey) (string, error) {
 
@@ -386,6 +460,19 @@ func (c *StandardHttpClient) GetValidatorIndex(pubkey types.ValidatorPubkey) (st
 
 }
 
+// Get the fork info for a state
+func (c *StandardHttpClient) GetFork(stateId string) (beacon.Fork, error) {
+	fork, err := c.getFork(stateId)
+	if err != nil {
+		return beacon.Fork{}, err
+	}
+	return beacon.Fork{
+		PreviousVersion: fork.Data.PreviousVersion,
+		CurrentVersion:  fork.Data.CurrentVersion,
+		Epoch:           uint64(fork.Data.Epoch),
+	}, nil
+}
+
 // Get domain data for a domain type at a given epoch
 func (c *StandardHttpClient) GetDomainData(domainType []byte, epoch uint64, useGenesisFork bool) ([]byte, error) {
 
@@ -509,6 +596,12 @@ func (c *StandardHttpClient) GetBeaconBlock(blockId string) (beacon.BeaconBlock,
 		beaconBlock.ExecutionBlockNumber = uint64(block.Data.Message.Body.ExecutionPayload.BlockNumber)
 	}
 
+	// Sync aggregates only exist after Altair
+	if block.Data.Message.Body.SyncAggregate != nil {
+		beaconBlock.HasSyncAggregate = true
+		beaconBlock.SyncCommitteeBits = bitfield.Bitvector512(block.Data.Message.Body.SyncAggregate.SyncCommitteeBits)
+	}
+
 	// Add attestation info
 	for i, attestation := range block.Data.Message.Body.Attestations {
 		bitString := hexutil.RemovePrefix(attestation.AggregationBits)
@@ -548,6 +641,97 @@ func (c *StandardHttpClient) ChangeWithdrawalCredentials(validatorIndex string,
 	})
 }
 
+// Subscribe to the Beacon node's event stream for the provided topics.
+// The returned channel is closed when the stream ends or the context is cancelled.
+func (c *StandardHttpClient) SubscribeToEvents(ctx context.Context, topics []beacon.EventTopic) (<-chan beacon.BeaconEvent, error) {
+	topicStrings := make([]string, len(topics))
+	for i, topic := range topics {
+		topicStrings[i] = string(topic)
+	}
+
+	// Open the stream
+	reader, err := c.getEventStream(ctx, strings.Join(topicStrings, ","))
+	if err != nil {
+		return nil, err
+	}
+
+	// Read events until the stream closes
+	events := make(chan beacon.BeaconEvent)
+	go func() {
+		defer close(events)
+		defer reader.Close()
+
+		scanner := bufio.NewScanner(reader)
+		var eventName string
+		var dataLines []string
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "":
+				// A blank line terminates the event; per the SSE spec, its data lines are joined with newlines
+				if eventName != "" && len(dataLines) > 0 {
+					event, err := parseEvent(beacon.EventTopic(eventName), []byte(strings.Join(dataLines, "\n")))
+					if err == nil {
+						select {
+						case events <- event:
+						case <-ctx.Done():
+							return
+						}
+					}
+				}
+				eventName = ""
+				dataLines = nil
+			case strings.HasPrefix(line, "event:"):
+				eventName = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+			case strings.HasPrefix(line, "data:"):
+				// Only a single leading space is part of the field separator
+				dataLines = append(dataLines, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// Convert the data of an event stream message into a Beacon event
+func parseEvent(topic beacon.EventTopic, data []byte) (beacon.BeaconEvent, error) {
+	event := beacon.BeaconEvent{
+		Topic: topic,
+	}
+	switch topic {
+	case beacon.EventTopic_Head:
+		var head HeadEvent
+		if err := json.Unmarshal(data, &head); err != nil {
+			return beacon.BeaconEvent{}, fmt.Errorf("Could not decode head event: %w", err)
+		}
+		event.Slot = uint64(head.Slot)
+		event.Block = head.Block
+		event.EpochTransition = head.EpochTransition
+
+	case beacon.EventTopic_FinalizedCheckpoint:
+		var checkpoint FinalizedCheckpointEvent
+		if err := json.Unmarshal(data, &checkpoint); err != nil {
+			return beacon.BeaconEvent{}, fmt.Errorf("Could not decode finalized checkpoint event: %w", err)
+		}
+		event.Epoch = uint64(checkpoint.Epoch)
+		event.Block = checkpoint.Block
+
+	case beacon.EventTopic_ChainReorg:
+		var reorg ChainReorgEvent
+		if err := json.Unmarshal(data, &reorg); err != nil {
+			return beacon.BeaconEvent{}, fmt.Errorf("Could not decode chain reorg event: %w", err)
+		}
+		event.Slot = uint64(reorg.Slot)
+		event.Epoch = uint64(reorg.Epoch)
+		event.Block = reorg.NewHeadBlock
+		event.Depth = uint64(reorg.Depth)
+
+	default:
+		return beacon.BeaconEvent{}, fmt.Errorf("Unsupported event topic '%s'", topic)
+	}
+	return event, nil
+}
+
 // Get sync status
 func (c *StandardHttpClient) getSyncStatus() (SyncStatusResponse, error) {
 	responseBody, status, err := c.getRequest(RequestSyncStatusPath)
@@ -864,6 +1048,26 @@ func (c *StandardHttpClient) postWithdrawalCredentialsChange(request BLSToExecut
 	return nil
 }
 
+// Open a connection to the event stream; the caller is responsible for closing the returned reader
+func (c *StandardHttpClient) getEventStream(ctx context.Context, topics string) (io.ReadCloser, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(RequestUrlFormat, c.providerAddress, fmt.Sprintf(RequestEventsPath, topics)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("Could not create event stream request: %w", err)
+	}
+	request.Header.Set("Accept", "text/event-stream")
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("Could not subscribe to events: %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		return nil, fmt.Errorf("Could not subscribe to events: HTTP status %d; response body: '%s'", response.StatusCode, string(body))
+	}
+	return response.Body, nil
+}
+
 // Make a GET request but do not read its body yet (allows buffered decoding)
 func (c *StandardHttpClient) getRequestReader(requestPath string) (io.ReadCloser, int, error) {
 
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+const testBlock = "0x9a2fefd2fdb57f74993c7780ea5b9030d2897b615b89f808011ca5aebed54eaf"
+
+// Serve a fixed set of server-sent events from a stand-in Beacon node
+func newEventServer(t *testing.T, stream string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/eth/v1/events" {
+			t.Errorf("unexpected request path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if accept := r.Header.Get("Accept"); accept != "text/event-stream" {
+			t.Errorf("unexpected Accept header %s", accept)
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, stream)
+	}))
+}
+
+// Read every event from the subscription until the stream closes
+func readEvents(t *testing.T, events <-chan beacon.BeaconEvent) []beacon.BeaconEvent {
+	received := []beacon.BeaconEvent{}
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return received
+			}
+			received = append(received, event)
+		case <-timeout:
+			t.Fatal("timed out waiting for the event stream to close")
+		}
+	}
+}
+
+func TestSubscribeToEvents(t *testing.T) {
+	stream := ": a comment line\n\n" +
+		"event: head\n" +
+		"data: {\"slot\":\"64\",\"block\":\"" + testBlock + "\",\"epoch_transition\":true}\n\n" +
+		"event: finalized_checkpoint\n" +
+		"data: {\"block\":\"" + testBlock + "\",\n" +
+		"data: \"epoch\":\"2\"}\n\n" +
+		"event: chain_reorg\n" +
+		"data: {\"slot\":\"70\",\"depth\":\"3\",\"new_head_block\":\"" + testBlock + "\",\"epoch\":\"2\"}\n\n" +
+		"event: head\n" +
+		"data: not json\n\n"
+	server := newEventServer(t, stream)
+	defer server.Close()
+
+	client := NewStandardHttpClient(server.URL)
+	events, err := client.SubscribeToEvents(context.Background(), []beacon.EventTopic{beacon.EventTopic_Head, beacon.EventTopic_FinalizedCheckpoint, beacon.EventTopic_ChainReorg})
+	if err != nil {
+		t.Fatalf("error subscribing to events: %s", err.Error())
+	}
+
+	block := common.HexToHash(testBlock)
+	expected := []beacon.BeaconEvent{
+		{Topic: beacon.EventTopic_Head, Slot: 64, Block: block, EpochTransition: true},
+		{Topic: beacon.EventTopic_FinalizedCheckpoint, Epoch: 2, Block: block},
+		{Topic: beacon.EventTopic_ChainReorg, Slot: 70, Epoch: 2, Block: block, Depth: 3},
+	}
+	received := readEvents(t, events)
+	if len(received) != len(expected) {
+		t.Fatalf("expected %d events, got %d: %+v", len(expected), len(received), received)
+	}
+	for i := range expected {
+		if received[i] != expected[i] {
+			t.Errorf("event %d: expected %+v, got %+v", i, expected[i], received[i])
+		}
+	}
+}
+
+func TestSubscribeToEventsHttpError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewStandardHttpClient(server.URL)
+	if _, err := client.SubscribeToEvents(context.Background(), []beacon.EventTopic{beacon.EventTopic_Head}); err == nil {
+		t.Fatal("expected an error when the Beacon node rejects the subscription")
+	}
+}
+
+func TestParseEventMultiLineData(t *testing.T) {
+	// Data lines are joined with newlines, which are whitespace to the JSON decoder
+	event, err := parseEvent(beacon.EventTopic_Head, []byte("{\"slot\":\n\"32\",\n\"block\":\""+testBlock+"\",\n\"epoch_transition\":false}"))
+	if err != nil {
+		t.Fatalf("error parsing event: %s", err.Error())
+	}
+	if event.Slot != 32 || event.EpochTransition {
+		t.Errorf("unexpected event %+v", event)
+	}
+}
+
+func TestParseEventUnsupportedTopic(t *testing.T) {
+	if _, err := parseEvent(beacon.EventTopic("block"), []byte("{}")); err == nil {
+		t.Fatal("expected an error for an unsupported topic")
+	}
+}
@@ -39,9 +39,10 @@ type SyncStatusResponse struct {
 }
 type Eth2ConfigResponse struct {
 	Data struct {
-		SecondsPerSlot               uinteger `json:"SECONDS_PER_SLOT"`
-		SlotsPerEpoch                uinteger `json:"SLOTS_PER_EPOCH"`
-		EpochsPerSyncCommitteePeriod uinteger `json:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+		SecondsPerSlot               uinteger  `json:"SECONDS_PER_SLOT"`
+		SlotsPerEpoch                uinteger  `json:"SLOTS_PER_EPOCH"`
+		EpochsPerSyncCommitteePeriod uinteger  `json:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+		CapellaForkVersion           byteArray `json:"CAPELLA_FORK_VERSION"`
 	} `json:"data"`
 }
 type Eth2DepositContractResponse struct {
@@ -96,6 +97,9 @@ type BeaconBlockResponse struct {
 					FeeRecipient byteArray `json:"fee_recipient"`
 					BlockNumber  uinteger  `json:"block_number"`
 				} `json:"execution_payload"`
+				SyncAggregate *struct {
+					SyncCommitteeBits byteArray `json:"sync_committee_bits"`
+				} `json:"sync_aggregate"`
 			} `json:"body"`
 		} `json:"message"`
 	} `json:"data"`
@@ -130,7 +134,8 @@ type ProposerDutiesResponse struct {
 	Data []ProposerDuty `json:"data"`
 }
 type ProposerDuty struct {
-	ValidatorIndex string `json:"validator_index"`
+	ValidatorIndex string   `json:"validator_index"`
+	Slot           uinteger `json:"slot"`
 }
 
 type CommitteesResponse struct {
@@ -145,6 +150,23 @@ type Attestation struct {
 	} `json:"data"`
 }
 
+// Event stream types
+type HeadEvent struct {
+	Slot            uinteger    `json:"slot"`
+	Block           common.Hash `json:"block"`
+	EpochTransition bool        `json:"epoch_transition"`
+}
+type FinalizedCheckpointEvent struct {
+	Block common.Hash `json:"block"`
+	Epoch uinteger    `json:"epoch"`
+}
+type ChainReorgEvent struct {
+	Slot         uinteger    `json:"slot"`
+	Depth        uinteger    `json:"depth"`
+	NewHeadBlock common.Hash `json:"new_head_block"`
+	Epoch        uinteger    `json:"epoch"`
+}
+
 // Unsigned integer type
 type uinteger uint64
 
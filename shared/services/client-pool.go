@@ -0,0 +1,314 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Settings
+const (
+	// How many blocks an Execution client can fall behind the best one in the pool before requests stop going to it
+	MaxEcHeadLag uint64 = 3
+
+	// How many slots a Beacon client can fall behind the best one in the pool before requests stop going to it
+	MaxBcHeadLag uint64 = 2
+
+	// How much weight each new sample gets in the latency and error rate moving averages
+	healthSampleWeight float64 = 0.2
+
+	// Score penalties; a perfectly healthy client scores 100
+	maxLatencyPenalty float64 = 30
+	latencyPenaltyMs  float64 = 50 // One point per this many milliseconds of latency
+	maxLagPenalty     float64 = 40
+	lagPenaltyPerUnit float64 = 10 // Points per block / slot behind the best client
+	maxErrorPenalty   float64 = 30
+
+	// How much better another client has to score before requests move away from the active one
+	scoreHysteresis float64 = 5
+)
+
+// The health of a single client in a pool
+type clientHealth struct {
+	endpoint  string
+	status    api.ClientStatus
+	ready     bool
+	head      uint64
+	headLag   uint64
+	lagging   bool
+	latency   float64 // Moving average, in milliseconds
+	errorRate float64 // Moving average of the fraction of failed requests
+	sampled   bool
+}
+
+// Tracks the health of every client a manager can use, and decides which of them requests should go to
+type clientPool struct {
+	clientType      string
+	maxHeadLag      uint64
+	clients         []*clientHealth
+	activeIndex     int
+	ignoreSyncCheck bool
+	logger          log.ColorLogger
+	lock            sync.Mutex
+}
+
+// Create a new pool for the given client URLs; the first one is treated as the primary
+func newClientPool(clientType string, urls []string, maxHeadLag uint64, logger log.ColorLogger) *clientPool {
+	pool := &clientPool{
+		clientType:  clientType,
+		maxHeadLag:  maxHeadLag,
+		clients:     make([]*clientHealth, len(urls)),
+		activeIndex: -1,
+		logger:      logger,
+	}
+	for i, clientUrl := range urls {
+		pool.clients[i] = &clientHealth{
+			endpoint: getEndpointName(clientUrl),
+			ready:    true,
+		}
+	}
+	return pool
+}
+
+// Get the order clients should be tried in: healthy ones by score, then lagging ones by score
+func (p *clientPool) getOrder() []int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	healthy := []int{}
+	lagging := []int{}
+	for i, client := range p.clients {
+		if !client.ready {
+			continue
+		}
+		if client.lagging {
+			lagging = append(lagging, i)
+		} else {
+			healthy = append(healthy, i)
+		}
+	}
+	p.sortByScore(healthy)
+	p.sortByScore(lagging)
+
+	// Stick with the active client unless another one is clearly better, so small latency changes don't bounce requests around
+	for position, i := range healthy {
+		if i != p.activeIndex || position == 0 {
+			continue
+		}
+		if p.clients[healthy[0]].getScore()-p.clients[i].getScore() < scoreHysteresis {
+			copy(healthy[1:position+1], healthy[0:position])
+			healthy[0] = i
+		}
+		break
+	}
+	return append(healthy, lagging...)
+}
+
+// Record a request that a client answered, updating its latency and marking it as the active client
+func (p *clientPool) recordSuccess(index int, latency time.Duration) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	client := p.clients[index]
+	client.recordSample(float64(latency.Milliseconds()), false)
+	if p.activeIndex != index {
+		if p.activeIndex != -1 {
+			p.logger.Printlnf("NOTE: switching to %s client %s.", p.clientType, client.endpoint)
+		}
+		p.activeIndex = index
+	}
+}
+
+// Record a request that a client failed to answer because it couldn't be reached, and take it out of rotation until its next status check
+func (p *clientPool) recordDisconnect(index int, err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	client := p.clients[index]
+	client.recordSample(client.latency, true)
+	client.ready = false
+	client.status.Error = err.Error()
+	p.logger.Printlnf("WARNING: %s client %s disconnected (%s), trying the next one...", p.clientType, client.endpoint, err.Error())
+}
+
+// Check if the primary client can take requests
+func (p *clientPool) isPrimaryReady() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.clients[0].ready
+}
+
+// Take the primary client out of rotation, so requests go to the others
+func (p *clientPool) disablePrimary() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.clients[0].ready = false
+}
+
+// Update the pool with the results of a status check on every client.
+// Heads are only meaningful for clients that are working; lag is measured against the best head in the pool.
+func (p *clientPool) updateStatus(statuses []api.ClientStatus, heads []uint64, latencies []time.Duration) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	// Find the best head
+	bestHead := uint64(0)
+	for i, status := range statuses {
+		if status.IsWorking && heads[i] > bestHead {
+			bestHead = heads[i]
+		}
+	}
+
+	for i, client := range p.clients {
+		status := statuses[i]
+		client.status = status
+		client.recordSample(float64(latencies[i].Milliseconds()), !status.IsWorking)
+		client.ready = status.IsWorking && status.IsSynced
+		if !status.IsWorking {
+			client.head = 0
+			client.headLag = 0
+			client.lagging = false
+			continue
+		}
+
+		client.head = heads[i]
+		client.headLag = bestHead - heads[i]
+		wasLagging := client.lagging
+		client.lagging = client.headLag > p.maxHeadLag
+		if client.lagging && !wasLagging && client.ready {
+			p.logger.Printlnf("WARNING: %s client %s is %d behind the best client in the pool, requests will go to other clients until it catches up.", p.clientType, client.endpoint, client.headLag)
+		}
+	}
+}
+
+// Get the status of the pool, based on the latest status checks and requests
+func (p *clientPool) getStatus() *api.ClientManagerStatus {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	status := &api.ClientManagerStatus{
+		FallbackEnabled: len(p.clients) > 1,
+		Endpoints:       make([]api.ClientEndpointStatus, len(p.clients)),
+	}
+	for i, client := range p.clients {
+		status.Endpoints[i] = api.ClientEndpointStatus{
+			Endpoint:  client.endpoint,
+			Status:    client.status,
+			Head:      client.head,
+			HeadLag:   client.headLag,
+			IsLagging: client.lagging,
+			LatencyMs: client.latency,
+			ErrorRate: client.errorRate,
+			Score:     client.getScore(),
+			IsActive:  i == p.activeIndex,
+		}
+	}
+
+	// Report the best of the other clients as the fallback
+	status.PrimaryClientStatus = p.clients[0].status
+	if status.FallbackEnabled {
+		others := []int{}
+		for i := 1; i < len(p.clients); i++ {
+			others = append(others, i)
+		}
+		p.sortByScore(others)
+		best := others[0]
+		for _, i := range others {
+			if p.clients[i].ready {
+				best = i
+				break
+			}
+		}
+		status.FallbackClientStatus = p.clients[best].status
+	}
+	return status
+}
+
+// Get the status to report when sync checks are being ignored, based on the predefined readiness of each client
+func (p *clientPool) getIgnoredSyncStatus() *api.ClientManagerStatus {
+	p.lock.Lock()
+	for _, client := range p.clients {
+		client.status.IsWorking = client.ready
+		client.status.IsSynced = client.ready
+	}
+	p.lock.Unlock()
+	return p.getStatus()
+}
+
+// Get a summary of why none of the clients are ready
+func (p *clientPool) getErrorSummary() string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	errors := make([]string, len(p.clients))
+	for i, client := range p.clients {
+		if client.status.Error != "" {
+			errors[i] = fmt.Sprintf("%s: %s", client.endpoint, client.status.Error)
+		} else {
+			errors[i] = fmt.Sprintf("%s: syncing (%.2f%%)", client.endpoint, client.status.SyncProgress*100)
+		}
+	}
+	return strings.Join(errors, "; ")
+}
+
+// Sort client indices by score, best first, keeping the configured order for ties
+func (p *clientPool) sortByScore(indices []int) {
+	sort.SliceStable(indices, func(i, j int) bool {
+		return p.clients[indices[i]].getScore() > p.clients[indices[j]].getScore()
+	})
+}
+
+// Add a latency / error sample to a client's moving averages
+func (h *clientHealth) recordSample(latencyMs float64, failed bool) {
+	errorSample := float64(0)
+	if failed {
+		errorSample = 1
+	}
+	if !h.sampled {
+		h.latency = latencyMs
+		h.errorRate = errorSample
+		h.sampled = true
+		return
+	}
+	h.latency = (1-healthSampleWeight)*h.latency + healthSampleWeight*latencyMs
+	h.errorRate = (1-healthSampleWeight)*h.errorRate + healthSampleWeight*errorSample
+}
+
+// Get the client's health score from 0 to 100, based on its latency, how far its head is behind the best client, and its error rate
+func (h *clientHealth) getScore() float64 {
+	if !h.ready {
+		return 0
+	}
+	latencyPenalty := math.Min(maxLatencyPenalty, h.latency/latencyPenaltyMs)
+	lagPenalty := math.Min(maxLagPenalty, float64(h.headLag)*lagPenaltyPerUnit)
+	errorPenalty := maxErrorPenalty * h.errorRate
+	return math.Max(0, 100-latencyPenalty-lagPenalty-errorPenalty)
+}
+
+// Get a name for a client that's safe to display and use as a metric label, since URLs can have API keys in their paths or queries
+func getEndpointName(clientUrl string) string {
+	parsedUrl, err := url.Parse(clientUrl)
+	if err != nil || parsedUrl.Host == "" {
+		return "unknown"
+	}
+	return fmt.Sprintf("%s://%s", parsedUrl.Scheme, parsedUrl.Host)
+}
+
+// Split a comma-separated list of URLs, ignoring blank entries
+func splitUrls(urls string) []string {
+	results := []string{}
+	for _, entry := range strings.Split(urls, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry != "" {
+			results = append(results, entry)
+		}
+	}
+	return results
+}
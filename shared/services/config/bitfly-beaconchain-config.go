@@ -38,6 +38,7 @@ func NewBitflyNodeMetricsConfig(cfg *RocketPoolConfig) *BitflyNodeMetricsConfig
 			// ensures the string is 28 characters of Base64
 			Regex:              "^[A-Za-z0-9+/]{28}$",
 			CanBeBlank:         false,
+			Sensitive:          true,
 			OverwriteOnUpgrade: false,
 		},
 
@@ -77,6 +77,7 @@ func NewConsensusCommonConfig(cfg *RocketPoolConfig) *ConsensusCommonConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth2},
 			EnvironmentVariables: []string{"CHECKPOINT_SYNC_URL"},
 			CanBeBlank:           true,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 
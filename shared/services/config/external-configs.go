@@ -129,6 +129,7 @@ func NewExternalExecutionConfig(cfg *RocketPoolConfig) *ExternalExecutionConfig
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Eth2, config.ContainerID_Node, config.ContainerID_Watchtower},
 			EnvironmentVariables: []string{"EC_HTTP_ENDPOINT"},
 			CanBeBlank:           false,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -141,6 +142,7 @@ func NewExternalExecutionConfig(cfg *RocketPoolConfig) *ExternalExecutionConfig
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Eth2, config.ContainerID_Node, config.ContainerID_Watchtower},
 			EnvironmentVariables: []string{"EC_WS_ENDPOINT"},
 			CanBeBlank:           false,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 	}
@@ -160,6 +162,7 @@ func NewExternalLighthouseConfig(cfg *RocketPoolConfig) *ExternalLighthouseConfi
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1, config.ContainerID_Api, config.ContainerID_Validator, config.ContainerID_Watchtower, config.ContainerID_Node},
 			EnvironmentVariables: []string{"CC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -233,6 +236,7 @@ func NewExternalLodestarConfig(cfg *RocketPoolConfig) *ExternalLodestarConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1, config.ContainerID_Api, config.ContainerID_Validator, config.ContainerID_Watchtower, config.ContainerID_Node},
 			EnvironmentVariables: []string{"CC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -307,6 +311,7 @@ func NewExternalNimbusConfig(cfg *RocketPoolConfig) *ExternalNimbusConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1, config.ContainerID_Api, config.ContainerID_Validator, config.ContainerID_Watchtower, config.ContainerID_Node},
 			EnvironmentVariables: []string{"CC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -380,6 +385,7 @@ func NewExternalPrysmConfig(cfg *RocketPoolConfig) *ExternalPrysmConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1, config.ContainerID_Api, config.ContainerID_Validator, config.ContainerID_Watchtower, config.ContainerID_Node},
 			EnvironmentVariables: []string{"CC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -392,6 +398,7 @@ func NewExternalPrysmConfig(cfg *RocketPoolConfig) *ExternalPrysmConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
 			EnvironmentVariables: []string{"CC_RPC_ENDPOINT"},
 			CanBeBlank:           false,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -465,6 +472,7 @@ func NewExternalTekuConfig(cfg *RocketPoolConfig) *ExternalTekuConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1, config.ContainerID_Api, config.ContainerID_Validator, config.ContainerID_Watchtower, config.ContainerID_Node},
 			EnvironmentVariables: []string{"CC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 
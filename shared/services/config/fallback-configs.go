@@ -13,6 +13,12 @@ type FallbackNormalConfig struct {
 
 	// The URL of the Beacon Node HTTP endpoint
 	CcHttpUrl config.Parameter `yaml:"ccHttpUrl,omitempty"`
+
+	// Extra Execution Client HTTP endpoints for the Smartnode's client pool
+	AdditionalEcHttpUrls config.Parameter `yaml:"additionalEcHttpUrls,omitempty"`
+
+	// Extra Beacon Node HTTP endpoints for the Smartnode's client pool
+	AdditionalCcHttpUrls config.Parameter `yaml:"additionalCcHttpUrls,omitempty"`
 }
 
 // Configuration for fallback Prysm
@@ -27,6 +33,12 @@ type FallbackPrysmConfig struct {
 
 	// The URL of the JSON-RPC endpoint for the Validator client
 	JsonRpcUrl config.Parameter `yaml:"jsonRpcUrl,omitempty"`
+
+	// Extra Execution Client HTTP endpoints for the Smartnode's client pool
+	AdditionalEcHttpUrls config.Parameter `yaml:"additionalEcHttpUrls,omitempty"`
+
+	// Extra Beacon Node HTTP endpoints for the Smartnode's client pool
+	AdditionalCcHttpUrls config.Parameter `yaml:"additionalCcHttpUrls,omitempty"`
 }
 
 // Generates a new FallbackNormalConfig configuration
@@ -43,6 +55,7 @@ func NewFallbackNormalConfig(cfg *RocketPoolConfig) *FallbackNormalConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
 			EnvironmentVariables: []string{"FALLBACK_EC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -55,6 +68,33 @@ func NewFallbackNormalConfig(cfg *RocketPoolConfig) *FallbackNormalConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator, config.ContainerID_Watchtower},
 			EnvironmentVariables: []string{"FALLBACK_CC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			Sensitive:            true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AdditionalEcHttpUrls: config.Parameter{
+			ID:                   "additionalEcHttpUrls",
+			Name:                 "Additional Execution Client URLs",
+			Description:          "A comma-separated list of the HTTP API endpoints for any other Execution clients you run. The Smartnode will add them to its pool of clients, along with your primary and fallback ones, and send requests to the healthiest one based on its latency, how far behind the chain head it is, and its error rate.\n\nThese are only used by the Smartnode, not by your Validator Client.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			Sensitive:            true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AdditionalCcHttpUrls: config.Parameter{
+			ID:                   "additionalCcHttpUrls",
+			Name:                 "Additional Beacon Node URLs",
+			Description:          "A comma-separated list of the HTTP Beacon API endpoints for any other Consensus clients you run. The Smartnode will add them to its pool of clients, along with your primary and fallback ones, and send requests to the healthiest one based on its latency, how far behind the chain head it is, and its error rate.\n\nThese are only used by the Smartnode, not by your Validator Client.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 	}
@@ -74,6 +114,7 @@ func NewFallbackPrysmConfig(cfg *RocketPoolConfig) *FallbackPrysmConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
 			EnvironmentVariables: []string{"FALLBACK_EC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -86,6 +127,7 @@ func NewFallbackPrysmConfig(cfg *RocketPoolConfig) *FallbackPrysmConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator, config.ContainerID_Watchtower},
 			EnvironmentVariables: []string{"FALLBACK_CC_API_ENDPOINT"},
 			CanBeBlank:           false,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -98,6 +140,33 @@ func NewFallbackPrysmConfig(cfg *RocketPoolConfig) *FallbackPrysmConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Eth1},
 			EnvironmentVariables: []string{"FALLBACK_CC_RPC_ENDPOINT"},
 			CanBeBlank:           false,
+			Sensitive:            true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AdditionalEcHttpUrls: config.Parameter{
+			ID:                   "additionalEcHttpUrls",
+			Name:                 "Additional Execution Client URLs",
+			Description:          "A comma-separated list of the HTTP API endpoints for any other Execution clients you run. The Smartnode will add them to its pool of clients, along with your primary and fallback ones, and send requests to the healthiest one based on its latency, how far behind the chain head it is, and its error rate.\n\nThese are only used by the Smartnode, not by your Validator Client.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			Sensitive:            true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AdditionalCcHttpUrls: config.Parameter{
+			ID:                   "additionalCcHttpUrls",
+			Name:                 "Additional Beacon Node URLs",
+			Description:          "A comma-separated list of the HTTP Beacon API endpoints for any other Consensus clients you run. The Smartnode will add them to its pool of clients, along with your primary and fallback ones, and send requests to the healthiest one based on its latency, how far behind the chain head it is, and its error rate.\n\nThese are only used by the Smartnode, not by your Validator Client.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 	}
@@ -108,6 +177,8 @@ func (cfg *FallbackNormalConfig) GetParameters() []*config.Parameter {
 	return []*config.Parameter{
 		&cfg.EcHttpUrl,
 		&cfg.CcHttpUrl,
+		&cfg.AdditionalEcHttpUrls,
+		&cfg.AdditionalCcHttpUrls,
 	}
 }
 
@@ -117,6 +188,8 @@ func (cfg *FallbackPrysmConfig) GetParameters() []*config.Parameter {
 		&cfg.EcHttpUrl,
 		&cfg.CcHttpUrl,
 		&cfg.JsonRpcUrl,
+		&cfg.AdditionalEcHttpUrls,
+		&cfg.AdditionalCcHttpUrls,
 	}
 }
 
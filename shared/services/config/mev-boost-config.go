@@ -1,7 +1,9 @@
 package config
 
 import (
+	"encoding/hex"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/rocket-pool/smartnode/shared/types/config"
@@ -17,6 +19,8 @@ const (
 	UnregulatedRelayDescription string = "Select this to enable the relays that do not follow any sanctions lists (do not censor transactions), "
 	NoSandwichRelayDescription  string = "and do not allow front-running or sandwich attacks."
 	AllMevRelayDescription      string = "and allow for all types of MEV (including sandwich attacks)."
+	CustomRelaysID              string = "customRelays"
+	relayPubkeyLength           int    = 48
 )
 
 // Configuration for MEV-Boost
@@ -53,6 +57,9 @@ type MevBoostConfig struct {
 	// Aestus relay
 	AestusRelay config.Parameter `yaml:"aestusEnabled,omitempty"`
 
+	// User-defined relays
+	CustomRelays config.Parameter `yaml:"customRelays,omitempty"`
+
 	// The RPC port
 	Port config.Parameter `yaml:"port,omitempty"`
 
@@ -146,6 +153,18 @@ func NewMevBoostConfig(cfg *RocketPoolConfig) *MevBoostConfig {
 		UltrasoundRelay:         generateRelayParameter("ultrasoundEnabled", relayMap[config.MevRelayID_Ultrasound]),
 		AestusRelay:             generateRelayParameter("aestusEnabled", relayMap[config.MevRelayID_Aestus]),
 
+		CustomRelays: config.Parameter{
+			ID:                   CustomRelaysID,
+			Name:                 "Custom Relays",
+			Description:          "Additional relays you want MEV-Boost to use on the current network, separated by commas. Each one must include the relay's public key, such as `https://0xabcd...1234@relay.example.com`; relays publish these URLs on their websites.\n\nThese are used alongside the profiles or relays selected above, in either selection mode.\n\n[orange]NOTE: the Smartnode can't vouch for custom relays. Only add relays you trust, and check them with `rocketpool service check-relays`.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_MevBoost},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
 		Port: config.Parameter{
 			ID:                   "port",
 			Name:                 "Port",
@@ -225,6 +244,7 @@ func (cfg *MevBoostConfig) GetParameters() []*config.Parameter {
 		&cfg.EdenRelay,
 		&cfg.UltrasoundRelay,
 		&cfg.AestusRelay,
+		&cfg.CustomRelays,
 		&cfg.Port,
 		&cfg.OpenRpcPort,
 		&cfg.ContainerTag,
@@ -334,9 +354,90 @@ func (cfg *MevBoostConfig) GetEnabledMevRelays() []config.MevRelay {
 		}
 	}
 
+	// Add the user-defined relays, skipping any that were already selected above
+	customRelays, _ := cfg.GetCustomRelays()
+	for _, customRelay := range customRelays {
+		isDuplicate := false
+		for _, relay := range relays {
+			if relayUrlsMatch(relay.Urls[currentNetwork], customRelay.Urls[currentNetwork]) {
+				isDuplicate = true
+				break
+			}
+		}
+		if !isDuplicate {
+			relays = append(relays, customRelay)
+		}
+	}
+
 	return relays
 }
 
+// Get the user-defined relays for the current network, along with errors for any that are invalid
+func (cfg *MevBoostConfig) GetCustomRelays() ([]config.MevRelay, []error) {
+	relays := []config.MevRelay{}
+	errs := []error{}
+
+	currentNetwork := cfg.parentConfig.Smartnode.Network.Value.(config.Network)
+	for _, relayUrl := range strings.Split(cfg.CustomRelays.Value.(string), ",") {
+		relayUrl = strings.TrimSpace(relayUrl)
+		if relayUrl == "" {
+			continue
+		}
+		_, parsedUrl, err := ParseMevRelayUrl(relayUrl)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		relays = append(relays, config.MevRelay{
+			ID:          config.MevRelayID_Custom,
+			Name:        parsedUrl.Host,
+			Description: "A custom relay.",
+			Urls: map[config.Network]string{
+				currentNetwork: relayUrl,
+			},
+			Regulated: false,
+		})
+	}
+
+	return relays, errs
+}
+
+// Parse a relay URL, which must include the relay's public key as its username.
+// Returns the public key and the URL.
+func ParseMevRelayUrl(relayUrl string) ([]byte, *url.URL, error) {
+	parsedUrl, err := url.Parse(relayUrl)
+	if err != nil {
+		return nil, nil, fmt.Errorf("relay URL [%s] is invalid: %w", relayUrl, err)
+	}
+	if parsedUrl.Scheme != "https" && parsedUrl.Scheme != "http" {
+		return nil, nil, fmt.Errorf("relay URL [%s] must start with https:// or http://", relayUrl)
+	}
+	if parsedUrl.Host == "" {
+		return nil, nil, fmt.Errorf("relay URL [%s] does not have a host", relayUrl)
+	}
+	if parsedUrl.User == nil || parsedUrl.User.Username() == "" {
+		return nil, nil, fmt.Errorf("relay URL [%s] does not include the relay's public key; it should look like https://0x<public key>@%s", relayUrl, parsedUrl.Host)
+	}
+	pubkey, err := hex.DecodeString(strings.TrimPrefix(parsedUrl.User.Username(), "0x"))
+	if err != nil || len(pubkey) != relayPubkeyLength {
+		return nil, nil, fmt.Errorf("relay URL [%s] has an invalid public key; it must be %d bytes of hex", relayUrl, relayPubkeyLength)
+	}
+	return pubkey, parsedUrl, nil
+}
+
+// Check if two relay URLs are for the same relay, ignoring their query strings
+func relayUrlsMatch(first string, second string) bool {
+	firstPubkey, firstUrl, err := ParseMevRelayUrl(first)
+	if err != nil {
+		return false
+	}
+	secondPubkey, secondUrl, err := ParseMevRelayUrl(second)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(firstUrl.Host, secondUrl.Host) && hex.EncodeToString(firstPubkey) == hex.EncodeToString(secondPubkey)
+}
+
 func (cfg *MevBoostConfig) GetRelayString() string {
 	relayUrls := []string{}
 	currentNetwork := cfg.parentConfig.Smartnode.Network.Value.(config.Network)
@@ -41,6 +41,7 @@ func NewNativeConfig(cfg *RocketPoolConfig) *NativeConfig {
 			AffectsContainers:    []config.ContainerID{},
 			EnvironmentVariables: []string{},
 			CanBeBlank:           false,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -86,6 +87,7 @@ func NewNativeConfig(cfg *RocketPoolConfig) *NativeConfig {
 			AffectsContainers:    []config.ContainerID{},
 			EnvironmentVariables: []string{},
 			CanBeBlank:           false,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -47,6 +48,9 @@ const defaultExporterMetricsPort uint16 = 9103
 const defaultWatchtowerMetricsPort uint16 = 9104
 const defaultEcMetricsPort uint16 = 9105
 
+// The root settings that describe this installation rather than its configuration
+var installationSettings = []string{"rpDir", "isNative", "version"}
+
 // The master configuration struct
 type RocketPoolConfig struct {
 	Title string `yaml:"-"`
@@ -814,6 +818,84 @@ func (cfg *RocketPoolConfig) Deserialize(masterMap map[string]map[string]string)
 	return nil
 }
 
+// Serializes the configuration like Serialize, but without the settings that are specific to this installation
+// so it can be applied to other nodes. Sensitive settings (passwords, access tokens, and URLs that can contain
+// credentials) are left out too unless includeSecrets is set; the number of them that had a value is returned.
+func (cfg *RocketPoolConfig) ExportSettings(includeSecrets bool) (map[string]map[string]string, int) {
+	masterMap := cfg.Serialize()
+	for _, key := range installationSettings {
+		delete(masterMap[rootConfigName], key)
+	}
+	if includeSecrets {
+		return masterMap, 0
+	}
+
+	redactedCount := 0
+	redact := func(sectionName string, params []*config.Parameter) {
+		for _, param := range params {
+			if !param.Sensitive {
+				continue
+			}
+			if masterMap[sectionName][param.ID] != "" {
+				redactedCount++
+			}
+			delete(masterMap[sectionName], param.ID)
+		}
+	}
+	redact(rootConfigName, cfg.GetParameters())
+	for name, subconfig := range cfg.GetSubconfigs() {
+		redact(name, subconfig.GetParameters())
+	}
+	return masterMap, redactedCount
+}
+
+// Creates a copy of this config with the provided settings applied on top of it; any settings that aren't provided
+// keep their current values. Returns an error if any of the provided settings don't exist.
+func (cfg *RocketPoolConfig) CreateCopyWithSettings(settings map[string]map[string]string) (*RocketPoolConfig, error) {
+	masterMap := cfg.Serialize()
+
+	unknownSettings := []string{}
+	for sectionName, sectionSettings := range settings {
+		currentSettings, exists := masterMap[sectionName]
+		if !exists {
+			unknownSettings = append(unknownSettings, fmt.Sprintf("[%s]", sectionName))
+			continue
+		}
+		for id, value := range sectionSettings {
+			if sectionName == rootConfigName && isInstallationSetting(id) {
+				// Keep this installation's settings so a settings file from another node can be applied
+				continue
+			}
+			if _, exists := currentSettings[id]; !exists {
+				unknownSettings = append(unknownSettings, fmt.Sprintf("[%s - %s]", sectionName, id))
+				continue
+			}
+			currentSettings[id] = value
+		}
+	}
+	if len(unknownSettings) > 0 {
+		sort.Strings(unknownSettings)
+		return nil, fmt.Errorf("unknown settings: %s", strings.Join(unknownSettings, ", "))
+	}
+
+	newConfig := NewRocketPoolConfig(cfg.RocketPoolDirectory, cfg.IsNativeMode)
+	err := newConfig.Deserialize(masterMap)
+	if err != nil {
+		return nil, err
+	}
+	return newConfig, nil
+}
+
+// Check if a root setting is specific to this installation
+func isInstallationSetting(id string) bool {
+	for _, key := range installationSettings {
+		if id == key {
+			return true
+		}
+	}
+	return false
+}
+
 // Generates a collection of environment variables based on this config's settings
 func (cfg *RocketPoolConfig) GenerateEnvironmentVariables() map[string]string {
 
@@ -1087,6 +1169,28 @@ func (cfg *RocketPoolConfig) GetChanges(oldConfig *RocketPoolConfig) (map[string
 	return changedSettings, totalAffectedContainers, changeNetworks
 }
 
+// Checks each parameter's value against its format, length and options; returns a list of errors for the invalid ones
+func (cfg *RocketPoolConfig) ValidateParameters() []string {
+	errors := []string{}
+
+	for _, param := range cfg.GetParameters() {
+		if err := param.Validate(); err != nil {
+			errors = append(errors, fmt.Sprintf("[%s] %s.", param.ID, err.Error()))
+		}
+	}
+
+	for name, subconfig := range cfg.GetSubconfigs() {
+		for _, param := range subconfig.GetParameters() {
+			if err := param.Validate(); err != nil {
+				errors = append(errors, fmt.Sprintf("[%s - %s] %s.", name, param.ID, err.Error()))
+			}
+		}
+	}
+
+	sort.Strings(errors)
+	return errors
+}
+
 // Checks to see if the current configuration is valid; if not, returns a list of errors
 func (cfg *RocketPoolConfig) Validate() []string {
 	errors := []string{}
@@ -1135,6 +1239,12 @@ func (cfg *RocketPoolConfig) Validate() []string {
 			if len(relays) == 0 {
 				errors = append(errors, "You have MEV-boost enabled in local mode but don't have any profiles or relays enabled. Please select at least one profile or relay to use MEV-boost.")
 			}
+
+			// Make sure the custom relays are valid
+			_, relayErrs := cfg.MevBoost.GetCustomRelays()
+			for _, err := range relayErrs {
+				errors = append(errors, fmt.Sprintf("Your MEV-Boost custom relays are invalid: %s.", err.Error()))
+			}
 		case config.Mode_External:
 			// In external MEV-boost mode, the user has to have an external URL if they're running Docker mode
 			if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local && cfg.MevBoost.ExternalUrl.Value.(string) == "" {
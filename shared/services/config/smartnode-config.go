@@ -25,20 +25,36 @@ const (
 	DaemonDataPath                     string = "/.rocketpool/data"
 	WatchtowerFolder                   string = "watchtower"
 	WatchtowerStateFile                string = "state.yml"
+	WatchtowerPenaltiesFile            string = "penalties.json"
 	RegenerateRewardsTreeRequestSuffix string = ".request"
 	RegenerateRewardsTreeRequestFormat string = "%d" + RegenerateRewardsTreeRequestSuffix
 	PrimaryRewardsFileUrl              string = "https://%s.ipfs.dweb.link/%s"
 	SecondaryRewardsFileUrl            string = "https://ipfs.io/ipfs/%s/%s"
 	GithubRewardsFileUrl               string = "https://github.com/rocket-pool/rewards-trees/raw/main/%s/%s"
 	FeeRecipientFilename               string = "rp-fee-recipient.txt"
+	TaskStatusFolder                   string = "tasks"
+	TaskStatusFilenameFormat           string = "%s-status.json"
+	ValidatorPerformanceFilename       string = "validator-performance.json"
+	StateCacheFolder                   string = "state-cache"
+	TransactionJournalFilename         string = "transactions.json"
 	NativeFeeRecipientFilename         string = "rp-fee-recipient-env.txt"
+	SlashingProtectionFilename         string = "slashing-protection.json"
+	ApiServerFolder                    string = "api-server"
+	ApiServerSocketFilename            string = "api.sock"
+	ApiServerTokenFilename             string = "token"
+	ApiServerCertFilename              string = "cert.pem"
+	ApiServerKeyFilename               string = "key.pem"
 )
 
 // Defaults
 const (
-	defaultProjectName       string = "rocketpool"
-	WatchtowerMaxFeeDefault  uint64 = 200
-	WatchtowerPrioFeeDefault uint64 = 3
+	defaultProjectName            string  = "rocketpool"
+	defaultApiServerAddress       string  = "127.0.0.1"
+	defaultApiServerPort          uint16  = 8280
+	defaultNotificationSmtpPort   uint16  = 587
+	defaultLowEthBalanceThreshold float64 = 0.05
+	WatchtowerMaxFeeDefault       uint64  = 200
+	WatchtowerPrioFeeDefault      uint64  = 3
 )
 
 // Configuration for the Smartnode
@@ -73,6 +89,12 @@ type SmartnodeConfig struct {
 	// Threshold for automatic transactions
 	AutoTxGasThreshold config.Parameter `yaml:"minipoolStakeGasThreshold,omitempty"`
 
+	// How long a node transaction can stay pending before it's replaced with a higher fee
+	StuckTxTimeout config.Parameter `yaml:"stuckTxTimeout,omitempty"`
+
+	// The highest max fee a stuck transaction can be bumped to
+	StuckTxMaxFee config.Parameter `yaml:"stuckTxMaxFee,omitempty"`
+
 	// The amount of ETH in a minipool's balance before auto-distribute kicks in
 	DistributeThreshold config.Parameter `yaml:"distributeThreshold,omitempty"`
 
@@ -91,6 +113,18 @@ type SmartnodeConfig struct {
 	// Manual override for the watchtower's priority fee
 	WatchtowerPrioFeeOverride config.Parameter `yaml:"watchtowerPrioFeeOverride,omitempty"`
 
+	// Toggle for comparing the watchtower's submissions with the other Oracle DAO members' before voting
+	WatchtowerConsensusCheck config.Parameter `yaml:"watchtowerConsensusCheck,omitempty"`
+
+	// How far apart two submissions can be (in percent) before they're treated as a disagreement
+	WatchtowerConsensusTolerance config.Parameter `yaml:"watchtowerConsensusTolerance,omitempty"`
+
+	// Toggle for holding back the watchtower's vote when it disagrees with an existing majority
+	WatchtowerConsensusStrict config.Parameter `yaml:"watchtowerConsensusStrict,omitempty"`
+
+	// Whether the watchtower submits penalties for the illegal fee recipients it detects
+	WatchtowerSubmitPenalties config.Parameter `yaml:"watchtowerSubmitPenalties,omitempty"`
+
 	// The toggle for rolling records
 	UseRollingRecords config.Parameter `yaml:"useRollingRecords,omitempty"`
 
@@ -103,6 +137,87 @@ type SmartnodeConfig struct {
 	// The path of the records folder where snapshots of rolling record info is stored during a rewards interval
 	RecordsPath config.Parameter `yaml:"recordsPath,omitempty"`
 
+	// The number of finalized network states to keep in the on-disk cache
+	StateCacheRetentionLimit config.Parameter `yaml:"stateCacheRetentionLimit,omitempty"`
+
+	// The URL of a Web3Signer-compatible remote signer that holds the validator keys
+	RemoteSignerUrl config.Parameter `yaml:"remoteSignerUrl,omitempty"`
+
+	// The bearer token for the remote signer's keymanager API
+	RemoteSignerAuthToken config.Parameter `yaml:"remoteSignerAuthToken,omitempty"`
+
+	// The URL of the Validator Client's keymanager API
+	KeymanagerApiUrl config.Parameter `yaml:"keymanagerApiUrl,omitempty"`
+
+	// The bearer token for the Validator Client's keymanager API
+	KeymanagerApiToken config.Parameter `yaml:"keymanagerApiToken,omitempty"`
+
+	// Toggle for the long-lived HTTP API server in the node daemon
+	EnableApiServer config.Parameter `yaml:"enableApiServer,omitempty"`
+
+	// Toggle for also serving the API server over TLS
+	EnableApiServerTls config.Parameter `yaml:"enableApiServerTls,omitempty"`
+
+	// The address to serve the API server on over TLS
+	ApiServerAddress config.Parameter `yaml:"apiServerAddress,omitempty"`
+
+	// The port to serve the API server on over TLS
+	ApiServerPort config.Parameter `yaml:"apiServerPort,omitempty"`
+
+	// The URL of a generic webhook for notifications
+	NotificationWebhookUrl config.Parameter `yaml:"notificationWebhookUrl,omitempty"`
+
+	// The URL of a Discord or Slack webhook for notifications
+	NotificationChatWebhookUrl config.Parameter `yaml:"notificationChatWebhookUrl,omitempty"`
+
+	// The SMTP server for email notifications
+	NotificationSmtpHost config.Parameter `yaml:"notificationSmtpHost,omitempty"`
+
+	// The SMTP server's port
+	NotificationSmtpPort config.Parameter `yaml:"notificationSmtpPort,omitempty"`
+
+	// The SMTP username
+	NotificationSmtpUsername config.Parameter `yaml:"notificationSmtpUsername,omitempty"`
+
+	// The SMTP password
+	NotificationSmtpPassword config.Parameter `yaml:"notificationSmtpPassword,omitempty"`
+
+	// The sender of notification emails
+	NotificationSmtpFrom config.Parameter `yaml:"notificationSmtpFrom,omitempty"`
+
+	// The recipients of notification emails
+	NotificationSmtpTo config.Parameter `yaml:"notificationSmtpTo,omitempty"`
+
+	// The URL of an ntfy topic for notifications
+	NotificationNtfyUrl config.Parameter `yaml:"notificationNtfyUrl,omitempty"`
+
+	// The access token for the ntfy topic
+	NotificationNtfyToken config.Parameter `yaml:"notificationNtfyToken,omitempty"`
+
+	// Toggle for notifications when a minipool is staked
+	NotifyMinipoolStaked config.Parameter `yaml:"notifyMinipoolStaked,omitempty"`
+
+	// Toggle for notifications when a minipool's bond is reduced
+	NotifyBondReduced config.Parameter `yaml:"notifyBondReduced,omitempty"`
+
+	// Toggle for notifications when the fee recipient is corrected
+	NotifyFeeRecipientCorrected config.Parameter `yaml:"notifyFeeRecipientCorrected,omitempty"`
+
+	// Toggle for notifications when the clients fall back
+	NotifyClientFallback config.Parameter `yaml:"notifyClientFallback,omitempty"`
+
+	// Toggle for notifications when the node wallet's ETH balance is low
+	NotifyLowEthBalance config.Parameter `yaml:"notifyLowEthBalance,omitempty"`
+
+	// The ETH balance below which the node wallet's balance is low
+	LowEthBalanceThreshold config.Parameter `yaml:"lowEthBalanceThreshold,omitempty"`
+
+	// Toggle for notifications when a validator goes offline
+	NotifyValidatorOffline config.Parameter `yaml:"notifyValidatorOffline,omitempty"`
+
+	// Toggle for notifications when an Oracle DAO member votes to scrub a minipool
+	NotifyScrubVote config.Parameter `yaml:"notifyScrubVote,omitempty"`
+
 	///////////////////////////
 	// Non-editable settings //
 	///////////////////////////
@@ -286,6 +401,30 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		StuckTxTimeout: config.Parameter{
+			ID:                   "stuckTxTimeout",
+			Name:                 "Stuck Transaction Timeout",
+			Description:          "The number of minutes one of your node's transactions can stay pending before the Smartnode considers it stuck. Stuck transactions are re-sent with the same nonce and a higher max fee and priority fee, so they replace the original and anything queued behind them can go through.\n\nSet this to 0 to disable automatic replacement; you can still view and cancel pending transactions with `rocketpool node tx`.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(15)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		StuckTxMaxFee: config.Parameter{
+			ID:                   "stuckTxMaxFee",
+			Name:                 "Stuck Transaction Max Fee",
+			Description:          "The highest max fee (in gwei) the Smartnode will raise a stuck transaction to when replacing it. Transactions that would need more than this are left alone.\n\nSet this to 0 for no limit.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(200)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		DistributeThreshold: config.Parameter{
 			ID:                   "distributeThreshold",
 			Name:                 "Auto-Distribute Threshold",
@@ -328,6 +467,7 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
 			EnvironmentVariables: []string{},
 			CanBeBlank:           true,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -340,6 +480,7 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
 			EnvironmentVariables: []string{},
 			CanBeBlank:           true,
+			Sensitive:            true,
 			OverwriteOnUpgrade:   false,
 		},
 
@@ -367,6 +508,54 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade:   true,
 		},
 
+		WatchtowerConsensusCheck: config.Parameter{
+			ID:                   "watchtowerConsensusCheck",
+			Name:                 "Compare Watchtower Submissions",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]Enable this to have the watchtower read the other Oracle DAO members' network balance, RPL price, and rewards tree submissions before voting, and log any that disagree with its own.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: true},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		WatchtowerConsensusTolerance: config.Parameter{
+			ID:                   "watchtowerConsensusTolerance",
+			Name:                 "Watchtower Disagreement Tolerance",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]How far apart (in percent) the watchtower's balances or RPL price can be from another member's before it's reported as a disagreement. Rewards tree roots must always match exactly.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(0)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		WatchtowerConsensusStrict: config.Parameter{
+			ID:                   "watchtowerConsensusStrict",
+			Name:                 "Strict Watchtower Consensus",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]Enable this to hold back the watchtower's vote when it disagrees with a majority of the members that have already submitted, so a client bug or bad local state can be investigated before it costs a consensus round.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		WatchtowerSubmitPenalties: config.Parameter{
+			ID:                   "watchtowerSubmitPenalties",
+			Name:                 "Submit Penalties",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]The watchtower always records the blocks where a Rocket Pool validator sent its fees or MEV to the wrong address; you can view them with `rocketpool odao penalties`.\n\nEnable this to also submit a penalty against the offending minipool for each one.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		UseRollingRecords: config.Parameter{
 			ID:                   "useRollingRecords",
 			Name:                 "Use Rolling Records",
@@ -415,6 +604,339 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		StateCacheRetentionLimit: config.Parameter{
+			ID:                   "stateCacheRetentionLimit",
+			Name:                 "State Cache Retention Limit",
+			Description:          "The number of finalized network state snapshots to keep on-disk, so they don't have to be rebuilt from scratch when they're needed again (for example, when a task is retried or the daemon restarts). The oldest snapshots are pruned once this limit is reached.\n\nSet this to 0 to disable the cache.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(10)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		RemoteSignerUrl: config.Parameter{
+			ID:                   "remoteSignerUrl",
+			Name:                 "Remote Signer URL",
+			Description:          "The URL of a Web3Signer-compatible remote signer that should hold your validator keys, such as `http://192.168.1.10:9000`. If this is set, new validator keys will be imported into the remote signer through its keymanager API instead of being written to your Validator Client's keystore folders, and voluntary exits and deposits will be signed by it.\n\n[orange]NOTE: your Validator Client must be configured to use this remote signer separately. Leave this blank to store validator keys locally.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			Sensitive:            true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		RemoteSignerAuthToken: config.Parameter{
+			ID:                   "remoteSignerAuthToken",
+			Name:                 "Remote Signer Auth Token",
+			Description:          "The bearer token for your remote signer's keymanager API, if it requires one. Only used if the Remote Signer URL is set.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			Sensitive:            true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		KeymanagerApiUrl: config.Parameter{
+			ID:                   "keymanagerApiUrl",
+			Name:                 "Keymanager API URL",
+			Description:          "The URL of your Validator Client's standard keymanager API, such as `http://rocketpool_validator:5062`. If this is set, the Smartnode will load new validator keys and fee recipient changes into your Validator Client through this API instead of restarting it, so it doesn't miss any attestations.\n\nIf your Validator Client doesn't support the API or the request fails, the Smartnode will fall back to restarting it. Leave this blank to always restart the Validator Client.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			Sensitive:            true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		KeymanagerApiToken: config.Parameter{
+			ID:                   "keymanagerApiToken",
+			Name:                 "Keymanager API Token",
+			Description:          "The bearer token for your Validator Client's keymanager API. Your Validator Client generates this when it enables the API; check its documentation to find out where it's stored. Only used if the Keymanager API URL is set.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			Sensitive:            true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		EnableApiServer: config.Parameter{
+			ID:                   "enableApiServer",
+			Name:                 "Enable API Server",
+			Description:          "Run a long-lived HTTP server in the Node container that serves the Smartnode's API calls as typed JSON routes, on a unix socket in your data folder (`api-server/api.sock`). Use `rocketpool --use-api-server` to send the CLI's calls to it instead of starting a new API process for each one; dashboards and other tools can call it directly too, and `GET /api/v1/` lists its routes.\n\nEvery request must include the bearer token stored in `api-server/token` in your data folder.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{"ENABLE_API_SERVER"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		EnableApiServerTls: config.Parameter{
+			ID:                   "enableApiServerTls",
+			Name:                 "Enable API Server TLS",
+			Description:          "Also serve the API server over HTTPS on the API Server Address and Port, so it can be used from other machines. It uses a self-signed certificate stored in `api-server/cert.pem` in your data folder; copy it to the machines that should connect and pass it to the CLI with `--api-cert`.\n\n[orange]WARNING: anyone with the bearer token can send transactions from your node wallet. Only expose this port to networks you trust. Only used if the API Server is enabled.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{"ENABLE_API_SERVER_TLS"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		ApiServerAddress: config.Parameter{
+			ID:                   "apiServerAddress",
+			Name:                 "API Server Address",
+			Description:          "The address the API server should listen on for HTTPS connections. The default only accepts connections from this machine; set it to the address of one of its network interfaces (or 0.0.0.0 for all of them) to let other machines connect. Only used if API Server TLS is enabled.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultApiServerAddress},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{"API_SERVER_ADDRESS"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		ApiServerPort: config.Parameter{
+			ID:                   "apiServerPort",
+			Name:                 "API Server Port",
+			Description:          "The port the API server should listen on for HTTPS connections. Only used if API Server TLS is enabled.",
+			Type:                 config.ParameterType_Uint16,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultApiServerPort},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{"API_SERVER_PORT"},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotificationWebhookUrl: config.Parameter{
+			ID:                   "notificationWebhookUrl",
+			Name:                 "Notification Webhook URL",
+			Description:          "The URL of a webhook that should receive the node's notifications as JSON, with the event type, a title, a message, the node address and the network. Leave this blank to disable it.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			Sensitive:            true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotificationChatWebhookUrl: config.Parameter{
+			ID:                   "notificationChatWebhookUrl",
+			Name:                 "Discord / Slack Webhook URL",
+			Description:          "The URL of a Discord or Slack incoming webhook that should receive the node's notifications as chat messages. Discord URLs (`discord.com` or `discordapp.com`) get Discord's message format; everything else gets Slack's. Leave this blank to disable it.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			Sensitive:            true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotificationSmtpHost: config.Parameter{
+			ID:                   "notificationSmtpHost",
+			Name:                 "SMTP Server",
+			Description:          "The hostname of the SMTP server to send notification emails through, such as `smtp.gmail.com`. Leave this blank to disable email notifications.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotificationSmtpPort: config.Parameter{
+			ID:                   "notificationSmtpPort",
+			Name:                 "SMTP Port",
+			Description:          "The port of the SMTP server. Port 465 uses implicit TLS; every other port upgrades the connection with STARTTLS if the server supports it.",
+			Type:                 config.ParameterType_Uint16,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultNotificationSmtpPort},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotificationSmtpUsername: config.Parameter{
+			ID:                   "notificationSmtpUsername",
+			Name:                 "SMTP Username",
+			Description:          "The username to log into the SMTP server with. Leave this blank if the server doesn't need authentication.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotificationSmtpPassword: config.Parameter{
+			ID:                   "notificationSmtpPassword",
+			Name:                 "SMTP Password",
+			Description:          "The password to log into the SMTP server with.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			Sensitive:            true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotificationSmtpFrom: config.Parameter{
+			ID:                   "notificationSmtpFrom",
+			Name:                 "Email Sender",
+			Description:          "The address notification emails should be sent from.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotificationSmtpTo: config.Parameter{
+			ID:                   "notificationSmtpTo",
+			Name:                 "Email Recipients",
+			Description:          "The addresses notification emails should be sent to, separated by commas.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotificationNtfyUrl: config.Parameter{
+			ID:                   "notificationNtfyUrl",
+			Name:                 "ntfy Topic URL",
+			Description:          "The URL of an ntfy topic that should receive the node's notifications as push notifications, such as `https://ntfy.sh/my-rocketpool-node`. Anyone who knows a topic on a public server can read it, so pick a name that's hard to guess or use your own server. Leave this blank to disable it.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			Sensitive:            true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotificationNtfyToken: config.Parameter{
+			ID:                   "notificationNtfyToken",
+			Name:                 "ntfy Access Token",
+			Description:          "The access token to publish to the ntfy topic with, if the server requires one.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			Sensitive:            true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotifyMinipoolStaked: config.Parameter{
+			ID:                   "notifyMinipoolStaked",
+			Name:                 "Notify on Minipool Staked",
+			Description:          "Send a notification when the node daemon stakes one of your minipools.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: true},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotifyBondReduced: config.Parameter{
+			ID:                   "notifyBondReduced",
+			Name:                 "Notify on Bond Reduction",
+			Description:          "Send a notification when the node daemon reduces the bond of one of your minipools.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: true},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotifyFeeRecipientCorrected: config.Parameter{
+			ID:                   "notifyFeeRecipientCorrected",
+			Name:                 "Notify on Fee Recipient Correction",
+			Description:          "Send a notification when the node daemon finds your Validator Client using the wrong fee recipient and corrects it.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: true},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotifyClientFallback: config.Parameter{
+			ID:                   "notifyClientFallback",
+			Name:                 "Notify on Client Fallback",
+			Description:          "Send a notification when the node daemon switches from your primary Execution or Consensus client to a fallback, and when it switches back.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: true},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotifyLowEthBalance: config.Parameter{
+			ID:                   "notifyLowEthBalance",
+			Name:                 "Notify on Low ETH Balance",
+			Description:          "Send a notification when your node wallet's ETH balance drops below the Low ETH Balance Threshold, so it can still pay for gas.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: true},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		LowEthBalanceThreshold: config.Parameter{
+			ID:                   "lowEthBalanceThreshold",
+			Name:                 "Low ETH Balance Threshold",
+			Description:          "The node wallet ETH balance, in ETH, below which a low balance notification is sent.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultLowEthBalanceThreshold},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotifyValidatorOffline: config.Parameter{
+			ID:                   "notifyValidatorOffline",
+			Name:                 "Notify on Validator Offline",
+			Description:          "Send a notification when one of your validators misses its attestations for several epochs in a row, and when it starts attesting again.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: true},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		NotifyScrubVote: config.Parameter{
+			ID:                   "notifyScrubVote",
+			Name:                 "Notify on Scrub Vote",
+			Description:          "Send a notification when an Oracle DAO member votes to scrub one of your minipools during its scrub check.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: true},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		txWatchUrl: map[config.Network]string{
 			config.Network_Mainnet: "https://etherscan.io/tx",
 			config.Network_Prater:  "https://goerli.etherscan.io/tx",
@@ -628,16 +1150,49 @@ func (cfg *SmartnodeConfig) GetParameters() []*config.Parameter {
 		&cfg.ManualMaxFee,
 		&cfg.PriorityFee,
 		&cfg.AutoTxGasThreshold,
+		&cfg.StuckTxTimeout,
+		&cfg.StuckTxMaxFee,
 		&cfg.DistributeThreshold,
 		&cfg.RewardsTreeMode,
 		&cfg.ArchiveECUrl,
 		&cfg.Web3StorageApiToken,
 		&cfg.WatchtowerMaxFeeOverride,
 		&cfg.WatchtowerPrioFeeOverride,
+		&cfg.WatchtowerConsensusCheck,
+		&cfg.WatchtowerConsensusTolerance,
+		&cfg.WatchtowerConsensusStrict,
+		&cfg.WatchtowerSubmitPenalties,
 		&cfg.UseRollingRecords,
 		&cfg.RecordCheckpointInterval,
 		&cfg.CheckpointRetentionLimit,
 		&cfg.RecordsPath,
+		&cfg.StateCacheRetentionLimit,
+		&cfg.RemoteSignerUrl,
+		&cfg.RemoteSignerAuthToken,
+		&cfg.KeymanagerApiUrl,
+		&cfg.KeymanagerApiToken,
+		&cfg.EnableApiServer,
+		&cfg.EnableApiServerTls,
+		&cfg.ApiServerAddress,
+		&cfg.ApiServerPort,
+		&cfg.NotificationWebhookUrl,
+		&cfg.NotificationChatWebhookUrl,
+		&cfg.NotificationSmtpHost,
+		&cfg.NotificationSmtpPort,
+		&cfg.NotificationSmtpUsername,
+		&cfg.NotificationSmtpPassword,
+		&cfg.NotificationSmtpFrom,
+		&cfg.NotificationSmtpTo,
+		&cfg.NotificationNtfyUrl,
+		&cfg.NotificationNtfyToken,
+		&cfg.NotifyMinipoolStaked,
+		&cfg.NotifyBondReduced,
+		&cfg.NotifyFeeRecipientCorrected,
+		&cfg.NotifyClientFallback,
+		&cfg.NotifyLowEthBalance,
+		&cfg.LowEthBalanceThreshold,
+		&cfg.NotifyValidatorOffline,
+		&cfg.NotifyScrubVote,
 	}
 }
 
@@ -679,6 +1234,34 @@ func (cfg *SmartnodeConfig) GetValidatorKeychainPath() string {
 	return filepath.Join(DaemonDataPath, "validators")
 }
 
+func (cfg *SmartnodeConfig) GetSlashingProtectionPath() string {
+	return filepath.Join(cfg.GetValidatorKeychainPath(), SlashingProtectionFilename)
+}
+
+func (cfg *SmartnodeConfig) GetApiServerSocketPath(daemon bool) string {
+	return cfg.getApiServerPath(daemon, ApiServerSocketFilename)
+}
+
+func (cfg *SmartnodeConfig) GetApiServerTokenPath(daemon bool) string {
+	return cfg.getApiServerPath(daemon, ApiServerTokenFilename)
+}
+
+func (cfg *SmartnodeConfig) GetApiServerCertPath(daemon bool) string {
+	return cfg.getApiServerPath(daemon, ApiServerCertFilename)
+}
+
+func (cfg *SmartnodeConfig) GetApiServerKeyPath(daemon bool) string {
+	return cfg.getApiServerPath(daemon, ApiServerKeyFilename)
+}
+
+func (cfg *SmartnodeConfig) getApiServerPath(daemon bool, filename string) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, ApiServerFolder, filename)
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), ApiServerFolder, filename)
+}
+
 func (cfg *SmartnodeConfig) GetRecordsPath() string {
 	if cfg.parent.IsNativeMode {
 		return filepath.Join(cfg.DataPath.Value.(string), "records")
@@ -687,6 +1270,22 @@ func (cfg *SmartnodeConfig) GetRecordsPath() string {
 	return filepath.Join(DaemonDataPath, "records")
 }
 
+func (cfg *SmartnodeConfig) GetStateCachePath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), StateCacheFolder)
+	}
+
+	return filepath.Join(DaemonDataPath, StateCacheFolder)
+}
+
+func (cfg *SmartnodeConfig) GetTransactionJournalPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), TransactionJournalFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, TransactionJournalFilename)
+}
+
 func (cfg *SmartnodeConfig) GetWalletPathInCLI() string {
 	return filepath.Join(cfg.DataPath.Value.(string), "wallet")
 }
@@ -707,6 +1306,14 @@ func (config *SmartnodeConfig) GetWatchtowerStatePath() string {
 	return filepath.Join(DaemonDataPath, WatchtowerFolder, "state.yml")
 }
 
+func (config *SmartnodeConfig) GetWatchtowerPenaltiesPath() string {
+	if config.parent.IsNativeMode {
+		return filepath.Join(config.DataPath.Value.(string), WatchtowerFolder, WatchtowerPenaltiesFile)
+	}
+
+	return filepath.Join(DaemonDataPath, WatchtowerFolder, WatchtowerPenaltiesFile)
+}
+
 func (cfg *SmartnodeConfig) GetCustomKeyPath() string {
 	if cfg.parent.IsNativeMode {
 		return filepath.Join(cfg.DataPath.Value.(string), "custom-keys")
@@ -816,6 +1423,22 @@ func (cfg *SmartnodeConfig) GetWatchtowerFolder(daemon bool) string {
 	return filepath.Join(cfg.DataPath.Value.(string), WatchtowerFolder)
 }
 
+func (cfg *SmartnodeConfig) GetTaskStatusPath(daemonName string, daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, TaskStatusFolder, fmt.Sprintf(TaskStatusFilenameFormat, daemonName))
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), TaskStatusFolder, fmt.Sprintf(TaskStatusFilenameFormat, daemonName))
+}
+
+func (cfg *SmartnodeConfig) GetValidatorPerformancePath(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, ValidatorPerformanceFilename)
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), ValidatorPerformanceFilename)
+}
+
 func (cfg *SmartnodeConfig) GetFeeRecipientFilePath() string {
 	if !cfg.parent.IsNativeMode {
 		return filepath.Join(DaemonDataPath, "validators", FeeRecipientFilename)
@@ -6,39 +6,50 @@ import (
 	"math"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/fatih/color"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/simulation"
+	"github.com/rocket-pool/smartnode/shared/services/unsignedtx"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
-// This is a proxy for multiple ETH clients, providing natural fallback support if one of them fails.
+// This is a proxy for a pool of ETH clients, sending requests to the healthiest one and providing natural fallback support if any of them fail.
 type ExecutionClientManager struct {
-	primaryEcUrl    string
-	fallbackEcUrl   string
-	primaryEc       *ethclient.Client
-	fallbackEc      *ethclient.Client
-	logger          log.ColorLogger
-	primaryReady    bool
-	fallbackReady   bool
-	ignoreSyncCheck bool
+	ecUrls []string
+	ecs    []*ethclient.Client
+	rpcs   []*rpc.Client
+	pool   *clientPool
+
+	// Called with every transaction that was sent successfully
+	txObserver func(*types.Transaction)
+
+	// Simulates every transaction that gets a gas estimate, if set
+	simulator *simulation.Simulator
+
+	// Whether transactions are recorded for offline signing instead of being sent
+	unsignedTransactions bool
 }
 
 // This is a signature for a wrapped ethclient.Client function
 type ecFunction func(*ethclient.Client) (interface{}, error)
 
+// This is a signature for a function that makes raw RPC calls
+type rpcFunction func(*rpc.Client) (interface{}, error)
+
 // Creates a new ExecutionClientManager instance based on the Rocket Pool config
 func NewExecutionClientManager(cfg *config.RocketPoolConfig) (*ExecutionClientManager, error) {
 
 	var primaryEcUrl string
-	var fallbackEcUrl string
 
 	// Get the primary EC url
 	if cfg.IsNativeMode {
@@ -49,42 +60,47 @@ func NewExecutionClientManager(cfg *config.RocketPoolConfig) (*ExecutionClientMa
 		primaryEcUrl = cfg.ExternalExecution.HttpUrl.Value.(string)
 	}
 
-	// Get the fallback EC url, if applicable
+	// Get the fallback EC urls, if applicable
+	ecUrls := []string{primaryEcUrl}
 	if cfg.UseFallbackClients.Value == true {
+		var fallbackEcUrl string
+		var additionalEcUrls string
 		if cfg.IsNativeMode {
 			fallbackEcUrl = cfg.FallbackNormal.EcHttpUrl.Value.(string)
+			additionalEcUrls = cfg.FallbackNormal.AdditionalEcHttpUrls.Value.(string)
 		} else {
 			cc, _ := cfg.GetSelectedConsensusClient()
 			switch cc {
 			case cfgtypes.ConsensusClient_Prysm:
 				fallbackEcUrl = cfg.FallbackPrysm.EcHttpUrl.Value.(string)
+				additionalEcUrls = cfg.FallbackPrysm.AdditionalEcHttpUrls.Value.(string)
 			default:
 				fallbackEcUrl = cfg.FallbackNormal.EcHttpUrl.Value.(string)
+				additionalEcUrls = cfg.FallbackNormal.AdditionalEcHttpUrls.Value.(string)
 			}
 		}
+		if fallbackEcUrl != "" {
+			ecUrls = append(ecUrls, fallbackEcUrl)
+		}
+		ecUrls = append(ecUrls, splitUrls(additionalEcUrls)...)
 	}
 
-	primaryEc, err := ethclient.Dial(primaryEcUrl)
-	if err != nil {
-		return nil, fmt.Errorf("error connecting to primary EC at [%s]: %w", primaryEcUrl, err)
-	}
-
-	var fallbackEc *ethclient.Client
-	if fallbackEcUrl != "" {
-		fallbackEc, err = ethclient.Dial(fallbackEcUrl)
+	ecs := make([]*ethclient.Client, len(ecUrls))
+	rpcs := make([]*rpc.Client, len(ecUrls))
+	for i, ecUrl := range ecUrls {
+		rpcClient, err := rpc.Dial(ecUrl)
 		if err != nil {
-			return nil, fmt.Errorf("error connecting to fallback EC at [%s]: %w", fallbackEcUrl, err)
+			return nil, fmt.Errorf("error connecting to EC at [%s]: %w", getEndpointName(ecUrl), err)
 		}
+		rpcs[i] = rpcClient
+		ecs[i] = ethclient.NewClient(rpcClient)
 	}
 
 	return &ExecutionClientManager{
-		primaryEcUrl:  primaryEcUrl,
-		fallbackEcUrl: fallbackEcUrl,
-		primaryEc:     primaryEc,
-		fallbackEc:    fallbackEc,
-		logger:        log.NewColorLogger(color.FgYellow),
-		primaryReady:  true,
-		fallbackReady: fallbackEc != nil,
+		ecUrls: ecUrls,
+		ecs:    ecs,
+		rpcs:   rpcs,
+		pool:   newClientPool("Execution", ecUrls, MaxEcHeadLag, log.NewColorLogger(color.FgYellow)),
 	}, nil
 
 }
@@ -144,6 +160,18 @@ func (p *ExecutionClientManager) HeaderByNumber(ctx context.Context, number *big
 	return result.(*types.Header), err
 }
 
+// BlockByNumber returns a block from the current canonical chain, including its transactions. If number is
+// nil, the latest known block is returned.
+func (p *ExecutionClientManager) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+		return client.BlockByNumber(ctx, number)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*types.Block), err
+}
+
 // PendingCodeAt returns the code of the given account in the pending state.
 func (p *ExecutionClientManager) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
 	result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
@@ -199,6 +227,9 @@ func (p *ExecutionClientManager) EstimateGas(ctx context.Context, call ethereum.
 	result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
 		return client.EstimateGas(ctx, call)
 	})
+	if p.simulator != nil {
+		p.simulate(ctx, call)
+	}
 	if err != nil {
 		return 0, err
 	}
@@ -207,12 +238,53 @@ func (p *ExecutionClientManager) EstimateGas(ctx context.Context, call ethereum.
 
 // SendTransaction injects the transaction into the pending pool for execution.
 func (p *ExecutionClientManager) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if p.unsignedTransactions {
+		return unsignedtx.Record(tx)
+	}
 	_, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
 		return nil, client.SendTransaction(ctx, tx)
 	})
+	if err == nil && p.txObserver != nil {
+		p.txObserver(tx)
+	}
 	return err
 }
 
+// Get a copy of the manager that simulates every transaction that gets a gas estimate, recording the results for the API response.
+// The copy shares this manager's clients, so only the calls made through it are simulated.
+func (p *ExecutionClientManager) withSimulator(simulator *simulation.Simulator) *ExecutionClientManager {
+	manager := *p
+	manager.simulator = simulator
+	return &manager
+}
+
+// Record transactions for the API response instead of sending them, so they can be signed on an offline machine
+func (p *ExecutionClientManager) EnableUnsignedTransactions() {
+	p.unsignedTransactions = true
+}
+
+// Simulate a transaction and record the result
+func (p *ExecutionClientManager) simulate(ctx context.Context, call ethereum.CallMsg) {
+	result, err := p.runRpcFunction(func(client *rpc.Client) (interface{}, error) {
+		return p.simulator.Simulate(ctx, client, call)
+	})
+	if err != nil {
+		simulation.Record(api.TransactionSimulation{
+			From:  call.From,
+			To:    call.To,
+			Value: call.Value,
+			Error: err.Error(),
+		})
+		return
+	}
+	simulation.Record(result.(api.TransactionSimulation))
+}
+
+// Set a function to call with every transaction that's sent successfully
+func (p *ExecutionClientManager) SetTransactionObserver(observer func(*types.Transaction)) {
+	p.txObserver = observer
+}
+
 /// ==========================
 /// ContractFilterer Functions
 /// ==========================
@@ -334,44 +406,43 @@ func (p *ExecutionClientManager) SyncProgress(ctx context.Context) (*ethereum.Sy
 
 func (p *ExecutionClientManager) CheckStatus(cfg *config.RocketPoolConfig) *api.ClientManagerStatus {
 
-	status := &api.ClientManagerStatus{
-		FallbackEnabled: p.fallbackEc != nil,
-	}
-
 	// Ignore the sync check and just use the predefined settings if requested
-	if p.ignoreSyncCheck {
-		status.PrimaryClientStatus.IsWorking = p.primaryReady
-		status.PrimaryClientStatus.IsSynced = p.primaryReady
-		if status.FallbackEnabled {
-			status.FallbackClientStatus.IsWorking = p.fallbackReady
-			status.FallbackClientStatus.IsSynced = p.fallbackReady
-		}
-		return status
-	}
-
-	// Get the primary EC status
-	status.PrimaryClientStatus = checkEcStatus(p.primaryEc)
-
-	// Flag if primary client is ready
-	p.primaryReady = (status.PrimaryClientStatus.IsWorking && status.PrimaryClientStatus.IsSynced)
-
-	// Get the fallback EC status if applicable
-	if status.FallbackEnabled {
-		status.FallbackClientStatus = checkEcStatus(p.fallbackEc)
-		// Check if fallback is using the expected network
-		expectedChainID := cfg.Smartnode.GetChainID()
-		if status.FallbackClientStatus.Error == "" && status.FallbackClientStatus.NetworkId != expectedChainID {
-			p.fallbackReady = false
-			colorReset := "\033[0m"
-			colorYellow := "\033[33m"
-			status.FallbackClientStatus.Error = fmt.Sprintf("The fallback client is using a different chain [%s%s%s, Chain ID %d] than what your node is configured for [%s, Chain ID %d]", colorYellow, getNetworkNameFromId(status.FallbackClientStatus.NetworkId), colorReset, status.FallbackClientStatus.NetworkId, getNetworkNameFromId(expectedChainID), expectedChainID)
-			return status
-		}
+	if p.pool.ignoreSyncCheck {
+		return p.pool.getIgnoredSyncStatus()
+	}
+
+	// Check all of the clients at once
+	statuses := make([]api.ClientStatus, len(p.ecs))
+	heads := make([]uint64, len(p.ecs))
+	latencies := make([]time.Duration, len(p.ecs))
+	expectedChainID := cfg.Smartnode.GetChainID()
+	var wg sync.WaitGroup
+	for i := range p.ecs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			start := time.Now()
+			statuses[i], heads[i] = checkEcStatus(p.ecs[i])
+			latencies[i] = time.Since(start)
+
+			// Check if the client is using the expected network
+			if statuses[i].Error == "" && statuses[i].NetworkId != expectedChainID {
+				colorReset := "\033[0m"
+				colorYellow := "\033[33m"
+				statuses[i].IsSynced = false
+				statuses[i].Error = fmt.Sprintf("The client is using a different chain [%s%s%s, Chain ID %d] than what your node is configured for [%s, Chain ID %d]", colorYellow, getNetworkNameFromId(statuses[i].NetworkId), colorReset, statuses[i].NetworkId, getNetworkNameFromId(expectedChainID), expectedChainID)
+			}
+		}(i)
 	}
+	wg.Wait()
 
-	p.fallbackReady = (status.FallbackEnabled && status.FallbackClientStatus.IsWorking && status.FallbackClientStatus.IsSynced)
+	p.pool.updateStatus(statuses, heads, latencies)
+	return p.pool.getStatus()
+}
 
-	return status
+// Get the status of every client in the pool based on the latest checks and requests, without contacting them
+func (p *ExecutionClientManager) GetPoolStatus() *api.ClientManagerStatus {
+	return p.pool.getStatus()
 }
 
 func getNetworkNameFromId(networkId uint) string {
@@ -388,8 +459,8 @@ func getNetworkNameFromId(networkId uint) string {
 
 }
 
-// Check the client status
-func checkEcStatus(client *ethclient.Client) api.ClientStatus {
+// Check the client status and get its latest block number
+func checkEcStatus(client *ethclient.Client) (api.ClientStatus, uint64) {
 
 	status := api.ClientStatus{}
 
@@ -399,31 +470,39 @@ func checkEcStatus(client *ethclient.Client) api.ClientStatus {
 		status.Error = fmt.Sprintf("Sync progress check failed with [%s]", err.Error())
 		status.IsSynced = false
 		status.IsWorking = false
-		return status
+		return status, 0
 	}
 
 	if networkId != nil {
 		status.NetworkId = uint(networkId.Uint64())
 	}
 
-	// Get the fallback's sync progress
+	// Get the client's sync progress
 	progress, err := client.SyncProgress(context.Background())
 	if err != nil {
 		status.Error = fmt.Sprintf("Sync progress check failed with [%s]", err.Error())
 		status.IsSynced = false
 		status.IsWorking = false
-		return status
+		return status, 0
 	}
 
 	// Make sure it's up to date
 	if progress == nil {
 
+		head, err := client.BlockNumber(context.Background())
+		if err != nil {
+			status.Error = fmt.Sprintf("Block number check failed with [%s]", err.Error())
+			status.IsSynced = false
+			status.IsWorking = false
+			return status, 0
+		}
+
 		isUpToDate, blockTime, err := IsSyncWithinThreshold(client)
 		if err != nil {
 			status.Error = fmt.Sprintf("Error checking if client's sync progress is up to date: [%s]", err.Error())
 			status.IsSynced = false
 			status.IsWorking = false
-			return status
+			return status, 0
 		}
 
 		status.IsWorking = true
@@ -431,13 +510,13 @@ func checkEcStatus(client *ethclient.Client) api.ClientStatus {
 			status.Error = fmt.Sprintf("Client claims to have finished syncing, but its last block was from %s ago. It likely doesn't have enough peers", time.Since(blockTime))
 			status.IsSynced = false
 			status.SyncProgress = 0
-			return status
+			return status, head
 		}
 
 		// It's synced and it works!
 		status.IsSynced = true
 		status.SyncProgress = 1
-		return status
+		return status, head
 
 	}
 
@@ -453,53 +532,53 @@ func checkEcStatus(client *ethclient.Client) api.ClientStatus {
 		status.SyncProgress = 0
 	}
 
-	return status
+	return status, progress.CurrentBlock
 
 }
 
-// Attempts to run a function progressively through each client until one succeeds or they all fail.
+// Attempts to run a function on each client in order of health until one succeeds or they all fail.
 func (p *ExecutionClientManager) runFunction(function ecFunction) (interface{}, error) {
+	return p.runOnClients(func(i int) (interface{}, error) {
+		return function(p.ecs[i])
+	})
+}
 
-	// Check if we can use the primary
-	if p.primaryReady {
-		// Try to run the function on the primary
-		result, err := function(p.primaryEc)
-		if err != nil {
-			if p.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				p.logger.Printlnf("WARNING: Primary Execution client disconnected (%s), using fallback...", err.Error())
-				p.primaryReady = false
-				return p.runFunction(function)
-			}
+// Attempts to run a raw RPC function progressively through each client until one succeeds or they all fail.
+func (p *ExecutionClientManager) runRpcFunction(function rpcFunction) (interface{}, error) {
+	return p.runOnClients(func(i int) (interface{}, error) {
+		return function(p.rpcs[i])
+	})
+}
 
-			// If it's a different error, just return it
-			return nil, err
-		}
+// Runs a function against each client, in the pool's order, until one of them responds
+func (p *ExecutionClientManager) runOnClients(function func(int) (interface{}, error)) (interface{}, error) {
 
-		// If there's no error, return the result
-		return result, nil
+	order := p.pool.getOrder()
+	if len(order) == 0 {
+		return nil, fmt.Errorf("no Execution clients were ready")
 	}
 
-	if p.fallbackReady {
-		// Try to run the function on the fallback
-		result, err := function(p.fallbackEc)
+	for _, i := range order {
+		start := time.Now()
+		result, err := function(i)
 		if err != nil {
 			if p.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				p.logger.Printlnf("WARNING: Fallback Execution client disconnected (%s)", err.Error())
-				p.fallbackReady = false
-				return nil, fmt.Errorf("all Execution clients failed")
+				// If it's disconnected, log it and try the next one
+				p.pool.recordDisconnect(i, err)
+				continue
 			}
 
-			// If it's a different error, just return it
+			// If it's a different error, the client still responded so just return it
+			p.pool.recordSuccess(i, time.Since(start))
 			return nil, err
 		}
 
 		// If there's no error, return the result
+		p.pool.recordSuccess(i, time.Since(start))
 		return result, nil
 	}
 
-	return nil, fmt.Errorf("no Execution clients were ready")
+	return nil, fmt.Errorf("all Execution clients failed")
 }
 
 // Returns true if the error was a connection failure and a backup client is available
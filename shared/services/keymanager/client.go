@@ -0,0 +1,257 @@
+package keymanager
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/types"
+	hexutils "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Config
+const (
+	RequestTimeout time.Duration = 30 * time.Second
+
+	RequestKeystoresPath    string = "/eth/v1/keystores"
+	RequestRemoteKeysPath   string = "/eth/v1/remotekeys"
+	RequestFeeRecipientPath string = "/eth/v1/validator/%s/feerecipient"
+	RequestGraffitiPath     string = "/eth/v1/validator/%s/graffiti"
+
+	RequestContentType = "application/json"
+)
+
+// Returned when the Validator Client doesn't provide the requested keymanager API route
+var ErrNotSupported = errors.New("the Validator Client does not support this keymanager API route")
+
+// Import statuses
+const (
+	ImportStatus_Imported  string = "imported"
+	ImportStatus_Duplicate string = "duplicate"
+	ImportStatus_Error     string = "error"
+)
+
+// A key listed by the keymanager API
+type ListedKey struct {
+	Pubkey   types.ValidatorPubkey
+	Readonly bool
+}
+
+// The result of importing a single key
+type ImportResult struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// Keymanager API requests / responses
+type listKeysResponse struct {
+	Data []struct {
+		ValidatingPubkey string `json:"validating_pubkey"`
+		Readonly         bool   `json:"readonly"`
+	} `json:"data"`
+}
+type listRemoteKeysResponse struct {
+	Data []struct {
+		Pubkey   string `json:"pubkey"`
+		Readonly bool   `json:"readonly"`
+	} `json:"data"`
+}
+type importKeystoresRequest struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection,omitempty"`
+}
+type remoteKey struct {
+	Pubkey string `json:"pubkey"`
+	Url    string `json:"url"`
+}
+type importRemoteKeysRequest struct {
+	RemoteKeys []remoteKey `json:"remote_keys"`
+}
+type importResponse struct {
+	Data []ImportResult `json:"data"`
+}
+type setFeeRecipientRequest struct {
+	EthAddress string `json:"ethaddress"`
+}
+type setGraffitiRequest struct {
+	Graffiti string `json:"graffiti"`
+}
+
+// Client for a Validator Client's standard keymanager API
+type Client struct {
+	url       string
+	authToken string
+	client    http.Client
+}
+
+// Create a new keymanager API client
+func NewClient(url string, authToken string) *Client {
+	return &Client{
+		url:       strings.TrimSuffix(url, "/"),
+		authToken: authToken,
+		client: http.Client{
+			Timeout: RequestTimeout,
+		},
+	}
+}
+
+// Get the keys the Validator Client is currently using
+func (c *Client) ListKeystores() ([]ListedKey, error) {
+	var response listKeysResponse
+	if err := c.sendRequest(http.MethodGet, RequestKeystoresPath, nil, &response); err != nil {
+		return nil, fmt.Errorf("Could not get validator keys: %w", err)
+	}
+	keys := make([]ListedKey, len(response.Data))
+	for i, key := range response.Data {
+		pubkey, err := types.HexToValidatorPubkey(hexutils.RemovePrefix(key.ValidatingPubkey))
+		if err != nil {
+			return nil, fmt.Errorf("Could not decode validator key %s: %w", key.ValidatingPubkey, err)
+		}
+		keys[i] = ListedKey{
+			Pubkey:   pubkey,
+			Readonly: key.Readonly,
+		}
+	}
+	return keys, nil
+}
+
+// Get the remote signer keys the Validator Client is currently using
+func (c *Client) ListRemoteKeys() ([]ListedKey, error) {
+	var response listRemoteKeysResponse
+	if err := c.sendRequest(http.MethodGet, RequestRemoteKeysPath, nil, &response); err != nil {
+		return nil, fmt.Errorf("Could not get remote validator keys: %w", err)
+	}
+	keys := make([]ListedKey, len(response.Data))
+	for i, key := range response.Data {
+		pubkey, err := types.HexToValidatorPubkey(hexutils.RemovePrefix(key.Pubkey))
+		if err != nil {
+			return nil, fmt.Errorf("Could not decode validator key %s: %w", key.Pubkey, err)
+		}
+		keys[i] = ListedKey{
+			Pubkey:   pubkey,
+			Readonly: key.Readonly,
+		}
+	}
+	return keys, nil
+}
+
+// Import EIP-2335 keystores into the Validator Client, optionally with EIP-3076 slashing protection data
+func (c *Client) ImportKeystores(keystores []string, passwords []string, slashingProtection string) ([]ImportResult, error) {
+	request := importKeystoresRequest{
+		Keystores:          keystores,
+		Passwords:          passwords,
+		SlashingProtection: slashingProtection,
+	}
+	var response importResponse
+	if err := c.sendRequest(http.MethodPost, RequestKeystoresPath, request, &response); err != nil {
+		return nil, fmt.Errorf("Could not import validator keys: %w", err)
+	}
+	if len(response.Data) != len(keystores) {
+		return nil, fmt.Errorf("Could not import validator keys: expected %d results but got %d", len(keystores), len(response.Data))
+	}
+	return response.Data, nil
+}
+
+// Register keys held by a remote signer with the Validator Client
+func (c *Client) ImportRemoteKeys(pubkeys []types.ValidatorPubkey, signerUrl string) ([]ImportResult, error) {
+	request := importRemoteKeysRequest{
+		RemoteKeys: make([]remoteKey, len(pubkeys)),
+	}
+	for i, pubkey := range pubkeys {
+		request.RemoteKeys[i] = remoteKey{
+			Pubkey: hexutil.Encode(pubkey.Bytes()),
+			Url:    signerUrl,
+		}
+	}
+	var response importResponse
+	if err := c.sendRequest(http.MethodPost, RequestRemoteKeysPath, request, &response); err != nil {
+		return nil, fmt.Errorf("Could not import remote validator keys: %w", err)
+	}
+	if len(response.Data) != len(pubkeys) {
+		return nil, fmt.Errorf("Could not import remote validator keys: expected %d results but got %d", len(pubkeys), len(response.Data))
+	}
+	return response.Data, nil
+}
+
+// Set the fee recipient for a validator
+func (c *Client) SetFeeRecipient(pubkey types.ValidatorPubkey, feeRecipient common.Address) error {
+	request := setFeeRecipientRequest{
+		EthAddress: feeRecipient.Hex(),
+	}
+	if err := c.sendRequest(http.MethodPost, fmt.Sprintf(RequestFeeRecipientPath, hexutil.Encode(pubkey.Bytes())), request, nil); err != nil {
+		return fmt.Errorf("Could not set fee recipient for validator %s: %w", pubkey.Hex(), err)
+	}
+	return nil
+}
+
+// Set the graffiti for a validator
+func (c *Client) SetGraffiti(pubkey types.ValidatorPubkey, graffiti string) error {
+	request := setGraffitiRequest{
+		Graffiti: graffiti,
+	}
+	if err := c.sendRequest(http.MethodPost, fmt.Sprintf(RequestGraffitiPath, hexutil.Encode(pubkey.Bytes())), request, nil); err != nil {
+		return fmt.Errorf("Could not set graffiti for validator %s: %w", pubkey.Hex(), err)
+	}
+	return nil
+}
+
+// Send a request to the keymanager API and decode its JSON response, if one is expected
+func (c *Client) sendRequest(method string, path string, body interface{}, response interface{}) error {
+
+	// Encode the body
+	var bodyReader io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error encoding request body: %w", err)
+		}
+		bodyReader = bytes.NewReader(bodyBytes)
+	}
+
+	// Build the request
+	request, err := http.NewRequest(method, c.url+path, bodyReader)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	request.Header.Set("Content-Type", RequestContentType)
+	request.Header.Set("Accept", RequestContentType)
+	if c.authToken != "" {
+		request.Header.Set("Authorization", "Bearer "+c.authToken)
+	}
+
+	// Send it
+	resp, err := c.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	responseBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response: %w", err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return fmt.Errorf("%w (HTTP status %d)", ErrNotSupported, resp.StatusCode)
+	default:
+		return fmt.Errorf("HTTP status %d; response body: '%s'", resp.StatusCode, string(responseBytes))
+	}
+
+	// Decode the response
+	if response == nil {
+		return nil
+	}
+	if err := json.Unmarshal(responseBytes, response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+	return nil
+
+}
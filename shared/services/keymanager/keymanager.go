@@ -0,0 +1,195 @@
+package keymanager
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/docker/docker/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+// Get a client for the Validator Client's keymanager API, if one has been configured
+func GetClient(cfg *config.RocketPoolConfig) (*Client, bool) {
+	url := cfg.Smartnode.KeymanagerApiUrl.Value.(string)
+	if url == "" {
+		return nil, false
+	}
+	return NewClient(url, cfg.Smartnode.KeymanagerApiToken.Value.(string)), true
+}
+
+// Load new validator keys and their graffiti into the Validator Client.
+// This uses the keymanager API if it's available and falls back to restarting the Validator Client if it isn't.
+// Returns true if the keys were loaded without a restart.
+func LoadValidatorKeys(cfg *config.RocketPoolConfig, bc beacon.Client, log *log.ColorLogger, d *client.Client, w *wallet.Wallet, pubkeys []types.ValidatorPubkey) (bool, error) {
+
+	kmClient, ok := GetClient(cfg)
+	if ok {
+		err := importValidatorKeys(cfg, kmClient, w, pubkeys)
+		if err == nil {
+			if log != nil {
+				log.Printlnf("Loaded %d validator key(s) into the Validator Client through its keymanager API.", len(pubkeys))
+			}
+
+			// The keys are already loaded with their slashing protection history, so a restart is only needed to pick up the graffiti
+			err = setGraffiti(cfg, kmClient, pubkeys)
+			if err == nil {
+				return true, nil
+			}
+			if log != nil {
+				log.Printlnf("WARNING: couldn't set the graffiti through the keymanager API, falling back to restarting the Validator Client: %s", err.Error())
+			}
+			return false, validator.RestartValidator(cfg, bc, log, d)
+		}
+		if log != nil {
+			log.Printlnf("WARNING: couldn't load validator keys through the keymanager API, falling back to restarting the Validator Client: %s", err.Error())
+		}
+	}
+
+	// A restart only loads the keys, so don't do it if they have a signing history the Validator Client doesn't know about yet
+	if cfg.Smartnode.RemoteSignerUrl.Value.(string) == "" {
+		slashingProtection, err := getSlashingProtection(w, pubkeys)
+		if err != nil {
+			return false, err
+		}
+		if slashingProtection != "" {
+			return false, errors.New("the validator keys have a saved slashing protection history, which the Validator Client can only load with the keys through its keymanager API; please run `rocketpool wallet import-slashing-protection` to import it and restart the Validator Client")
+		}
+	}
+
+	return false, validator.RestartValidator(cfg, bc, log, d)
+
+}
+
+// Apply a new fee recipient to every key in the Validator Client.
+// This uses the keymanager API if it's available and falls back to restarting the Validator Client (so it picks up the fee recipient file) if it isn't.
+// Returns true if the fee recipient was applied without a restart.
+func SetFeeRecipient(cfg *config.RocketPoolConfig, bc beacon.Client, log *log.ColorLogger, d *client.Client, feeRecipient common.Address) (bool, error) {
+
+	kmClient, ok := GetClient(cfg)
+	if ok {
+		err := setFeeRecipient(cfg, kmClient, feeRecipient)
+		if err == nil {
+			if log != nil {
+				log.Printlnf("Set the fee recipient to %s through the Validator Client's keymanager API.", feeRecipient.Hex())
+			}
+			return true, nil
+		}
+		if log != nil {
+			log.Printlnf("WARNING: couldn't set the fee recipient through the keymanager API, falling back to restarting the Validator Client: %s", err.Error())
+		}
+	}
+
+	return false, validator.RestartValidator(cfg, bc, log, d)
+
+}
+
+// Import validator keys through the keymanager API, either as local keystores or as keys held by the remote signer
+func importValidatorKeys(cfg *config.RocketPoolConfig, kmClient *Client, w *wallet.Wallet, pubkeys []types.ValidatorPubkey) error {
+
+	if len(pubkeys) == 0 {
+		return nil
+	}
+
+	var results []ImportResult
+	var err error
+	if remoteSignerUrl := cfg.Smartnode.RemoteSignerUrl.Value.(string); remoteSignerUrl != "" {
+		// The remote signer already holds the keys, so the Validator Client just needs to know about them
+		results, err = kmClient.ImportRemoteKeys(pubkeys, remoteSignerUrl)
+		if err != nil {
+			return err
+		}
+	} else {
+		// Encrypt each key with a one-off password for the transfer
+		keystores := make([]string, len(pubkeys))
+		passwords := make([]string, len(pubkeys))
+		for i, pubkey := range pubkeys {
+			key, err := w.LoadValidatorKey(pubkey)
+			if err != nil {
+				return err
+			}
+			keystoreBytes, password, err := keystore.CreateEncryptedKeystore(key, "")
+			if err != nil {
+				return fmt.Errorf("error creating keystore for validator %s: %w", pubkey.Hex(), err)
+			}
+			keystores[i] = string(keystoreBytes)
+			passwords[i] = password
+		}
+		// Send the keys' saved signing history with them, so the Validator Client won't sign anything that conflicts with it
+		slashingProtection, err := getSlashingProtection(w, pubkeys)
+		if err != nil {
+			return err
+		}
+		results, err = kmClient.ImportKeystores(keystores, passwords, slashingProtection)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Make sure every key was accepted
+	for i, result := range results {
+		if result.Status != ImportStatus_Imported && result.Status != ImportStatus_Duplicate {
+			return fmt.Errorf("validator %s could not be imported: status '%s' (%s)", pubkeys[i].Hex(), result.Status, result.Message)
+		}
+	}
+	return nil
+
+}
+
+// Set the fee recipient for all of the Validator Client's keys through the keymanager API
+func setFeeRecipient(cfg *config.RocketPoolConfig, kmClient *Client, feeRecipient common.Address) error {
+	keys, err := kmClient.ListKeystores()
+	if err != nil {
+		return err
+	}
+	if cfg.Smartnode.RemoteSignerUrl.Value.(string) != "" {
+		remoteKeys, err := kmClient.ListRemoteKeys()
+		if err != nil {
+			return err
+		}
+		keys = append(keys, remoteKeys...)
+	}
+	for _, key := range keys {
+		if err := kmClient.SetFeeRecipient(key.Pubkey, feeRecipient); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Set the node's graffiti for the provided keys through the keymanager API, unless the graffiti wall writer addon is managing it
+func setGraffiti(cfg *config.RocketPoolConfig, kmClient *Client, pubkeys []types.ValidatorPubkey) error {
+	if cfg.GraffitiWallWriter.GetEnabledParameter().Value == true {
+		return nil
+	}
+	graffiti := cfg.GenerateEnvironmentVariables()["GRAFFITI"]
+	for _, pubkey := range pubkeys {
+		if err := kmClient.SetGraffiti(pubkey, graffiti); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get the saved signing history of the provided keys as a serialized EIP-3076 interchange, or a blank string if there isn't any
+func getSlashingProtection(w *wallet.Wallet, pubkeys []types.ValidatorPubkey) (string, error) {
+	interchange, exists, err := w.ExportSlashingProtection(pubkeys)
+	if err != nil {
+		return "", fmt.Errorf("error loading slashing protection history: %w", err)
+	}
+	if !exists || len(interchange.Data) == 0 {
+		return "", nil
+	}
+	bytes, err := json.Marshal(interchange)
+	if err != nil {
+		return "", fmt.Errorf("error serializing slashing protection history: %w", err)
+	}
+	return string(bytes), nil
+}
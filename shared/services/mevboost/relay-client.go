@@ -0,0 +1,150 @@
+package mevboost
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/types"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+)
+
+// Config
+const (
+	RequestTimeout time.Duration = 15 * time.Second
+
+	RequestStatusPath                string = "/eth/v1/builder/status"
+	RequestValidatorRegistrationPath string = "/relay/v1/data/validator_registration"
+
+	RequestContentType = "application/json"
+)
+
+// A validator's registration with a relay
+type ValidatorRegistration struct {
+	Pubkey       types.ValidatorPubkey
+	FeeRecipient common.Address
+	GasLimit     uint64
+	Timestamp    time.Time
+}
+
+// Relay API responses
+type validatorRegistrationResponse struct {
+	Message struct {
+		FeeRecipient string `json:"fee_recipient"`
+		GasLimit     string `json:"gas_limit"`
+		Timestamp    string `json:"timestamp"`
+		Pubkey       string `json:"pubkey"`
+	} `json:"message"`
+	Signature string `json:"signature"`
+}
+
+// Client for a MEV-boost relay's builder and data APIs
+type RelayClient struct {
+	url    string
+	client http.Client
+}
+
+// Create a new relay client from a relay URL, which includes the relay's public key
+func NewRelayClient(relayUrl string) (*RelayClient, error) {
+	_, parsedUrl, err := config.ParseMevRelayUrl(relayUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	// The public key is only used by MEV-boost to verify bids, so it isn't sent to the relay
+	baseUrl := url.URL{
+		Scheme: parsedUrl.Scheme,
+		Host:   parsedUrl.Host,
+		Path:   strings.TrimSuffix(parsedUrl.Path, "/"),
+	}
+	return &RelayClient{
+		url: baseUrl.String(),
+		client: http.Client{
+			Timeout: RequestTimeout,
+		},
+	}, nil
+}
+
+// Check that the relay is up and ready to serve requests
+func (c *RelayClient) CheckStatus() error {
+	_, statusCode, err := c.sendGetRequest(RequestStatusPath)
+	if err != nil {
+		return fmt.Errorf("Could not get relay status: %w", err)
+	}
+	if statusCode != http.StatusOK {
+		return fmt.Errorf("Relay is not ready (HTTP status %d)", statusCode)
+	}
+	return nil
+}
+
+// Get a validator's latest registration with the relay. Returns false if the validator isn't registered.
+func (c *RelayClient) GetValidatorRegistration(pubkey types.ValidatorPubkey) (ValidatorRegistration, bool, error) {
+	path := fmt.Sprintf("%s?pubkey=%s", RequestValidatorRegistrationPath, hexutil.Encode(pubkey.Bytes()))
+	responseBytes, statusCode, err := c.sendGetRequest(path)
+	if err != nil {
+		return ValidatorRegistration{}, false, fmt.Errorf("Could not get registration for validator %s: %w", pubkey.Hex(), err)
+	}
+	switch statusCode {
+	case http.StatusOK:
+	case http.StatusBadRequest, http.StatusNotFound:
+		// Relays respond with one of these when they don't have a registration for the validator
+		return ValidatorRegistration{}, false, nil
+	default:
+		return ValidatorRegistration{}, false, fmt.Errorf("Could not get registration for validator %s: HTTP status %d; response body: '%s'", pubkey.Hex(), statusCode, string(responseBytes))
+	}
+
+	// Decode the response
+	var response validatorRegistrationResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return ValidatorRegistration{}, false, fmt.Errorf("Could not decode registration for validator %s: %w", pubkey.Hex(), err)
+	}
+	registration := ValidatorRegistration{
+		Pubkey: pubkey,
+	}
+	if !common.IsHexAddress(response.Message.FeeRecipient) {
+		return ValidatorRegistration{}, false, fmt.Errorf("Registration for validator %s has an invalid fee recipient [%s]", pubkey.Hex(), response.Message.FeeRecipient)
+	}
+	registration.FeeRecipient = common.HexToAddress(response.Message.FeeRecipient)
+	if response.Message.GasLimit != "" {
+		registration.GasLimit, err = strconv.ParseUint(response.Message.GasLimit, 10, 64)
+		if err != nil {
+			return ValidatorRegistration{}, false, fmt.Errorf("Registration for validator %s has an invalid gas limit [%s]: %w", pubkey.Hex(), response.Message.GasLimit, err)
+		}
+	}
+	if response.Message.Timestamp != "" {
+		timestamp, err := strconv.ParseInt(response.Message.Timestamp, 10, 64)
+		if err != nil {
+			return ValidatorRegistration{}, false, fmt.Errorf("Registration for validator %s has an invalid timestamp [%s]: %w", pubkey.Hex(), response.Message.Timestamp, err)
+		}
+		registration.Timestamp = time.Unix(timestamp, 0)
+	}
+	return registration, true, nil
+}
+
+// Send a GET request to the relay, returning the response body and status code
+func (c *RelayClient) sendGetRequest(path string) ([]byte, int, error) {
+	request, err := http.NewRequest(http.MethodGet, c.url+path, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error creating request: %w", err)
+	}
+	request.Header.Set("Accept", RequestContentType)
+
+	resp, err := c.client.Do(request)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	responseBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error reading response: %w", err)
+	}
+	return responseBytes, resp.StatusCode, nil
+}
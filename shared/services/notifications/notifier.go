@@ -0,0 +1,175 @@
+package notifications
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Settings
+const (
+	sendTimeout = 15 * time.Second
+)
+
+// The events the node can send notifications about
+type EventType string
+
+const (
+	EventType_MinipoolStaked        EventType = "minipoolStaked"
+	EventType_BondReduced           EventType = "bondReduced"
+	EventType_FeeRecipientCorrected EventType = "feeRecipientCorrected"
+	EventType_ClientFallback        EventType = "clientFallback"
+	EventType_LowEthBalance         EventType = "lowEthBalance"
+	EventType_ValidatorOffline      EventType = "validatorOffline"
+	EventType_ScrubVote             EventType = "scrubVote"
+)
+
+// A notification about something that happened on the node
+type Notification struct {
+	Event       EventType      `json:"event"`
+	Title       string         `json:"title"`
+	Message     string         `json:"message"`
+	NodeAddress common.Address `json:"nodeAddress"`
+	Network     string         `json:"network"`
+	Time        time.Time      `json:"time"`
+}
+
+// A destination that notifications can be sent to
+type sink interface {
+	GetName() string
+	Send(notification Notification) error
+}
+
+// Sends notifications to every sink that's configured, for the events that are enabled
+type Notifier struct {
+	cfg         *config.RocketPoolConfig
+	nodeAddress common.Address
+	sinks       []sink
+}
+
+// Create a new notifier from the Smartnode config
+func NewNotifier(cfg *config.RocketPoolConfig, nodeAddress common.Address) *Notifier {
+
+	httpClient := &http.Client{
+		Timeout: sendTimeout,
+	}
+
+	sinks := []sink{}
+	smartnode := cfg.Smartnode
+	if url := smartnode.NotificationWebhookUrl.Value.(string); url != "" {
+		sinks = append(sinks, &webhookSink{
+			url:        url,
+			httpClient: httpClient,
+		})
+	}
+	if url := smartnode.NotificationChatWebhookUrl.Value.(string); url != "" {
+		sinks = append(sinks, &chatSink{
+			url:        url,
+			httpClient: httpClient,
+		})
+	}
+	if host := smartnode.NotificationSmtpHost.Value.(string); host != "" {
+		recipients := []string{}
+		for _, recipient := range strings.Split(smartnode.NotificationSmtpTo.Value.(string), ",") {
+			recipient = strings.TrimSpace(recipient)
+			if recipient != "" {
+				recipients = append(recipients, recipient)
+			}
+		}
+		sinks = append(sinks, &smtpSink{
+			host:     host,
+			port:     smartnode.NotificationSmtpPort.Value.(uint16),
+			username: smartnode.NotificationSmtpUsername.Value.(string),
+			password: smartnode.NotificationSmtpPassword.Value.(string),
+			from:     smartnode.NotificationSmtpFrom.Value.(string),
+			to:       recipients,
+		})
+	}
+	if url := smartnode.NotificationNtfyUrl.Value.(string); url != "" {
+		sinks = append(sinks, &ntfySink{
+			url:        url,
+			token:      smartnode.NotificationNtfyToken.Value.(string),
+			httpClient: httpClient,
+		})
+	}
+
+	return &Notifier{
+		cfg:         cfg,
+		nodeAddress: nodeAddress,
+		sinks:       sinks,
+	}
+
+}
+
+// Check if notifications for an event are enabled, and there's somewhere to send them
+func (n *Notifier) IsEnabled(event EventType) bool {
+	if len(n.sinks) == 0 {
+		return false
+	}
+
+	smartnode := n.cfg.Smartnode
+	switch event {
+	case EventType_MinipoolStaked:
+		return smartnode.NotifyMinipoolStaked.Value == true
+	case EventType_BondReduced:
+		return smartnode.NotifyBondReduced.Value == true
+	case EventType_FeeRecipientCorrected:
+		return smartnode.NotifyFeeRecipientCorrected.Value == true
+	case EventType_ClientFallback:
+		return smartnode.NotifyClientFallback.Value == true
+	case EventType_LowEthBalance:
+		return smartnode.NotifyLowEthBalance.Value == true
+	case EventType_ValidatorOffline:
+		return smartnode.NotifyValidatorOffline.Value == true
+	case EventType_ScrubVote:
+		return smartnode.NotifyScrubVote.Value == true
+	default:
+		return false
+	}
+}
+
+// Send a notification about an event to every sink, if notifications for it are enabled.
+// Notifications are best-effort, so failures are logged instead of interrupting the caller.
+func (n *Notifier) Notify(logger *log.ColorLogger, event EventType, title string, message string) {
+
+	if !n.IsEnabled(event) {
+		return
+	}
+
+	notification := Notification{
+		Event:       event,
+		Title:       title,
+		Message:     message,
+		NodeAddress: n.nodeAddress,
+		Network:     string(n.cfg.Smartnode.Network.Value.(cfgtypes.Network)),
+		Time:        time.Now().UTC(),
+	}
+	err := n.send(notification)
+	if err != nil {
+		logger.Printlnf("WARNING: couldn't send the '%s' notification: %s", title, err.Error())
+	}
+
+}
+
+// Send a notification to every sink, returning the errors of the ones that failed
+func (n *Notifier) send(notification Notification) error {
+	errs := []string{}
+	for _, sink := range n.sinks {
+		err := sink.Send(notification)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", sink.GetName(), err.Error()))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package notifications
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// The priorities of each event on ntfy; 3 is ntfy's default and 5 is its highest
+var ntfyPriorities = map[EventType]string{
+	EventType_MinipoolStaked:        "3",
+	EventType_BondReduced:           "3",
+	EventType_FeeRecipientCorrected: "4",
+	EventType_ClientFallback:        "4",
+	EventType_LowEthBalance:         "4",
+	EventType_ValidatorOffline:      "5",
+	EventType_ScrubVote:             "5",
+}
+
+// Sends notifications as push notifications to an ntfy topic
+type ntfySink struct {
+	url        string
+	token      string
+	httpClient *http.Client
+}
+
+func (s *ntfySink) GetName() string {
+	return "ntfy"
+}
+
+func (s *ntfySink) Send(notification Notification) error {
+	message := fmt.Sprintf("%s\n\nNode %s on %s", notification.Message, notification.NodeAddress.Hex(), notification.Network)
+	request, err := http.NewRequest(http.MethodPost, s.url, strings.NewReader(message))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	request.Header.Set("Title", notification.Title)
+	request.Header.Set("Tags", string(notification.Event))
+	if priority, exists := ntfyPriorities[notification.Event]; exists {
+		request.Header.Set("Priority", priority)
+	}
+	if s.token != "" {
+		request.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	return sendRequest(s.httpClient, request)
+}
@@ -0,0 +1,115 @@
+package notifications
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// The SMTP port that uses implicit TLS instead of STARTTLS
+const smtpImplicitTlsPort uint16 = 465
+
+// Sends notifications as emails through an SMTP server
+type smtpSink struct {
+	host     string
+	port     uint16
+	username string
+	password string
+	from     string
+	to       []string
+}
+
+func (s *smtpSink) GetName() string {
+	return "email"
+}
+
+func (s *smtpSink) Send(notification Notification) error {
+
+	if s.from == "" || len(s.to) == 0 {
+		return errors.New("the email sender and recipients must be set")
+	}
+
+	// Build the message
+	var message strings.Builder
+	message.WriteString(fmt.Sprintf("From: %s\r\n", s.from))
+	message.WriteString(fmt.Sprintf("To: %s\r\n", strings.Join(s.to, ", ")))
+	message.WriteString(fmt.Sprintf("Subject: [Rocket Pool] %s\r\n", notification.Title))
+	message.WriteString(fmt.Sprintf("Date: %s\r\n", notification.Time.Format(time.RFC1123Z)))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	message.WriteString("\r\n")
+	message.WriteString(strings.ReplaceAll(notification.Message, "\n", "\r\n"))
+	message.WriteString(fmt.Sprintf("\r\n\r\nNode %s on %s\r\n", notification.NodeAddress.Hex(), notification.Network))
+
+	// Connect to the server
+	address := net.JoinHostPort(s.host, fmt.Sprint(s.port))
+	var conn net.Conn
+	var err error
+	if s.port == smtpImplicitTlsPort {
+		conn, err = tls.DialWithDialer(&net.Dialer{Timeout: sendTimeout}, "tcp", address, &tls.Config{ServerName: s.host})
+	} else {
+		conn, err = net.DialTimeout("tcp", address, sendTimeout)
+	}
+	if err != nil {
+		return fmt.Errorf("error connecting to %s: %w", address, err)
+	}
+
+	// Bound the whole session, so a server that stops responding can't block the caller
+	err = conn.SetDeadline(time.Now().Add(sendTimeout))
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("error setting SMTP session deadline: %w", err)
+	}
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("error starting SMTP session: %w", err)
+	}
+	if s.port != smtpImplicitTlsPort {
+		if supported, _ := client.Extension("STARTTLS"); supported {
+			err = client.StartTLS(&tls.Config{ServerName: s.host})
+			if err != nil {
+				client.Close()
+				return fmt.Errorf("error starting TLS: %w", err)
+			}
+		}
+	}
+	defer client.Close()
+
+	// Send the message
+	if s.username != "" {
+		err = client.Auth(smtp.PlainAuth("", s.username, s.password, s.host))
+		if err != nil {
+			return fmt.Errorf("error logging into SMTP server: %w", err)
+		}
+	}
+	err = client.Mail(s.from)
+	if err != nil {
+		return fmt.Errorf("error setting sender: %w", err)
+	}
+	for _, recipient := range s.to {
+		err = client.Rcpt(recipient)
+		if err != nil {
+			return fmt.Errorf("error adding recipient %s: %w", recipient, err)
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("error starting message: %w", err)
+	}
+	_, err = writer.Write([]byte(message.String()))
+	if err != nil {
+		writer.Close()
+		return fmt.Errorf("error writing message: %w", err)
+	}
+	err = writer.Close()
+	if err != nil {
+		return fmt.Errorf("error sending message: %w", err)
+	}
+	return client.Quit()
+
+}
@@ -0,0 +1,101 @@
+package notifications
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+// The most of an error response body to include in errors
+const maxErrorBodyLength int64 = 512
+
+// Sends notifications as JSON to a generic webhook
+type webhookSink struct {
+	url        string
+	httpClient *http.Client
+}
+
+func (s *webhookSink) GetName() string {
+	return "webhook"
+}
+
+func (s *webhookSink) Send(notification Notification) error {
+	return postJson(s.httpClient, s.url, notification)
+}
+
+// Sends notifications as chat messages to a Discord or Slack webhook
+type chatSink struct {
+	url        string
+	httpClient *http.Client
+}
+
+// The body of a Discord webhook message
+type discordMessage struct {
+	Content string `json:"content"`
+}
+
+// The body of a Slack webhook message
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+func (s *chatSink) GetName() string {
+	if s.isDiscord() {
+		return "Discord webhook"
+	}
+	return "Slack webhook"
+}
+
+func (s *chatSink) Send(notification Notification) error {
+	if s.isDiscord() {
+		return postJson(s.httpClient, s.url, discordMessage{
+			Content: fmt.Sprintf("**%s**\n%s\n_Node %s on %s_", notification.Title, notification.Message, notification.NodeAddress.Hex(), notification.Network),
+		})
+	}
+	return postJson(s.httpClient, s.url, slackMessage{
+		Text: fmt.Sprintf("*%s*\n%s\n_Node %s on %s_", notification.Title, notification.Message, notification.NodeAddress.Hex(), notification.Network),
+	})
+}
+
+// Check if the webhook is a Discord one; Discord and Slack use different message formats
+func (s *chatSink) isDiscord() bool {
+	parsedUrl, err := url.Parse(s.url)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(parsedUrl.Hostname())
+	return host == "discord.com" || host == "discordapp.com" || strings.HasSuffix(host, ".discord.com") || strings.HasSuffix(host, ".discordapp.com")
+}
+
+// POST a JSON body to a URL, checking that the server accepted it
+func postJson(httpClient *http.Client, url string, body interface{}) error {
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("error serializing notification: %w", err)
+	}
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	return sendRequest(httpClient, request)
+}
+
+// Send a request, checking that the server accepted it
+func sendRequest(httpClient *http.Client, request *http.Request) error {
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, maxErrorBodyLength))
+		return fmt.Errorf("the server returned %s: %s", response.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package penalties
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Load the illegal fee recipient violations that the watchtower saved to disk
+func LoadViolations(path string) ([]api.PenaltyViolation, error) {
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return []api.PenaltyViolation{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading penalties file %s: %w", path, err)
+	}
+
+	violations := []api.PenaltyViolation{}
+	err = json.Unmarshal(bytes, &violations)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing penalties file %s: %w", path, err)
+	}
+	return violations, nil
+}
+
+// Save the illegal fee recipient violations to disk, ordered by slot; the provided slice is left in its original order
+func SaveViolations(path string, violations []api.PenaltyViolation) error {
+	sorted := make([]api.PenaltyViolation, len(violations))
+	copy(sorted, violations)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Slot < sorted[j].Slot
+	})
+
+	bytes, err := json.MarshalIndent(sorted, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing penalties: %w", err)
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return fmt.Errorf("error creating penalties folder: %w", err)
+	}
+
+	// Write to a temporary file first so a crash can't leave a partial file behind
+	tempPath := path + ".tmp"
+	err = os.WriteFile(tempPath, bytes, 0644)
+	if err != nil {
+		return fmt.Errorf("error writing penalties file %s: %w", tempPath, err)
+	}
+	err = os.Rename(tempPath, path)
+	if err != nil {
+		return fmt.Errorf("error replacing penalties file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Get the index of the violation for the given minipool and slot, or -1 if it hasn't been recorded
+func FindViolation(violations []api.PenaltyViolation, slot uint64, minipoolAddress common.Address) int {
+	for i, violation := range violations {
+		if violation.Slot == slot && violation.MinipoolAddress == minipoolAddress {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,111 @@
+package performance
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// A summary of a validator's performance over a range of epochs
+type Summary struct {
+	AttestationDuties      uint64
+	AttestationsIncluded   uint64
+	MissedAttestationSlots []uint64
+	Proposals              uint64
+	MissedProposals        uint64
+	MissedProposalSlots    []uint64
+	SyncDuties             uint64
+	SyncParticipation      uint64
+}
+
+// Get the fraction of attestation duties that were included, or 1 if there weren't any duties
+func (s Summary) AttestationHitRate() float64 {
+	if s.AttestationDuties == 0 {
+		return 1
+	}
+	return float64(s.AttestationsIncluded) / float64(s.AttestationDuties)
+}
+
+// Get the fraction of sync committee duties that were fulfilled, or 1 if there weren't any duties
+func (s Summary) SyncParticipationRate() float64 {
+	if s.SyncDuties == 0 {
+		return 1
+	}
+	return float64(s.SyncParticipation) / float64(s.SyncDuties)
+}
+
+// Summarize a validator's performance over the epochs at or after the given epoch
+func Summarize(epochs []api.ValidatorEpochPerformance, startEpoch uint64) Summary {
+	summary := Summary{
+		MissedAttestationSlots: []uint64{},
+		MissedProposalSlots:    []uint64{},
+	}
+	for _, epoch := range epochs {
+		if epoch.Epoch < startEpoch {
+			continue
+		}
+		if epoch.HasAttestationDuty {
+			summary.AttestationDuties++
+			if epoch.AttestationIncluded {
+				summary.AttestationsIncluded++
+			} else {
+				summary.MissedAttestationSlots = append(summary.MissedAttestationSlots, epoch.AttestationSlot)
+			}
+		}
+		summary.Proposals += uint64(len(epoch.ProposalSlots))
+		summary.MissedProposals += uint64(len(epoch.MissedProposalSlots))
+		summary.MissedProposalSlots = append(summary.MissedProposalSlots, epoch.MissedProposalSlots...)
+		summary.SyncDuties += epoch.SyncDuties
+		summary.SyncParticipation += epoch.SyncParticipation
+	}
+	return summary
+}
+
+// Load the validator performance record that the node daemon saved to disk
+func LoadRecord(path string) (*api.MinipoolPerformanceRecord, bool, error) {
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("error reading validator performance file %s: %w", path, err)
+	}
+
+	var record api.MinipoolPerformanceRecord
+	err = json.Unmarshal(bytes, &record)
+	if err != nil {
+		return nil, false, fmt.Errorf("error deserializing validator performance file %s: %w", path, err)
+	}
+	if record.Minipools == nil {
+		record.Minipools = map[string]*api.MinipoolPerformance{}
+	}
+	return &record, true, nil
+}
+
+// Save the validator performance record to disk so the API can report it
+func SaveRecord(path string, record *api.MinipoolPerformanceRecord) error {
+	bytes, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("error serializing validator performance: %w", err)
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return fmt.Errorf("error creating validator performance folder: %w", err)
+	}
+
+	// Write to a temporary file first so the API never reads a partial file
+	tempPath := path + ".tmp"
+	err = os.WriteFile(tempPath, bytes, 0644)
+	if err != nil {
+		return fmt.Errorf("error writing validator performance file %s: %w", tempPath, err)
+	}
+	err = os.Rename(tempPath, path)
+	if err != nil {
+		return fmt.Errorf("error replacing validator performance file %s: %w", path, err)
+	}
+	return nil
+}
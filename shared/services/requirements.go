@@ -329,12 +329,12 @@ func checkExecutionClientStatus(ecMgr *ExecutionClientManager, cfg *config.Rocke
 
 	// Check the EC status
 	mgrStatus := ecMgr.CheckStatus(cfg)
-	if ecMgr.primaryReady {
+	if ecMgr.pool.isPrimaryReady() {
 		return true, nil, nil
 	}
 
-	// If the primary isn't synced but there's a fallback and it is, return true
-	if ecMgr.fallbackReady {
+	// If the primary isn't synced but one of the other clients is, return true
+	if len(ecMgr.pool.getOrder()) > 0 {
 		if mgrStatus.PrimaryClientStatus.Error != "" {
 			log.Printf("Primary execution client is unavailable (%s), using fallback execution client...\n", mgrStatus.PrimaryClientStatus.Error)
 		} else {
@@ -343,23 +343,23 @@ func checkExecutionClientStatus(ecMgr *ExecutionClientManager, cfg *config.Rocke
 		return true, nil, nil
 	}
 
-	// If neither is synced, go through the status to figure out what to do
+	// If none are synced, go through the status to figure out what to do
 
-	// Is the primary working and syncing? If so, wait for it
-	if mgrStatus.PrimaryClientStatus.IsWorking && mgrStatus.PrimaryClientStatus.Error == "" {
-		log.Printf("Fallback execution client is not configured or unavailable, waiting for primary execution client to finish syncing (%.2f%%)\n", mgrStatus.PrimaryClientStatus.SyncProgress*100)
-		return false, ecMgr.primaryEc, nil
-	}
-
-	// Is the fallback working and syncing? If so, wait for it
-	if mgrStatus.FallbackEnabled && mgrStatus.FallbackClientStatus.IsWorking && mgrStatus.FallbackClientStatus.Error == "" {
-		log.Printf("Primary execution client is unavailable (%s), waiting for the fallback execution client to finish syncing (%.2f%%)\n", mgrStatus.PrimaryClientStatus.Error, mgrStatus.FallbackClientStatus.SyncProgress*100)
-		return false, ecMgr.fallbackEc, nil
+	// Is one of the clients working and syncing? If so, wait for it
+	for i, endpoint := range mgrStatus.Endpoints {
+		if endpoint.Status.IsWorking && endpoint.Status.Error == "" {
+			if i == 0 {
+				log.Printf("Fallback execution clients are not configured or unavailable, waiting for primary execution client to finish syncing (%.2f%%)\n", endpoint.Status.SyncProgress*100)
+			} else {
+				log.Printf("Primary execution client is unavailable (%s), waiting for the fallback execution client at %s to finish syncing (%.2f%%)\n", mgrStatus.PrimaryClientStatus.Error, endpoint.Endpoint, endpoint.Status.SyncProgress*100)
+			}
+			return false, ecMgr.ecs[i], nil
+		}
 	}
 
-	// If neither client is working, report the errors
+	// If no client is working, report the errors
 	if mgrStatus.FallbackEnabled {
-		return false, nil, fmt.Errorf("Primary execution client is unavailable (%s) and fallback execution client is unavailable (%s), no execution clients are ready.", mgrStatus.PrimaryClientStatus.Error, mgrStatus.FallbackClientStatus.Error)
+		return false, nil, fmt.Errorf("No execution clients are ready: %s", ecMgr.pool.getErrorSummary())
 	}
 
 	return false, nil, fmt.Errorf("Primary execution client is unavailable (%s) and no fallback execution client is configured.", mgrStatus.PrimaryClientStatus.Error)
@@ -369,12 +369,12 @@ func checkBeaconClientStatus(bcMgr *BeaconClientManager) (bool, error) {
 
 	// Check the BC status
 	mgrStatus := bcMgr.CheckStatus()
-	if bcMgr.primaryReady {
+	if bcMgr.pool.isPrimaryReady() {
 		return true, nil
 	}
 
-	// If the primary isn't synced but there's a fallback and it is, return true
-	if bcMgr.fallbackReady {
+	// If the primary isn't synced but one of the other clients is, return true
+	if len(bcMgr.pool.getOrder()) > 0 {
 		if mgrStatus.PrimaryClientStatus.Error != "" {
 			log.Printf("Primary consensus client is unavailable (%s), using fallback consensus client...\n", mgrStatus.PrimaryClientStatus.Error)
 		} else {
@@ -383,23 +383,23 @@ func checkBeaconClientStatus(bcMgr *BeaconClientManager) (bool, error) {
 		return true, nil
 	}
 
-	// If neither is synced, go through the status to figure out what to do
+	// If none are synced, go through the status to figure out what to do
 
-	// Is the primary working and syncing? If so, wait for it
-	if mgrStatus.PrimaryClientStatus.IsWorking && mgrStatus.PrimaryClientStatus.Error == "" {
-		log.Printf("Fallback consensus client is not configured or unavailable, waiting for primary consensus client to finish syncing (%.2f%%)\n", mgrStatus.PrimaryClientStatus.SyncProgress*100)
-		return false, nil
-	}
-
-	// Is the fallback working and syncing? If so, wait for it
-	if mgrStatus.FallbackEnabled && mgrStatus.FallbackClientStatus.IsWorking && mgrStatus.FallbackClientStatus.Error == "" {
-		log.Printf("Primary cosnensus client is unavailable (%s), waiting for the fallback consensus client to finish syncing (%.2f%%)\n", mgrStatus.PrimaryClientStatus.Error, mgrStatus.FallbackClientStatus.SyncProgress*100)
-		return false, nil
+	// Is one of the clients working and syncing? If so, wait for it
+	for i, endpoint := range mgrStatus.Endpoints {
+		if endpoint.Status.IsWorking && endpoint.Status.Error == "" {
+			if i == 0 {
+				log.Printf("Fallback consensus clients are not configured or unavailable, waiting for primary consensus client to finish syncing (%.2f%%)\n", endpoint.Status.SyncProgress*100)
+			} else {
+				log.Printf("Primary consensus client is unavailable (%s), waiting for the fallback consensus client at %s to finish syncing (%.2f%%)\n", mgrStatus.PrimaryClientStatus.Error, endpoint.Endpoint, endpoint.Status.SyncProgress*100)
+			}
+			return false, nil
+		}
 	}
 
-	// If neither client is working, report the errors
+	// If no client is working, report the errors
 	if mgrStatus.FallbackEnabled {
-		return false, fmt.Errorf("Primary consensus client is unavailable (%s) and fallback consensus client is unavailable (%s), no consensus clients are ready.", mgrStatus.PrimaryClientStatus.Error, mgrStatus.FallbackClientStatus.Error)
+		return false, fmt.Errorf("No consensus clients are ready: %s", bcMgr.pool.getErrorSummary())
 	}
 
 	return false, fmt.Errorf("Primary consensus client is unavailable (%s) and no fallback consensus client is configured.", mgrStatus.PrimaryClientStatus.Error)
@@ -0,0 +1,197 @@
+package rewards
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The differences between a node's rewards in two rewards files
+type NodeRewardsDifference struct {
+	Address                   common.Address `json:"address"`
+	MissingFromCanonical      bool           `json:"missingFromCanonical"`
+	MissingFromGenerated      bool           `json:"missingFromGenerated"`
+	CanonicalRewardNetwork    uint64         `json:"canonicalRewardNetwork"`
+	GeneratedRewardNetwork    uint64         `json:"generatedRewardNetwork"`
+	CanonicalCollateralRpl    *QuotedBigInt  `json:"canonicalCollateralRpl"`
+	GeneratedCollateralRpl    *QuotedBigInt  `json:"generatedCollateralRpl"`
+	CanonicalOracleDaoRpl     *QuotedBigInt  `json:"canonicalOracleDaoRpl"`
+	GeneratedOracleDaoRpl     *QuotedBigInt  `json:"generatedOracleDaoRpl"`
+	CanonicalSmoothingPoolEth *QuotedBigInt  `json:"canonicalSmoothingPoolEth"`
+	GeneratedSmoothingPoolEth *QuotedBigInt  `json:"generatedSmoothingPoolEth"`
+}
+
+// The differences between a minipool's performance in two minipool performance files
+type MinipoolPerformanceDifference struct {
+	Address                    common.Address `json:"address"`
+	MissingFromCanonical       bool           `json:"missingFromCanonical"`
+	MissingFromGenerated       bool           `json:"missingFromGenerated"`
+	CanonicalSuccessfulCount   uint64         `json:"canonicalSuccessfulCount"`
+	GeneratedSuccessfulCount   uint64         `json:"generatedSuccessfulCount"`
+	CanonicalMissedCount       uint64         `json:"canonicalMissedCount"`
+	GeneratedMissedCount       uint64         `json:"generatedMissedCount"`
+	MissedOnlyInCanonicalSlots []uint64       `json:"missedOnlyInCanonicalSlots"`
+	MissedOnlyInGeneratedSlots []uint64       `json:"missedOnlyInGeneratedSlots"`
+	CanonicalEthEarned         *big.Int       `json:"canonicalEthEarned"`
+	GeneratedEthEarned         *big.Int       `json:"generatedEthEarned"`
+}
+
+// The result of comparing a generated rewards file against the canonical one for the same interval
+type RewardsFileComparison struct {
+	CanonicalMerkleRoot string                          `json:"canonicalMerkleRoot"`
+	GeneratedMerkleRoot string                          `json:"generatedMerkleRoot"`
+	NodeDifferences     []NodeRewardsDifference         `json:"nodeDifferences"`
+	MinipoolDifferences []MinipoolPerformanceDifference `json:"minipoolDifferences"`
+	PerformanceCompared bool                            `json:"performanceCompared"`
+}
+
+// True if the two files had the same Merkle root and no node or minipool differences
+func (c *RewardsFileComparison) IsMatch() bool {
+	return c.CanonicalMerkleRoot == c.GeneratedMerkleRoot &&
+		len(c.NodeDifferences) == 0 &&
+		len(c.MinipoolDifferences) == 0
+}
+
+// Compare a generated rewards file against the canonical one for the same interval, node by node and minipool by minipool.
+// The minipool performance files are optional; if either one is nil, only the node rewards will be compared.
+func CompareRewardsFiles(canonical IRewardsFile, canonicalPerformance IMinipoolPerformanceFile, generated IRewardsFile, generatedPerformance IMinipoolPerformanceFile) *RewardsFileComparison {
+	comparison := &RewardsFileComparison{
+		CanonicalMerkleRoot: canonical.GetHeader().MerkleRoot,
+		GeneratedMerkleRoot: generated.GetHeader().MerkleRoot,
+		NodeDifferences:     []NodeRewardsDifference{},
+		MinipoolDifferences: []MinipoolPerformanceDifference{},
+	}
+
+	// Compare the node rewards
+	for _, address := range mergeAddresses(canonical.GetNodeAddresses(), generated.GetNodeAddresses()) {
+		canonicalInfo, canonicalExists := canonical.GetNodeRewardsInfo(address)
+		generatedInfo, generatedExists := generated.GetNodeRewardsInfo(address)
+		diff := NodeRewardsDifference{
+			Address:              address,
+			MissingFromCanonical: !canonicalExists,
+			MissingFromGenerated: !generatedExists,
+		}
+		if canonicalExists {
+			diff.CanonicalRewardNetwork = canonicalInfo.GetRewardNetwork()
+			diff.CanonicalCollateralRpl = canonicalInfo.GetCollateralRpl()
+			diff.CanonicalOracleDaoRpl = canonicalInfo.GetOracleDaoRpl()
+			diff.CanonicalSmoothingPoolEth = canonicalInfo.GetSmoothingPoolEth()
+		}
+		if generatedExists {
+			diff.GeneratedRewardNetwork = generatedInfo.GetRewardNetwork()
+			diff.GeneratedCollateralRpl = generatedInfo.GetCollateralRpl()
+			diff.GeneratedOracleDaoRpl = generatedInfo.GetOracleDaoRpl()
+			diff.GeneratedSmoothingPoolEth = generatedInfo.GetSmoothingPoolEth()
+		}
+
+		if !canonicalExists || !generatedExists ||
+			diff.CanonicalRewardNetwork != diff.GeneratedRewardNetwork ||
+			!quotedBigIntsEqual(diff.CanonicalCollateralRpl, diff.GeneratedCollateralRpl) ||
+			!quotedBigIntsEqual(diff.CanonicalOracleDaoRpl, diff.GeneratedOracleDaoRpl) ||
+			!quotedBigIntsEqual(diff.CanonicalSmoothingPoolEth, diff.GeneratedSmoothingPoolEth) {
+			comparison.NodeDifferences = append(comparison.NodeDifferences, diff)
+		}
+	}
+
+	// Compare the minipool performance
+	if canonicalPerformance == nil || generatedPerformance == nil {
+		return comparison
+	}
+	comparison.PerformanceCompared = true
+	for _, address := range mergeAddresses(canonicalPerformance.GetMinipoolAddresses(), generatedPerformance.GetMinipoolAddresses()) {
+		canonicalPerf, canonicalExists := canonicalPerformance.GetSmoothingPoolPerformance(address)
+		generatedPerf, generatedExists := generatedPerformance.GetSmoothingPoolPerformance(address)
+		diff := MinipoolPerformanceDifference{
+			Address:              address,
+			MissingFromCanonical: !canonicalExists,
+			MissingFromGenerated: !generatedExists,
+		}
+		var canonicalSlots, generatedSlots []uint64
+		if canonicalExists {
+			diff.CanonicalSuccessfulCount = canonicalPerf.GetSuccessfulAttestationCount()
+			diff.CanonicalMissedCount = canonicalPerf.GetMissedAttestationCount()
+			diff.CanonicalEthEarned = canonicalPerf.GetEthEarned()
+			canonicalSlots = canonicalPerf.GetMissingAttestationSlots()
+		}
+		if generatedExists {
+			diff.GeneratedSuccessfulCount = generatedPerf.GetSuccessfulAttestationCount()
+			diff.GeneratedMissedCount = generatedPerf.GetMissedAttestationCount()
+			diff.GeneratedEthEarned = generatedPerf.GetEthEarned()
+			generatedSlots = generatedPerf.GetMissingAttestationSlots()
+		}
+		diff.MissedOnlyInCanonicalSlots = subtractSlots(canonicalSlots, generatedSlots)
+		diff.MissedOnlyInGeneratedSlots = subtractSlots(generatedSlots, canonicalSlots)
+
+		if !canonicalExists || !generatedExists ||
+			diff.CanonicalSuccessfulCount != diff.GeneratedSuccessfulCount ||
+			diff.CanonicalMissedCount != diff.GeneratedMissedCount ||
+			len(diff.MissedOnlyInCanonicalSlots) > 0 ||
+			len(diff.MissedOnlyInGeneratedSlots) > 0 ||
+			!BigIntsEqual(diff.CanonicalEthEarned, diff.GeneratedEthEarned) {
+			comparison.MinipoolDifferences = append(comparison.MinipoolDifferences, diff)
+		}
+	}
+
+	return comparison
+}
+
+// Get the sorted union of two address lists
+func mergeAddresses(first []common.Address, second []common.Address) []common.Address {
+	seen := map[common.Address]bool{}
+	addresses := []common.Address{}
+	for _, list := range [][]common.Address{first, second} {
+		for _, address := range list {
+			if !seen[address] {
+				seen[address] = true
+				addresses = append(addresses, address)
+			}
+		}
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i][:], addresses[j][:]) < 0
+	})
+	return addresses
+}
+
+// Get the sorted slots in the first list that aren't in the second one
+func subtractSlots(first []uint64, second []uint64) []uint64 {
+	exclude := map[uint64]bool{}
+	for _, slot := range second {
+		exclude[slot] = true
+	}
+	slots := []uint64{}
+	for _, slot := range first {
+		if !exclude[slot] {
+			slots = append(slots, slot)
+		}
+	}
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i] < slots[j]
+	})
+	return slots
+}
+
+// Compare two quoted big ints, treating nil as zero
+func quotedBigIntsEqual(first *QuotedBigInt, second *QuotedBigInt) bool {
+	var firstInt, secondInt *big.Int
+	if first != nil {
+		firstInt = &first.Int
+	}
+	if second != nil {
+		secondInt = &second.Int
+	}
+	return BigIntsEqual(firstInt, secondInt)
+}
+
+// Compare two big ints, treating nil as zero
+func BigIntsEqual(first *big.Int, second *big.Int) bool {
+	if first == nil {
+		first = big.NewInt(0)
+	}
+	if second == nil {
+		second = big.NewInt(0)
+	}
+	return first.Cmp(second) == 0
+}
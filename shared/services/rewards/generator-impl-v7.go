@@ -21,8 +21,6 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/services/state"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
-	"github.com/wealdtech/go-merkletree"
-	"github.com/wealdtech/go-merkletree/keccak256"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -222,51 +220,81 @@ func (r *treeGeneratorImpl_v7) approximateStakerShareOfSmoothingPool(rp *rocketp
 	return &r.rewardsFile.TotalRewards.PoolStakerSmoothingPoolEth.Int, nil
 }
 
+// Estimates the rewards for each node as of the current state, without processing Beacon performance or building the Merkle tree.
+// Every eligible minipool that's currently opted into the Smoothing Pool is treated as if it attested perfectly.
+func (r *treeGeneratorImpl_v7) estimateRewards(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, bc beacon.Client) (IRewardsFile, error) {
+	r.log.Printlnf("%s Estimating rewards using Ruleset v%d.", r.logPrefix, r.rewardsFile.RulesetVersion)
+
+	r.rp = rp
+	r.cfg = cfg
+	r.bc = bc
+	r.validNetworkCache = map[uint64]bool{
+		0: true,
+	}
+
+	// Set the network name
+	r.rewardsFile.Network = fmt.Sprint(cfg.Smartnode.Network.Value)
+	r.rewardsFile.MinipoolPerformanceFile.Network = r.rewardsFile.Network
+	r.rewardsFile.MinipoolPerformanceFile.RewardsFileVersion = r.rewardsFile.RewardsFileVersion
+	r.rewardsFile.MinipoolPerformanceFile.RulesetVersion = r.rewardsFile.RulesetVersion
+
+	// Get the Beacon config
+	r.beaconConfig = r.networkState.BeaconConfig
+	r.slotsPerEpoch = r.beaconConfig.SlotsPerEpoch
+	r.genesisTime = time.Unix(int64(r.beaconConfig.GenesisTime), 0)
+
+	// Set the EL client call opts
+	r.opts = &bind.CallOpts{
+		BlockNumber: r.elSnapshotHeader.Number,
+	}
+
+	// Get the max of node count and minipool count - this will be used for an error epsilon due to division truncation
+	nodeCount := len(r.networkState.NodeDetails)
+	minipoolCount := len(r.networkState.MinipoolDetails)
+	if nodeCount > minipoolCount {
+		r.epsilon = big.NewInt(int64(nodeCount))
+	} else {
+		r.epsilon = big.NewInt(int64(minipoolCount))
+	}
+
+	// Calculate the RPL rewards
+	err := r.calculateRplRewards()
+	if err != nil {
+		return nil, fmt.Errorf("error calculating RPL rewards: %w", err)
+	}
+
+	// Calculate the ETH rewards
+	err = r.calculateEthRewards(false)
+	if err != nil {
+		return nil, fmt.Errorf("error calculating ETH rewards: %w", err)
+	}
+
+	// Calculate the network reward map and the totals
+	r.updateNetworksAndTotals()
+
+	return r.rewardsFile, nil
+}
+
 // Generates a merkle tree from the provided rewards map
 func (r *treeGeneratorImpl_v7) generateMerkleTree() error {
 
 	// Generate the leaf data for each node
 	totalData := make([][]byte, 0, len(r.rewardsFile.NodeRewards))
 	for address, rewardsForNode := range r.rewardsFile.NodeRewards {
-		// Ignore nodes that didn't receive any rewards
-		if rewardsForNode.CollateralRpl.Cmp(common.Big0) == 0 && rewardsForNode.OracleDaoRpl.Cmp(common.Big0) == 0 && rewardsForNode.SmoothingPoolEth.Cmp(common.Big0) == 0 {
+		nodeData := getNodeMerkleData(address, rewardsForNode.RewardNetwork, rewardsForNode.CollateralRpl, rewardsForNode.OracleDaoRpl, rewardsForNode.SmoothingPoolEth)
+		if nodeData == nil {
 			continue
 		}
 
-		// Node data is address[20] :: network[32] :: RPL[32] :: ETH[32]
-		nodeData := make([]byte, 0, 20+32*3)
-
-		// Node address
-		addressBytes := address.Bytes()
-		nodeData = append(nodeData, addressBytes...)
-
-		// Node network
-		network := big.NewInt(0).SetUint64(rewardsForNode.RewardNetwork)
-		networkBytes := make([]byte, 32)
-		network.FillBytes(networkBytes)
-		nodeData = append(nodeData, networkBytes...)
-
-		// RPL rewards
-		rplRewards := big.NewInt(0)
-		rplRewards.Add(&rewardsForNode.CollateralRpl.Int, &rewardsForNode.OracleDaoRpl.Int)
-		rplRewardsBytes := make([]byte, 32)
-		rplRewards.FillBytes(rplRewardsBytes)
-		nodeData = append(nodeData, rplRewardsBytes...)
-
-		// ETH rewards
-		ethRewardsBytes := make([]byte, 32)
-		rewardsForNode.SmoothingPoolEth.FillBytes(ethRewardsBytes)
-		nodeData = append(nodeData, ethRewardsBytes...)
-
 		// Assign it to the node rewards tracker and add it to the leaf data slice
 		rewardsForNode.MerkleData = nodeData
 		totalData = append(totalData, nodeData)
 	}
 
 	// Generate the tree
-	tree, err := merkletree.NewUsing(totalData, keccak256.New(), false, true)
+	tree, err := buildMerkleTree(totalData)
 	if err != nil {
-		return fmt.Errorf("error generating Merkle Tree: %w", err)
+		return err
 	}
 
 	// Generate the proofs for each node
@@ -67,6 +67,11 @@ type treeGeneratorImpl interface {
 	getRulesetVersion() uint64
 }
 
+// Implemented by generators that can estimate the rewards of the interval that's currently in progress
+type rewardsEstimator interface {
+	estimateRewards(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, bc beacon.Client) (IRewardsFile, error)
+}
+
 func NewTreeGenerator(logger *log.ColorLogger, logPrefix string, rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, bc beacon.Client, index uint64, startTime time.Time, endTime time.Time, consensusBlock uint64, elSnapshotHeader *types.Header, intervalsPassed uint64, state *state.NetworkState, rollingRecord *RollingRecord) (*TreeGenerator, error) {
 	t := &TreeGenerator{
 		logger:           logger,
@@ -203,6 +208,16 @@ func (t *TreeGenerator) ApproximateStakerShareOfSmoothingPool() (*big.Int, error
 	return t.approximatorImpl.approximateStakerShareOfSmoothingPool(t.rp, t.cfg, t.bc)
 }
 
+// Estimates the rewards for each node as of the generator's end time, without building the Merkle tree.
+// Beacon performance is only taken into account if a rolling record was provided.
+func (t *TreeGenerator) EstimateRewards() (IRewardsFile, error) {
+	estimator, ok := t.generatorImpl.(rewardsEstimator)
+	if !ok {
+		return nil, fmt.Errorf("ruleset v%d does not support rewards estimates", t.generatorImpl.getRulesetVersion())
+	}
+	return estimator.estimateRewards(t.rp, t.cfg, t.bc)
+}
+
 func (t *TreeGenerator) GetGeneratorRulesetVersion() uint64 {
 	return t.generatorImpl.getRulesetVersion()
 }
@@ -35,6 +35,9 @@ type RollingRecord struct {
 	logPrefix          string              `json:"-"`
 	intervalDutiesInfo *IntervalDutiesInfo `json:"-"`
 
+	// True if this record only tracks a single node's minipools, for monitoring instead of rewards
+	isNodeRecord bool `json:"-"`
+
 	// Constants for convenience
 	one          *big.Int `json:"-"`
 	validatorReq *big.Int `json:"-"`
@@ -63,6 +66,16 @@ func NewRollingRecord(log *log.ColorLogger, logPrefix string, bc beacon.Client,
 	}
 }
 
+// Create a rolling record for monitoring the performance of a single node's minipools.
+// Unlike the rewards record, it tracks every attestation duty regardless of Smoothing Pool status
+// and remembers which duties were completed so they can be broken down by epoch.
+// The provided states should only contain the node's own minipools.
+func NewNodeRollingRecord(log *log.ColorLogger, logPrefix string, bc beacon.Client, startSlot uint64, beaconConfig *beacon.Eth2Config) *RollingRecord {
+	record := NewRollingRecord(log, logPrefix, bc, startSlot, beaconConfig, 0)
+	record.isNodeRecord = true
+	return record
+}
+
 // Load an existing record from serialized JSON data
 func DeserializeRollingRecord(log *log.ColorLogger, logPrefix string, bc beacon.Client, beaconConfig *beacon.Eth2Config, bytes []byte) (*RollingRecord, error) {
 	record := &RollingRecord{
@@ -161,6 +174,31 @@ func (r *RollingRecord) GetScores(cheatingNodes map[common.Address]bool) ([]*Min
 	return minipoolInfos, totalScore, totalCount
 }
 
+// Remove the attestation duties up to (and including) the given slot from a node record, and return them.
+// The results are keyed by validator index, then by duty slot; the value is true if the attestation was included.
+func (r *RollingRecord) TakeAttestationResults(endSlot uint64) map[string]map[uint64]bool {
+	results := map[string]map[uint64]bool{}
+	for index, mpInfo := range r.ValidatorIndexMap {
+		validatorResults := map[uint64]bool{}
+		for slot := range mpInfo.MissingAttestationSlots {
+			if slot <= endSlot {
+				validatorResults[slot] = false
+				delete(mpInfo.MissingAttestationSlots, slot)
+			}
+		}
+		for slot := range mpInfo.CompletedAttestations {
+			if slot <= endSlot {
+				validatorResults[slot] = true
+				delete(mpInfo.CompletedAttestations, slot)
+			}
+		}
+		if len(validatorResults) > 0 {
+			results[index] = validatorResults
+		}
+	}
+	return results
+}
+
 // Serialize the current record into a byte array
 func (r *RollingRecord) Serialize() ([]byte, error) {
 	// Clone the record
@@ -213,6 +251,9 @@ func (r *RollingRecord) updateValidatorIndices(state *state.NetworkState) {
 				MissingAttestationSlots: map[uint64]bool{},
 				AttestationScore:        NewQuotedBigInt(0),
 			}
+			if r.isNodeRecord {
+				minipoolInfo.CompletedAttestations = map[uint64]bool{}
+			}
 			r.ValidatorIndexMap[validator.Index] = minipoolInfo
 		}
 	}
@@ -259,13 +300,15 @@ func (r *RollingRecord) getDutiesForEpoch(epoch uint64, endSlot uint64, state *s
 				continue
 			}
 
-			// Check if this minipool was opted into the SP for this block
-			nodeDetails := state.NodeDetailsByAddress[mpInfo.NodeAddress]
-			isOptedIn := nodeDetails.SmoothingPoolRegistrationState
-			spRegistrationTime := time.Unix(nodeDetails.SmoothingPoolRegistrationChanged.Int64(), 0)
-			if (isOptedIn && blockTime.Sub(spRegistrationTime) < 0) || // If this block occurred before the node opted in, ignore it
-				(!isOptedIn && spRegistrationTime.Sub(blockTime) < 0) { // If this block occurred after the node opted out, ignore it
-				continue
+			// Check if this minipool was opted into the SP for this block; node records track every duty
+			if !r.isNodeRecord {
+				nodeDetails := state.NodeDetailsByAddress[mpInfo.NodeAddress]
+				isOptedIn := nodeDetails.SmoothingPoolRegistrationState
+				spRegistrationTime := time.Unix(nodeDetails.SmoothingPoolRegistrationChanged.Int64(), 0)
+				if (isOptedIn && blockTime.Sub(spRegistrationTime) < 0) || // If this block occurred before the node opted in, ignore it
+					(!isOptedIn && spRegistrationTime.Sub(blockTime) < 0) { // If this block occurred after the node opted out, ignore it
+					continue
+				}
 			}
 
 			// Check if this minipool was in the `staking` state during this time
@@ -377,6 +420,9 @@ func (r *RollingRecord) processAttestationsInSlot(inclusionSlot uint64, attestat
 							delete(r.intervalDutiesInfo.Slots, attestation.SlotIndex)
 						}
 						delete(validator.MissingAttestationSlots, attestation.SlotIndex)
+						if validator.CompletedAttestations != nil {
+							validator.CompletedAttestations[attestation.SlotIndex] = true
+						}
 
 						// Get the pseudoscore for this attestation
 						details := state.MinipoolDetailsByAddress[validator.Address]
@@ -126,6 +126,7 @@ type IntervalInfo struct {
 	TreeFileExists         bool          `json:"treeFileExists"`
 	MerkleRootValid        bool          `json:"merkleRootValid"`
 	CID                    string        `json:"cid"`
+	MerkleRoot             common.Hash   `json:"merkleRoot"`
 	StartTime              time.Time     `json:"startTime"`
 	EndTime                time.Time     `json:"endTime"`
 	NodeExists             bool          `json:"nodeExists"`
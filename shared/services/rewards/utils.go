@@ -33,6 +33,8 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/wealdtech/go-merkletree"
+	"github.com/wealdtech/go-merkletree/keccak256"
 	"github.com/web3-storage/go-w3s-client/adder"
 )
 
@@ -108,6 +110,7 @@ func GetIntervalInfo(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, no
 	}
 
 	info.CID = event.MerkleTreeCID
+	info.MerkleRoot = event.MerkleRoot
 	info.StartTime = event.IntervalStartTime
 	info.EndTime = event.IntervalEndTime
 	merkleRootCanon := event.MerkleRoot
@@ -256,22 +259,89 @@ func GetELBlockHeaderForTime(targetTime time.Time, rp *rocketpool.RocketPool) (*
 	}
 }
 
-// Downloads a single rewards file
-func DownloadRewardsFile(cfg *config.RocketPoolConfig, interval uint64, cid string, isDaemon bool) error {
+// Downloads a single rewards file, verifying it against the canonical Merkle root and CID before saving it
+func DownloadRewardsFile(cfg *config.RocketPoolConfig, interval uint64, cid string, merkleRoot common.Hash, isDaemon bool) error {
 
 	// Determine file name and path
 	rewardsTreePath, err := homedir.Expand(cfg.Smartnode.GetRewardsTreePath(interval, isDaemon))
 	if err != nil {
 		return fmt.Errorf("error expanding rewards tree path: %w", err)
 	}
-	rewardsTreeFilename := filepath.Base(rewardsTreePath)
+
+	// Get the canonical file
+	_, bytes, err := FetchRewardsFile(cfg, interval, cid, merkleRoot)
+	if err != nil {
+		return err
+	}
+
+	// Write the file
+	err = os.WriteFile(rewardsTreePath, bytes, 0644)
+	if err != nil {
+		return fmt.Errorf("error saving interval %d file to %s: %w", interval, rewardsTreePath, err)
+	}
+	return nil
+
+}
+
+// Downloads the canonical rewards file for an interval from IPFS (or the GitHub mirror), verifying it against its on-chain Merkle root and CID.
+// Returns the deserialized file along with its raw (decompressed) bytes.
+func FetchRewardsFile(cfg *config.RocketPoolConfig, interval uint64, cid string, merkleRoot common.Hash) (IRewardsFile, []byte, error) {
+
+	rewardsTreeFilename := filepath.Base(cfg.Smartnode.GetRewardsTreePath(interval, true))
 	ipfsFilename := rewardsTreeFilename + config.RewardsTreeIpfsExtension
 
+	var rewardsFile IRewardsFile
+	bytes, err := downloadFromMirrors(cfg, cid, rewardsTreeFilename, func(bytes []byte) error {
+		// Make sure the file matches the canonical one before accepting it
+		var err error
+		rewardsFile, err = DeserializeRewardsFile(bytes)
+		if err != nil {
+			return fmt.Errorf("error deserializing file: %w", err)
+		}
+		err = VerifyRewardsFile(rewardsFile, interval, ipfsFilename, cid, merkleRoot)
+		if err != nil {
+			return fmt.Errorf("file failed verification: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return rewardsFile, bytes, nil
+
+}
+
+// Downloads the minipool performance file for an interval from IPFS (or the GitHub mirror), using the CID listed in the interval's rewards file
+func FetchMinipoolPerformanceFile(cfg *config.RocketPoolConfig, interval uint64, cid string) (IMinipoolPerformanceFile, error) {
+
+	performanceFilename := filepath.Base(cfg.Smartnode.GetMinipoolPerformancePath(interval, true))
+
+	var performanceFile IMinipoolPerformanceFile
+	_, err := downloadFromMirrors(cfg, cid, performanceFilename, func(bytes []byte) error {
+		var err error
+		performanceFile, err = DeserializeMinipoolPerformanceFile(bytes)
+		if err != nil {
+			return fmt.Errorf("error deserializing file: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return performanceFile, nil
+
+}
+
+// Downloads a file from the first mirror that provides a copy passing the provided check, decompressing it if necessary.
+// The error lists why each mirror was rejected.
+func downloadFromMirrors(cfg *config.RocketPoolConfig, cid string, filename string, check func([]byte) error) ([]byte, error) {
+
 	// Create URL list
+	ipfsFilename := filename + config.RewardsTreeIpfsExtension
 	urls := []string{
 		fmt.Sprintf(config.PrimaryRewardsFileUrl, cid, ipfsFilename),
 		fmt.Sprintf(config.SecondaryRewardsFileUrl, cid, ipfsFilename),
-		fmt.Sprintf(config.GithubRewardsFileUrl, string(cfg.Smartnode.Network.Value.(cfgtypes.Network)), rewardsTreeFilename),
+		fmt.Sprintf(config.GithubRewardsFileUrl, string(cfg.Smartnode.Network.Value.(cfgtypes.Network)), filename),
 	}
 
 	// Attempt downloads
@@ -305,17 +375,124 @@ func DownloadRewardsFile(cfg *config.RocketPoolConfig, interval uint64, cid stri
 				}
 			}
 
-			// Write the file
-			err = os.WriteFile(rewardsTreePath, writeBytes, 0644)
+			err = check(writeBytes)
 			if err != nil {
-				return fmt.Errorf("error saving interval %d file to %s: %w", interval, rewardsTreePath, err)
+				errBuilder.WriteString(fmt.Sprintf("Downloaded file from %s was rejected: %s\n", url, err.Error()))
+				continue
 			}
-			return nil
+			return writeBytes, nil
 		}
 	}
 
-	return fmt.Errorf(errBuilder.String())
+	return nil, fmt.Errorf(errBuilder.String())
+
+}
+
+// Verifies that a rewards file matches the Merkle root and IPFS CID recorded on-chain for its interval.
+// The Merkle tree is rebuilt from the node rewards in the file rather than trusting the root in its header.
+func VerifyRewardsFile(rewardsFile IRewardsFile, interval uint64, ipfsFilename string, expectedCid string, expectedRoot common.Hash) error {
+
+	// Check the header
+	header := rewardsFile.GetHeader()
+	if header.Index != interval {
+		return fmt.Errorf("file is for interval %d but interval %d was expected", header.Index, interval)
+	}
+	headerRoot := common.HexToHash(header.MerkleRoot)
+	if headerRoot != expectedRoot {
+		return fmt.Errorf("file header has Merkle root %s but the canonical root is %s", headerRoot.Hex(), expectedRoot.Hex())
+	}
 
+	// Rebuild the tree from the node rewards and check its root
+	root, err := GetMerkleRootForRewardsFile(rewardsFile)
+	if err != nil {
+		return fmt.Errorf("error rebuilding Merkle tree: %w", err)
+	}
+	if root != expectedRoot {
+		return fmt.Errorf("rebuilt Merkle root %s does not match the canonical root %s", root.Hex(), expectedRoot.Hex())
+	}
+
+	// Check the CID
+	cid, err := GetCidForRewardsFile(rewardsFile, ipfsFilename)
+	if err != nil {
+		return fmt.Errorf("error calculating CID: %w", err)
+	}
+	if cid.String() != expectedCid {
+		return fmt.Errorf("file has CID %s but the canonical CID is %s", cid.String(), expectedCid)
+	}
+
+	return nil
+
+}
+
+// Rebuilds the Merkle tree from the node rewards in a rewards file and returns its root
+func GetMerkleRootForRewardsFile(rewardsFile IRewardsFile) (common.Hash, error) {
+
+	// Generate the leaf data for each node
+	addresses := rewardsFile.GetNodeAddresses()
+	totalData := make([][]byte, 0, len(addresses))
+	for _, address := range addresses {
+		rewardsForNode, exists := rewardsFile.GetNodeRewardsInfo(address)
+		if !exists {
+			return common.Hash{}, fmt.Errorf("node %s is missing its rewards info", address.Hex())
+		}
+		nodeData := getNodeMerkleData(address, rewardsForNode.GetRewardNetwork(), rewardsForNode.GetCollateralRpl(), rewardsForNode.GetOracleDaoRpl(), rewardsForNode.GetSmoothingPoolEth())
+		if nodeData != nil {
+			totalData = append(totalData, nodeData)
+		}
+	}
+
+	// Generate the tree
+	tree, err := buildMerkleTree(totalData)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(tree.Root()), nil
+
+}
+
+// Get the Merkle tree leaf data for a node's rewards, or nil if the node didn't receive any rewards and isn't in the tree
+func getNodeMerkleData(address common.Address, rewardNetwork uint64, collateralRpl *QuotedBigInt, oracleDaoRpl *QuotedBigInt, smoothingPoolEth *QuotedBigInt) []byte {
+
+	// Ignore nodes that didn't receive any rewards
+	if collateralRpl.Cmp(common.Big0) == 0 && oracleDaoRpl.Cmp(common.Big0) == 0 && smoothingPoolEth.Cmp(common.Big0) == 0 {
+		return nil
+	}
+
+	// Node data is address[20] :: network[32] :: RPL[32] :: ETH[32]
+	nodeData := make([]byte, 0, 20+32*3)
+
+	// Node address
+	nodeData = append(nodeData, address.Bytes()...)
+
+	// Node network
+	network := big.NewInt(0).SetUint64(rewardNetwork)
+	networkBytes := make([]byte, 32)
+	network.FillBytes(networkBytes)
+	nodeData = append(nodeData, networkBytes...)
+
+	// RPL rewards
+	rplRewards := big.NewInt(0)
+	rplRewards.Add(&collateralRpl.Int, &oracleDaoRpl.Int)
+	rplRewardsBytes := make([]byte, 32)
+	rplRewards.FillBytes(rplRewardsBytes)
+	nodeData = append(nodeData, rplRewardsBytes...)
+
+	// ETH rewards
+	ethRewardsBytes := make([]byte, 32)
+	smoothingPoolEth.FillBytes(ethRewardsBytes)
+	nodeData = append(nodeData, ethRewardsBytes...)
+
+	return nodeData
+
+}
+
+// Build the rewards Merkle tree from the leaf data of each node
+func buildMerkleTree(totalData [][]byte) (*merkletree.MerkleTree, error) {
+	tree, err := merkletree.NewUsing(totalData, keccak256.New(), false, true)
+	if err != nil {
+		return nil, fmt.Errorf("error generating Merkle Tree: %w", err)
+	}
+	return tree, nil
 }
 
 // Get the IPFS CID for a blob of data
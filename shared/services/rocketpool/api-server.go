@@ -0,0 +1,240 @@
+package rocketpool
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/output"
+)
+
+// Config
+const (
+	ApiServerRoutePrefix string = "/api/v1/"
+
+	apiServerSocketUrl string = "http://unix"
+	apiServerTimeout          = 10 * time.Minute
+)
+
+// Call an API handler through the node daemon's API server if it's being used, or by starting a new API process otherwise.
+// route is the handler's path on the API server, request is its JSON body (nil for routes that don't take one), and args are the arguments of the matching API command.
+func (c *Client) callApiRoute(route string, request interface{}, args string, otherArgs ...string) ([]byte, error) {
+	if !c.useApiServer && c.apiUrl == "" {
+		return c.callAPI(args, otherArgs...)
+	}
+	if c.unsignedTx {
+		return []byte{}, errors.New("--unsigned-tx can't be used with the daemon's API server, since it shares its services with the daemon's own tasks")
+	}
+	responseBytes, err := c.callApiServer(route, request)
+	if err == nil {
+		output.AddApiResponse(args, responseBytes)
+	}
+	return responseBytes, err
+}
+
+// Get the transaction settings to send with an API server request
+func (c *Client) getApiServerSettings() api.ApiServerSettings {
+	return api.ApiServerSettings{
+		MaxFee:      c.maxFee,
+		MaxPrioFee:  c.maxPrioFee,
+		CustomNonce: c.customNonce,
+		Simulate:    c.simulate,
+	}
+}
+
+// Call one of the node daemon's API server routes, sending the request as its JSON body if there is one
+func (c *Client) callApiServer(route string, request interface{}) ([]byte, error) {
+
+	method := http.MethodGet
+	var body []byte
+	if request != nil {
+		method = http.MethodPost
+		var err error
+		body, err = json.Marshal(request)
+		if err != nil {
+			return nil, fmt.Errorf("Could not serialize API server request: %w", err)
+		}
+	}
+
+	// Get the HTTP client and the token
+	httpClient, baseUrl, err := c.getApiServerClient()
+	if err != nil {
+		return nil, err
+	}
+	token, err := c.getApiServerToken()
+	if err != nil {
+		return nil, err
+	}
+
+	url := strings.TrimSuffix(baseUrl, "/") + ApiServerRoutePrefix + route
+	if c.debugPrint {
+		fmt.Println("To API server:")
+		fmt.Println(method, url)
+		if body != nil {
+			fmt.Println(string(body))
+		}
+	}
+
+	// Send the request
+	httpRequest, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("Could not create API server request: %w", err)
+	}
+	if body != nil {
+		httpRequest.Header.Set("Content-Type", "application/json")
+	}
+	httpRequest.Header.Set("Authorization", "Bearer "+token)
+	output, err := func() ([]byte, error) {
+		response, err := httpClient.Do(httpRequest)
+		if err != nil {
+			return nil, fmt.Errorf("Could not reach the API server: %w", err)
+		}
+		defer response.Body.Close()
+		output, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, fmt.Errorf("Could not read API server response: %w", err)
+		}
+
+		// Errors from the server itself are API responses too, so only fail if it didn't send one
+		if response.StatusCode != http.StatusOK && !json.Valid(output) {
+			return nil, fmt.Errorf("The API server returned %s: %s", response.Status, strings.TrimSpace(string(output)))
+		}
+		return output, nil
+	}()
+
+	if c.debugPrint {
+		if output != nil {
+			fmt.Println("API Out:")
+			fmt.Println(string(output))
+		}
+		if err != nil {
+			fmt.Println("API Err:")
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Reset the gas settings after the call
+	c.maxFee = c.originalMaxFee
+	c.maxPrioFee = c.originalMaxPrioFee
+	c.gasLimit = c.originalGasLimit
+
+	// Show what the transactions would do
+	if c.simulate && err == nil {
+		printSimulations(output)
+	}
+
+	return output, err
+
+}
+
+// Get the HTTP client for the API server and its base URL, connecting over its unix socket unless a URL was provided
+func (c *Client) getApiServerClient() (*http.Client, string, error) {
+
+	if c.apiUrl != "" && !strings.HasPrefix(c.apiUrl, "https://") {
+		return nil, "", fmt.Errorf("The API server URL [%s] must use HTTPS.", c.apiUrl)
+	}
+	if c.apiServerClient != nil {
+		if c.apiUrl != "" {
+			return c.apiServerClient, c.apiUrl, nil
+		}
+		return c.apiServerClient, apiServerSocketUrl, nil
+	}
+
+	cfg, _, err := c.LoadConfig()
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Connect over TLS, pinned to the server's self-signed certificate
+	if c.apiUrl != "" {
+		certPath := c.apiCertPath
+		if certPath == "" {
+			certPath = cfg.Smartnode.GetApiServerCertPath(false)
+		}
+		certPath, err = homedir.Expand(certPath)
+		if err != nil {
+			return nil, "", fmt.Errorf("Could not expand API server certificate path: %w", err)
+		}
+		certBytes, err := os.ReadFile(certPath)
+		if err != nil {
+			return nil, "", fmt.Errorf("Could not read API server certificate: %w", err)
+		}
+		block, _ := pem.Decode(certBytes)
+		if block == nil {
+			return nil, "", fmt.Errorf("API server certificate %s is not PEM-encoded", certPath)
+		}
+		pinnedCert := block.Bytes
+
+		c.apiServerClient = &http.Client{
+			Timeout: apiServerTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					MinVersion: tls.VersionTLS12,
+					// The certificate is self-signed, so it's checked against the pinned one instead of a CA
+					InsecureSkipVerify: true,
+					VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+						if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], pinnedCert) {
+							return errors.New("the API server's certificate does not match the pinned certificate")
+						}
+						return nil
+					},
+				},
+			},
+		}
+		return c.apiServerClient, c.apiUrl, nil
+	}
+
+	// Connect over the unix socket
+	socketPath, err := homedir.Expand(cfg.Smartnode.GetApiServerSocketPath(false))
+	if err != nil {
+		return nil, "", fmt.Errorf("Could not expand API server socket path: %w", err)
+	}
+	c.apiServerClient = &http.Client{
+		Timeout: apiServerTimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+	return c.apiServerClient, apiServerSocketUrl, nil
+
+}
+
+// Get the API server's bearer token
+func (c *Client) getApiServerToken() (string, error) {
+
+	tokenPath := c.apiTokenPath
+	if tokenPath == "" {
+		cfg, _, err := c.LoadConfig()
+		if err != nil {
+			return "", err
+		}
+		tokenPath = cfg.Smartnode.GetApiServerTokenPath(false)
+	}
+	tokenPath, err := homedir.Expand(tokenPath)
+	if err != nil {
+		return "", fmt.Errorf("Could not expand API server token path: %w", err)
+	}
+	token, err := os.ReadFile(tokenPath)
+	if err != nil {
+		return "", fmt.Errorf("Could not read API server token (is the API server enabled in `rocketpool service config`?): %w", err)
+	}
+	return strings.TrimSpace(string(token)), nil
+
+}
@@ -10,7 +10,14 @@ import (
 
 // Wait for a transaction
 func (c *Client) WaitForTransaction(txHash common.Hash) (api.APIResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("wait %s", txHash.String()))
+	// The transaction was exported for offline signing, so there's nothing to wait for
+	if c.unsignedTx {
+		return api.APIResponse{}, ErrTransactionNotSent
+	}
+	responseBytes, err := c.callApiRoute("wait", api.WaitForTransactionRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		TxHash:            txHash,
+	}, fmt.Sprintf("wait %s", txHash.String()))
 	if err != nil {
 		return api.APIResponse{}, fmt.Errorf("Error waiting for tx: %w", err)
 	}
@@ -3,6 +3,7 @@ package rocketpool
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -30,6 +31,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/output"
 	"github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
@@ -100,6 +102,14 @@ type Client struct {
 	debugPrint         bool
 	ignoreSyncCheck    bool
 	forceFallbacks     bool
+	simulate           bool
+	unsignedTx         bool
+	unsignedTxFormat   string
+	useApiServer       bool
+	apiUrl             string
+	apiTokenPath       string
+	apiCertPath        string
+	apiServerClient    *http.Client
 }
 
 func getClientStatusString(clientStatus api.ClientStatus) string {
@@ -174,6 +184,13 @@ func NewClientFromCtx(c *cli.Context) *Client {
 		originalMaxPrioFee: c.GlobalFloat64("maxPrioFee"),
 		originalGasLimit:   c.GlobalUint64("gasLimit"),
 		debugPrint:         c.GlobalBool("debug"),
+		simulate:           c.GlobalBool("simulate"),
+		unsignedTx:         c.GlobalBool("unsigned-tx"),
+		unsignedTxFormat:   c.GlobalString("unsigned-tx-format"),
+		useApiServer:       c.GlobalBool("use-api-server"),
+		apiUrl:             c.GlobalString("api-url"),
+		apiTokenPath:       os.ExpandEnv(c.GlobalString("api-token")),
+		apiCertPath:        os.ExpandEnv(c.GlobalString("api-cert")),
 		forceFallbacks:     false,
 		ignoreSyncCheck:    false,
 	}
@@ -584,6 +601,43 @@ func (c *Client) PrintServiceStatus(composeFiles []string) error {
 	return c.printOutput(cmd)
 }
 
+// Get the state of each of the Rocket Pool service's containers
+func (c *Client) GetServiceStatus(composeFiles []string) ([]api.ServiceContainerStatus, error) {
+	cmd, err := c.compose(composeFiles, "ps --all --format json")
+	if err != nil {
+		return nil, err
+	}
+	psOutput, err := c.readOutput(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the service's containers: %w", err)
+	}
+
+	// Older versions of docker compose print a JSON array, newer ones print one JSON object per line
+	containers := []api.ServiceContainerStatus{}
+	trimmed := bytes.TrimSpace(psOutput)
+	if len(trimmed) == 0 {
+		return containers, nil
+	}
+	if trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &containers); err != nil {
+			return nil, fmt.Errorf("error decoding the service's containers: %w", err)
+		}
+		return containers, nil
+	}
+	for _, line := range bytes.Split(trimmed, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		var container api.ServiceContainerStatus
+		if err := json.Unmarshal(line, &container); err != nil {
+			return nil, fmt.Errorf("error decoding the service's containers: %w", err)
+		}
+		containers = append(containers, container)
+	}
+	return containers, nil
+}
+
 // Print the Rocket Pool service logs
 func (c *Client) PrintServiceLogs(composeFiles []string, tail string, serviceNames ...string) error {
 	sanitizedStrings := make([]string, len(serviceNames))
@@ -1330,6 +1384,12 @@ func (c *Client) composeAddons(cfg *config.RocketPoolConfig, rocketpoolDir strin
 
 // Call the Rocket Pool API
 func (c *Client) callAPI(args string, otherArgs ...string) ([]byte, error) {
+	// Calls without a route on the daemon's API server need a local API process
+	if c.apiUrl != "" {
+		return []byte{}, fmt.Errorf("`rocketpool api %s` isn't served by the daemon's API server, so it can't be used with --api-url", args)
+	}
+	call := args
+
 	// Sanitize and parse the args
 	ignoreSyncCheckFlag, forceFallbackECFlag, args := c.getApiCallArgs(args, otherArgs...)
 
@@ -1340,24 +1400,32 @@ func (c *Client) callAPI(args string, otherArgs ...string) ([]byte, error) {
 		if err != nil {
 			return []byte{}, err
 		}
-		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), args)
+		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), c.getSimulateFlag(), c.getUnsignedTxFlag(), args)
 	} else {
-		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s api %s",
+		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s %s %s api %s",
 			c.daemonPath,
 			shellescape.Quote(fmt.Sprintf("%s/%s", c.configPath, SettingsFile)),
 			ignoreSyncCheckFlag,
 			forceFallbackECFlag,
 			c.getGasOpts(),
 			c.getCustomNonce(),
+			c.getSimulateFlag(),
+			c.getUnsignedTxFlag(),
 			args)
 	}
 
 	// Run the command
-	return c.runApiCall(cmd)
+	responseBytes, err := c.runApiCall(cmd)
+	if err == nil {
+		output.AddApiResponse(call, responseBytes)
+	}
+	return responseBytes, err
 }
 
 // Call the Rocket Pool API with some custom environment variables
 func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, otherArgs ...string) ([]byte, error) {
+	call := args
+
 	// Sanitize and parse the args
 	ignoreSyncCheckFlag, forceFallbackECFlag, args := c.getApiCallArgs(args, otherArgs...)
 
@@ -1373,13 +1441,13 @@ func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, othe
 		if err != nil {
 			return []byte{}, err
 		}
-		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s api %s", envArgs, shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), args)
+		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s %s %s api %s", envArgs, shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), c.getSimulateFlag(), c.getUnsignedTxFlag(), args)
 	} else {
 		envArgs := ""
 		for key, value := range envVars {
 			envArgs += fmt.Sprintf("%s=%s ", key, shellescape.Quote(value))
 		}
-		cmd = fmt.Sprintf("%s %s --settings %s %s %s %s %s api %s",
+		cmd = fmt.Sprintf("%s %s --settings %s %s %s %s %s %s %s api %s",
 			envArgs,
 			c.daemonPath,
 			shellescape.Quote(fmt.Sprintf("%s/%s", c.configPath, SettingsFile)),
@@ -1387,11 +1455,17 @@ func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, othe
 			forceFallbackECFlag,
 			c.getGasOpts(),
 			c.getCustomNonce(),
+			c.getSimulateFlag(),
+			c.getUnsignedTxFlag(),
 			args)
 	}
 
 	// Run the command
-	return c.runApiCall(cmd)
+	responseBytes, err := c.runApiCall(cmd)
+	if err == nil {
+		output.AddApiResponse(call, responseBytes)
+	}
+	return responseBytes, err
 }
 
 func (c *Client) getApiCallArgs(args string, otherArgs ...string) (string, string, string) {
@@ -1445,6 +1519,16 @@ func (c *Client) runApiCall(cmd string) ([]byte, error) {
 	c.maxPrioFee = c.originalMaxPrioFee
 	c.gasLimit = c.originalGasLimit
 
+	// Show what the transactions would do
+	if c.simulate && err == nil {
+		printSimulations(output)
+	}
+
+	// Save the transactions that were built for offline signing
+	if c.unsignedTx && err == nil {
+		err = c.saveUnsignedTransactions(output)
+	}
+
 	return output, err
 }
 
@@ -1469,6 +1553,20 @@ func (c *Client) getGasOpts() string {
 	return opts
 }
 
+func (c *Client) getSimulateFlag() string {
+	if c.simulate {
+		return "--simulate"
+	}
+	return ""
+}
+
+func (c *Client) getUnsignedTxFlag() string {
+	if c.unsignedTx {
+		return "--unsigned-tx"
+	}
+	return ""
+}
+
 func (c *Client) getCustomNonce() string {
 	// Set the custom nonce
 	nonce := ""
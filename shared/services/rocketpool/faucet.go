@@ -9,7 +9,7 @@ import (
 
 // Get faucet status
 func (c *Client) FaucetStatus() (api.FaucetStatusResponse, error) {
-	responseBytes, err := c.callAPI("faucet status")
+	responseBytes, err := c.callApiRoute("faucet/status", nil, "faucet status")
 	if err != nil {
 		return api.FaucetStatusResponse{}, fmt.Errorf("Could not get faucet status: %w", err)
 	}
@@ -25,7 +25,7 @@ func (c *Client) FaucetStatus() (api.FaucetStatusResponse, error) {
 
 // Check whether the node can withdraw RPL from the faucet
 func (c *Client) CanFaucetWithdrawRpl() (api.CanFaucetWithdrawRplResponse, error) {
-	responseBytes, err := c.callAPI("faucet can-withdraw-rpl")
+	responseBytes, err := c.callApiRoute("faucet/can-withdraw-rpl", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "faucet can-withdraw-rpl")
 	if err != nil {
 		return api.CanFaucetWithdrawRplResponse{}, fmt.Errorf("Could not get can withdraw RPL from faucet status: %w", err)
 	}
@@ -41,7 +41,7 @@ func (c *Client) CanFaucetWithdrawRpl() (api.CanFaucetWithdrawRplResponse, error
 
 // Withdraw RPL from the faucet
 func (c *Client) FaucetWithdrawRpl() (api.FaucetWithdrawRplResponse, error) {
-	responseBytes, err := c.callAPI("faucet withdraw-rpl")
+	responseBytes, err := c.callApiRoute("faucet/withdraw-rpl", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "faucet withdraw-rpl")
 	if err != nil {
 		return api.FaucetWithdrawRplResponse{}, fmt.Errorf("Could not withdraw RPL from faucet: %w", err)
 	}
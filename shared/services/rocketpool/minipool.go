@@ -6,13 +6,14 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/types"
 
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
 // Get minipool status
 func (c *Client) MinipoolStatus() (api.MinipoolStatusResponse, error) {
-	responseBytes, err := c.callAPI("minipool status")
+	responseBytes, err := c.callApiRoute("minipool/status", nil, "minipool status")
 	if err != nil {
 		return api.MinipoolStatusResponse{}, fmt.Errorf("Could not get minipool status: %w", err)
 	}
@@ -58,7 +59,10 @@ func (c *Client) MinipoolStatus() (api.MinipoolStatusResponse, error) {
 
 // Check whether a minipool is eligible for a refund
 func (c *Client) CanRefundMinipool(address common.Address) (api.CanRefundMinipoolResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-refund %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/can-refund", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool can-refund %s", address.Hex()))
 	if err != nil {
 		return api.CanRefundMinipoolResponse{}, fmt.Errorf("Could not get can refund minipool status: %w", err)
 	}
@@ -74,7 +78,10 @@ func (c *Client) CanRefundMinipool(address common.Address) (api.CanRefundMinipoo
 
 // Refund ETH from a minipool
 func (c *Client) RefundMinipool(address common.Address) (api.RefundMinipoolResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool refund %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/refund", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool refund %s", address.Hex()))
 	if err != nil {
 		return api.RefundMinipoolResponse{}, fmt.Errorf("Could not refund minipool: %w", err)
 	}
@@ -88,9 +95,31 @@ func (c *Client) RefundMinipool(address common.Address) (api.RefundMinipoolRespo
 	return response, nil
 }
 
+// Get the recent performance of the node's minipools
+func (c *Client) MinipoolPerformance(epochs uint64) (api.MinipoolPerformanceResponse, error) {
+	responseBytes, err := c.callApiRoute("minipool/performance", api.MinipoolPerformanceRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Epochs:            epochs,
+	}, fmt.Sprintf("minipool performance %d", epochs))
+	if err != nil {
+		return api.MinipoolPerformanceResponse{}, fmt.Errorf("Could not get minipool performance: %w", err)
+	}
+	var response api.MinipoolPerformanceResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MinipoolPerformanceResponse{}, fmt.Errorf("Could not decode minipool performance response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MinipoolPerformanceResponse{}, fmt.Errorf("Could not get minipool performance: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check whether a minipool is eligible for staking
 func (c *Client) CanStakeMinipool(address common.Address) (api.CanStakeMinipoolResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-stake %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/can-stake", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool can-stake %s", address.Hex()))
 	if err != nil {
 		return api.CanStakeMinipoolResponse{}, fmt.Errorf("Could not get can stake minipool status: %w", err)
 	}
@@ -106,7 +135,10 @@ func (c *Client) CanStakeMinipool(address common.Address) (api.CanStakeMinipoolR
 
 // Stake a minipool
 func (c *Client) StakeMinipool(address common.Address) (api.StakeMinipoolResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool stake %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/stake", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool stake %s", address.Hex()))
 	if err != nil {
 		return api.StakeMinipoolResponse{}, fmt.Errorf("Could not stake minipool: %w", err)
 	}
@@ -122,7 +154,10 @@ func (c *Client) StakeMinipool(address common.Address) (api.StakeMinipoolRespons
 
 // Check whether a minipool is eligible for promotion
 func (c *Client) CanPromoteMinipool(address common.Address) (api.CanPromoteMinipoolResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-promote %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/can-promote", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool can-promote %s", address.Hex()))
 	if err != nil {
 		return api.CanPromoteMinipoolResponse{}, fmt.Errorf("Could not get can promote minipool status: %w", err)
 	}
@@ -138,7 +173,10 @@ func (c *Client) CanPromoteMinipool(address common.Address) (api.CanPromoteMinip
 
 // Promote a minipool
 func (c *Client) PromoteMinipool(address common.Address) (api.PromoteMinipoolResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool promote %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/promote", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool promote %s", address.Hex()))
 	if err != nil {
 		return api.PromoteMinipoolResponse{}, fmt.Errorf("Could not promote minipool: %w", err)
 	}
@@ -154,7 +192,10 @@ func (c *Client) PromoteMinipool(address common.Address) (api.PromoteMinipoolRes
 
 // Check whether a minipool can be dissolved
 func (c *Client) CanDissolveMinipool(address common.Address) (api.CanDissolveMinipoolResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-dissolve %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/can-dissolve", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool can-dissolve %s", address.Hex()))
 	if err != nil {
 		return api.CanDissolveMinipoolResponse{}, fmt.Errorf("Could not get can dissolve minipool status: %w", err)
 	}
@@ -170,7 +211,10 @@ func (c *Client) CanDissolveMinipool(address common.Address) (api.CanDissolveMin
 
 // Dissolve a minipool
 func (c *Client) DissolveMinipool(address common.Address) (api.DissolveMinipoolResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool dissolve %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/dissolve", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool dissolve %s", address.Hex()))
 	if err != nil {
 		return api.DissolveMinipoolResponse{}, fmt.Errorf("Could not dissolve minipool: %w", err)
 	}
@@ -186,7 +230,10 @@ func (c *Client) DissolveMinipool(address common.Address) (api.DissolveMinipoolR
 
 // Check whether a minipool can be exited
 func (c *Client) CanExitMinipool(address common.Address) (api.CanExitMinipoolResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-exit %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/can-exit", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool can-exit %s", address.Hex()))
 	if err != nil {
 		return api.CanExitMinipoolResponse{}, fmt.Errorf("Could not get can exit minipool status: %w", err)
 	}
@@ -202,7 +249,10 @@ func (c *Client) CanExitMinipool(address common.Address) (api.CanExitMinipoolRes
 
 // Exit a minipool
 func (c *Client) ExitMinipool(address common.Address) (api.ExitMinipoolResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool exit %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/exit", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool exit %s", address.Hex()))
 	if err != nil {
 		return api.ExitMinipoolResponse{}, fmt.Errorf("Could not exit minipool: %w", err)
 	}
@@ -216,9 +266,46 @@ func (c *Client) ExitMinipool(address common.Address) (api.ExitMinipoolResponse,
 	return response, nil
 }
 
+// Get pre-signed exit messages for all of the node's staking minipools
+func (c *Client) ExportMinipoolExits() (api.ExportMinipoolExitsResponse, error) {
+	responseBytes, err := c.callApiRoute("minipool/export-exits", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "minipool export-exits")
+	if err != nil {
+		return api.ExportMinipoolExitsResponse{}, fmt.Errorf("Could not export minipool exits: %w", err)
+	}
+	var response api.ExportMinipoolExitsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ExportMinipoolExitsResponse{}, fmt.Errorf("Could not decode export minipool exits response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ExportMinipoolExitsResponse{}, fmt.Errorf("Could not export minipool exits: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Broadcast a signed voluntary exit message to the Beacon Chain
+func (c *Client) BroadcastMinipoolExit(validatorIndex string, epoch uint64, signature types.ValidatorSignature) (api.BroadcastMinipoolExitResponse, error) {
+	responseBytes, err := c.callApiRoute("minipool/broadcast-exit", api.MinipoolBroadcastExitRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		ValidatorIndex:    validatorIndex,
+		Epoch:             epoch,
+		Signature:         signature,
+	}, fmt.Sprintf("minipool broadcast-exit %s %d %s", validatorIndex, epoch, signature.Hex()))
+	if err != nil {
+		return api.BroadcastMinipoolExitResponse{}, fmt.Errorf("Could not broadcast minipool exit: %w", err)
+	}
+	var response api.BroadcastMinipoolExitResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BroadcastMinipoolExitResponse{}, fmt.Errorf("Could not decode broadcast minipool exit response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BroadcastMinipoolExitResponse{}, fmt.Errorf("Could not broadcast minipool exit: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check all of the node's minipools for closure eligibility, and return the details of the closeable ones
 func (c *Client) GetMinipoolCloseDetailsForNode() (api.GetMinipoolCloseDetailsForNodeResponse, error) {
-	responseBytes, err := c.callAPI("minipool get-minipool-close-details-for-node")
+	responseBytes, err := c.callApiRoute("minipool/get-minipool-close-details-for-node", nil, "minipool get-minipool-close-details-for-node")
 	if err != nil {
 		return api.GetMinipoolCloseDetailsForNodeResponse{}, fmt.Errorf("Could not get get-minipool-close-details-for-node status: %w", err)
 	}
@@ -234,7 +321,10 @@ func (c *Client) GetMinipoolCloseDetailsForNode() (api.GetMinipoolCloseDetailsFo
 
 // Close a minipool
 func (c *Client) CloseMinipool(address common.Address) (api.CloseMinipoolResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool close %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/close", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool close %s", address.Hex()))
 	if err != nil {
 		return api.CloseMinipoolResponse{}, fmt.Errorf("Could not close minipool: %w", err)
 	}
@@ -250,7 +340,10 @@ func (c *Client) CloseMinipool(address common.Address) (api.CloseMinipoolRespons
 
 // Check whether a minipool can have its delegate upgraded
 func (c *Client) CanDelegateUpgradeMinipool(address common.Address) (api.CanDelegateUpgradeResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-delegate-upgrade %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/can-delegate-upgrade", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool can-delegate-upgrade %s", address.Hex()))
 	if err != nil {
 		return api.CanDelegateUpgradeResponse{}, fmt.Errorf("Could not get can delegate upgrade minipool status: %w", err)
 	}
@@ -266,7 +359,10 @@ func (c *Client) CanDelegateUpgradeMinipool(address common.Address) (api.CanDele
 
 // Upgrade a minipool delegate
 func (c *Client) DelegateUpgradeMinipool(address common.Address) (api.DelegateUpgradeResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool delegate-upgrade %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/delegate-upgrade", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool delegate-upgrade %s", address.Hex()))
 	if err != nil {
 		return api.DelegateUpgradeResponse{}, fmt.Errorf("Could not upgrade delegate for minipool: %w", err)
 	}
@@ -282,7 +378,10 @@ func (c *Client) DelegateUpgradeMinipool(address common.Address) (api.DelegateUp
 
 // Check whether a minipool can have its delegate rolled back
 func (c *Client) CanDelegateRollbackMinipool(address common.Address) (api.CanDelegateRollbackResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-delegate-rollback %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/can-delegate-rollback", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool can-delegate-rollback %s", address.Hex()))
 	if err != nil {
 		return api.CanDelegateRollbackResponse{}, fmt.Errorf("Could not get can delegate rollback minipool status: %w", err)
 	}
@@ -298,7 +397,10 @@ func (c *Client) CanDelegateRollbackMinipool(address common.Address) (api.CanDel
 
 // Rollback a minipool delegate
 func (c *Client) DelegateRollbackMinipool(address common.Address) (api.DelegateRollbackResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool delegate-rollback %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/delegate-rollback", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool delegate-rollback %s", address.Hex()))
 	if err != nil {
 		return api.DelegateRollbackResponse{}, fmt.Errorf("Could not rollback delegate for minipool: %w", err)
 	}
@@ -314,7 +416,11 @@ func (c *Client) DelegateRollbackMinipool(address common.Address) (api.DelegateR
 
 // Check whether a minipool can have its auto-upgrade setting changed
 func (c *Client) CanSetUseLatestDelegateMinipool(address common.Address, setting bool) (api.CanSetUseLatestDelegateResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-set-use-latest-delegate %s %t", address.Hex(), setting))
+	responseBytes, err := c.callApiRoute("minipool/can-set-use-latest-delegate", api.MinipoolSetUseLatestDelegateRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+		Setting:           setting,
+	}, fmt.Sprintf("minipool can-set-use-latest-delegate %s %t", address.Hex(), setting))
 	if err != nil {
 		return api.CanSetUseLatestDelegateResponse{}, fmt.Errorf("Could not get can set use latest delegate for minipool status: %w", err)
 	}
@@ -330,7 +436,11 @@ func (c *Client) CanSetUseLatestDelegateMinipool(address common.Address, setting
 
 // Change a minipool's auto-upgrade setting
 func (c *Client) SetUseLatestDelegateMinipool(address common.Address, setting bool) (api.SetUseLatestDelegateResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool set-use-latest-delegate %s %t", address.Hex(), setting))
+	responseBytes, err := c.callApiRoute("minipool/set-use-latest-delegate", api.MinipoolSetUseLatestDelegateRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+		Setting:           setting,
+	}, fmt.Sprintf("minipool set-use-latest-delegate %s %t", address.Hex(), setting))
 	if err != nil {
 		return api.SetUseLatestDelegateResponse{}, fmt.Errorf("Could not set use latest delegate for minipool: %w", err)
 	}
@@ -346,7 +456,11 @@ func (c *Client) SetUseLatestDelegateMinipool(address common.Address, setting bo
 
 // Get the artifacts necessary for vanity address searching
 func (c *Client) GetVanityArtifacts(depositAmount *big.Int, nodeAddress string) (api.GetVanityArtifactsResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool get-vanity-artifacts %s %s", depositAmount.String(), nodeAddress))
+	responseBytes, err := c.callApiRoute("minipool/get-vanity-artifacts", api.MinipoolVanityArtifactsRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		DepositAmount:     depositAmount,
+		NodeAddress:       nodeAddress,
+	}, fmt.Sprintf("minipool get-vanity-artifacts %s %s", depositAmount.String(), nodeAddress))
 	if err != nil {
 		return api.GetVanityArtifactsResponse{}, fmt.Errorf("Could not get vanity artifacts: %w", err)
 	}
@@ -362,7 +476,11 @@ func (c *Client) GetVanityArtifacts(depositAmount *big.Int, nodeAddress string)
 
 // Check whether the minipool can begin the bond reduction process
 func (c *Client) CanBeginReduceBondAmount(address common.Address, newBondAmountWei *big.Int) (api.CanBeginReduceBondAmountResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-begin-reduce-bond-amount %s %s", address.Hex(), newBondAmountWei.String()))
+	responseBytes, err := c.callApiRoute("minipool/can-begin-reduce-bond-amount", api.MinipoolBeginReduceBondAmountRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+		NewBondAmountWei:  newBondAmountWei,
+	}, fmt.Sprintf("minipool can-begin-reduce-bond-amount %s %s", address.Hex(), newBondAmountWei.String()))
 	if err != nil {
 		return api.CanBeginReduceBondAmountResponse{}, fmt.Errorf("Could not get can begin reduce bond amount status: %w", err)
 	}
@@ -378,7 +496,11 @@ func (c *Client) CanBeginReduceBondAmount(address common.Address, newBondAmountW
 
 // Begin the bond reduction process for a minipool
 func (c *Client) BeginReduceBondAmount(address common.Address, newBondAmountWei *big.Int) (api.BeginReduceBondAmountResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool begin-reduce-bond-amount %s %s", address.Hex(), newBondAmountWei.String()))
+	responseBytes, err := c.callApiRoute("minipool/begin-reduce-bond-amount", api.MinipoolBeginReduceBondAmountRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+		NewBondAmountWei:  newBondAmountWei,
+	}, fmt.Sprintf("minipool begin-reduce-bond-amount %s %s", address.Hex(), newBondAmountWei.String()))
 	if err != nil {
 		return api.BeginReduceBondAmountResponse{}, fmt.Errorf("Could not begin reduce bond amount: %w", err)
 	}
@@ -394,7 +516,10 @@ func (c *Client) BeginReduceBondAmount(address common.Address, newBondAmountWei
 
 // Check if a minipool's bond can be reduced
 func (c *Client) CanReduceBondAmount(address common.Address) (api.CanReduceBondAmountResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-reduce-bond-amount %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/can-reduce-bond-amount", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool can-reduce-bond-amount %s", address.Hex()))
 	if err != nil {
 		return api.CanReduceBondAmountResponse{}, fmt.Errorf("Could not get can reduce bond amount status: %w", err)
 	}
@@ -410,7 +535,10 @@ func (c *Client) CanReduceBondAmount(address common.Address) (api.CanReduceBondA
 
 // Reduce a minipool's bond
 func (c *Client) ReduceBondAmount(address common.Address) (api.ReduceBondAmountResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool reduce-bond-amount %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/reduce-bond-amount", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool reduce-bond-amount %s", address.Hex()))
 	if err != nil {
 		return api.ReduceBondAmountResponse{}, fmt.Errorf("Could not reduce bond amount: %w", err)
 	}
@@ -426,7 +554,7 @@ func (c *Client) ReduceBondAmount(address common.Address) (api.ReduceBondAmountR
 
 // Get the balance distribution details for all of the node's minipools
 func (c *Client) GetDistributeBalanceDetails() (api.GetDistributeBalanceDetailsResponse, error) {
-	responseBytes, err := c.callAPI("minipool get-distribute-balance-details")
+	responseBytes, err := c.callApiRoute("minipool/get-distribute-balance-details", nil, "minipool get-distribute-balance-details")
 	if err != nil {
 		return api.GetDistributeBalanceDetailsResponse{}, fmt.Errorf("Could not get distribute balance details: %w", err)
 	}
@@ -442,7 +570,10 @@ func (c *Client) GetDistributeBalanceDetails() (api.GetDistributeBalanceDetailsR
 
 // Distribute a minipool's ETH balance
 func (c *Client) DistributeBalance(address common.Address) (api.DistributeBalanceResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool distribute-balance %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("minipool/distribute-balance", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool distribute-balance %s", address.Hex()))
 	if err != nil {
 		return api.DistributeBalanceResponse{}, fmt.Errorf("Could not get distribute balance status: %w", err)
 	}
@@ -457,24 +588,52 @@ func (c *Client) DistributeBalance(address common.Address) (api.DistributeBalanc
 }
 
 // Import a validator private key for a vacant minipool
-func (c *Client) ImportKey(address common.Address, mnemonic string) (api.ChangeWithdrawalCredentialsResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool import-key %s", address.Hex()), mnemonic)
+func (c *Client) ImportKey(address common.Address, skipLivenessCheck bool, mnemonic string) (api.ImportKeyResponse, error) {
+	responseBytes, err := c.callApiRoute("minipool/import-key", api.MinipoolImportKeyRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+		SkipLivenessCheck: skipLivenessCheck,
+		Mnemonic:          mnemonic,
+	}, fmt.Sprintf("minipool import-key %s %t", address.Hex(), skipLivenessCheck), mnemonic)
 	if err != nil {
-		return api.ChangeWithdrawalCredentialsResponse{}, fmt.Errorf("Could not import validator key: %w", err)
+		return api.ImportKeyResponse{}, fmt.Errorf("Could not import validator key: %w", err)
 	}
-	var response api.ChangeWithdrawalCredentialsResponse
+	var response api.ImportKeyResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ImportKeyResponse{}, fmt.Errorf("Could not decode import-key response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ImportKeyResponse{}, fmt.Errorf("Could not import validator key: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Load a minipool's validator key into the Validator Client
+func (c *Client) LoadKey(address common.Address) (api.LoadKeyResponse, error) {
+	responseBytes, err := c.callApiRoute("minipool/load-key", api.MinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+	}, fmt.Sprintf("minipool load-key %s", address.Hex()))
+	if err != nil {
+		return api.LoadKeyResponse{}, fmt.Errorf("Could not load validator key: %w", err)
+	}
+	var response api.LoadKeyResponse
 	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return api.ChangeWithdrawalCredentialsResponse{}, fmt.Errorf("Could not decode import-key response: %w", err)
+		return api.LoadKeyResponse{}, fmt.Errorf("Could not decode load-key response: %w", err)
 	}
 	if response.Error != "" {
-		return api.ChangeWithdrawalCredentialsResponse{}, fmt.Errorf("Could not import validator key: %s", response.Error)
+		return api.LoadKeyResponse{}, fmt.Errorf("Could not load validator key: %s", response.Error)
 	}
 	return response, nil
 }
 
 // Check whether a solo validator's withdrawal creds can be migrated to a minipool address
 func (c *Client) CanChangeWithdrawalCredentials(address common.Address, mnemonic string) (api.CanChangeWithdrawalCredentialsResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-change-withdrawal-creds %s", address.Hex()), mnemonic)
+	responseBytes, err := c.callApiRoute("minipool/can-change-withdrawal-creds", api.MinipoolChangeWithdrawalCredsRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+		Mnemonic:          mnemonic,
+	}, fmt.Sprintf("minipool can-change-withdrawal-creds %s", address.Hex()), mnemonic)
 	if err != nil {
 		return api.CanChangeWithdrawalCredentialsResponse{}, fmt.Errorf("Could not get can-change-withdrawal-creds status: %w", err)
 	}
@@ -490,7 +649,11 @@ func (c *Client) CanChangeWithdrawalCredentials(address common.Address, mnemonic
 
 // Migrate a solo validator's withdrawal creds to a minipool address
 func (c *Client) ChangeWithdrawalCredentials(address common.Address, mnemonic string) (api.ChangeWithdrawalCredentialsResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool change-withdrawal-creds %s", address.Hex()), mnemonic)
+	responseBytes, err := c.callApiRoute("minipool/change-withdrawal-creds", api.MinipoolChangeWithdrawalCredsRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+		Mnemonic:          mnemonic,
+	}, fmt.Sprintf("minipool change-withdrawal-creds %s", address.Hex()), mnemonic)
 	if err != nil {
 		return api.ChangeWithdrawalCredentialsResponse{}, fmt.Errorf("Could not change withdrawal creds: %w", err)
 	}
@@ -506,7 +669,7 @@ func (c *Client) ChangeWithdrawalCredentials(address common.Address, mnemonic st
 
 // Check all of the node's minipools for rescue eligibility, and return the details of the rescuable ones
 func (c *Client) GetMinipoolRescueDissolvedDetailsForNode() (api.GetMinipoolRescueDissolvedDetailsForNodeResponse, error) {
-	responseBytes, err := c.callAPI("minipool get-rescue-dissolved-details-for-node")
+	responseBytes, err := c.callApiRoute("minipool/get-rescue-dissolved-details-for-node", nil, "minipool get-rescue-dissolved-details-for-node")
 	if err != nil {
 		return api.GetMinipoolRescueDissolvedDetailsForNodeResponse{}, fmt.Errorf("Could not get get-minipool-rescue-dissolved-details-for-node status: %w", err)
 	}
@@ -522,7 +685,11 @@ func (c *Client) GetMinipoolRescueDissolvedDetailsForNode() (api.GetMinipoolResc
 
 // Rescue a dissolved minipool by depositing ETH for it to the Beacon deposit contract
 func (c *Client) RescueDissolvedMinipool(address common.Address, amount *big.Int) (api.RescueDissolvedMinipoolResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("minipool rescue-dissolved %s %s", address.Hex(), amount.String()))
+	responseBytes, err := c.callApiRoute("minipool/rescue-dissolved", api.MinipoolRescueDissolvedRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MinipoolAddress:   address,
+		DepositAmount:     amount,
+	}, fmt.Sprintf("minipool rescue-dissolved %s %s", address.Hex(), amount.String()))
 	if err != nil {
 		return api.RescueDissolvedMinipoolResponse{}, fmt.Errorf("Could not rescue dissolved minipool: %w", err)
 	}
@@ -10,7 +10,7 @@ import (
 
 // Get network node fee
 func (c *Client) NodeFee() (api.NodeFeeResponse, error) {
-	responseBytes, err := c.callAPI("network node-fee")
+	responseBytes, err := c.callApiRoute("network/node-fee", nil, "network node-fee")
 	if err != nil {
 		return api.NodeFeeResponse{}, fmt.Errorf("Could not get network node fee: %w", err)
 	}
@@ -26,7 +26,7 @@ func (c *Client) NodeFee() (api.NodeFeeResponse, error) {
 
 // Get network RPL price
 func (c *Client) RplPrice() (api.RplPriceResponse, error) {
-	responseBytes, err := c.callAPI("network rpl-price")
+	responseBytes, err := c.callApiRoute("network/rpl-price", nil, "network rpl-price")
 	if err != nil {
 		return api.RplPriceResponse{}, fmt.Errorf("Could not get network RPL price: %w", err)
 	}
@@ -57,7 +57,7 @@ func (c *Client) RplPrice() (api.RplPriceResponse, error) {
 
 // Get network stats
 func (c *Client) NetworkStats() (api.NetworkStatsResponse, error) {
-	responseBytes, err := c.callAPI("network stats")
+	responseBytes, err := c.callApiRoute("network/stats", nil, "network stats")
 	if err != nil {
 		return api.NetworkStatsResponse{}, fmt.Errorf("Could not get network stats: %w", err)
 	}
@@ -73,7 +73,7 @@ func (c *Client) NetworkStats() (api.NetworkStatsResponse, error) {
 
 // Get the timezone map
 func (c *Client) TimezoneMap() (api.NetworkTimezonesResponse, error) {
-	responseBytes, err := c.callAPI("network timezone-map")
+	responseBytes, err := c.callApiRoute("network/timezone-map", nil, "network timezone-map")
 	if err != nil {
 		return api.NetworkTimezonesResponse{}, fmt.Errorf("Could not get network timezone map: %w", err)
 	}
@@ -89,7 +89,10 @@ func (c *Client) TimezoneMap() (api.NetworkTimezonesResponse, error) {
 
 // Check if the rewards tree for the provided interval can be generated
 func (c *Client) CanGenerateRewardsTree(index uint64) (api.CanNetworkGenerateRewardsTreeResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("network can-generate-rewards-tree %d", index))
+	responseBytes, err := c.callApiRoute("network/can-generate-rewards-tree", api.NetworkRewardsTreeRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Index:             index,
+	}, fmt.Sprintf("network can-generate-rewards-tree %d", index))
 	if err != nil {
 		return api.CanNetworkGenerateRewardsTreeResponse{}, fmt.Errorf("Could not check rewards tree generation status: %w", err)
 	}
@@ -105,7 +108,10 @@ func (c *Client) CanGenerateRewardsTree(index uint64) (api.CanNetworkGenerateRew
 
 // Set a request marker for the watchtower to generate the rewards tree for the given interval
 func (c *Client) GenerateRewardsTree(index uint64) (api.NetworkGenerateRewardsTreeResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("network generate-rewards-tree %d", index))
+	responseBytes, err := c.callApiRoute("network/generate-rewards-tree", api.NetworkRewardsTreeRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Index:             index,
+	}, fmt.Sprintf("network generate-rewards-tree %d", index))
 	if err != nil {
 		return api.NetworkGenerateRewardsTreeResponse{}, fmt.Errorf("Could not initialize rewards tree generation: %w", err)
 	}
@@ -119,9 +125,28 @@ func (c *Client) GenerateRewardsTree(index uint64) (api.NetworkGenerateRewardsTr
 	return response, nil
 }
 
+// Regenerate the rewards tree for the given interval and compare it against the canonical one
+func (c *Client) VerifyRewardsTree(index uint64) (api.NetworkVerifyRewardsTreeResponse, error) {
+	responseBytes, err := c.callApiRoute("network/verify-rewards-tree", api.NetworkRewardsTreeRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Index:             index,
+	}, fmt.Sprintf("network verify-rewards-tree %d", index))
+	if err != nil {
+		return api.NetworkVerifyRewardsTreeResponse{}, fmt.Errorf("Could not verify rewards tree: %w", err)
+	}
+	var response api.NetworkVerifyRewardsTreeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NetworkVerifyRewardsTreeResponse{}, fmt.Errorf("Could not decode rewards tree verification response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NetworkVerifyRewardsTreeResponse{}, fmt.Errorf("Could not verify rewards tree: %s", response.Error)
+	}
+	return response, nil
+}
+
 // GetActiveDAOProposals fetches information about active DAO proposals
 func (c *Client) GetActiveDAOProposals() (api.NetworkDAOProposalsResponse, error) {
-	responseBytes, err := c.callAPI("network dao-proposals")
+	responseBytes, err := c.callApiRoute("network/dao-proposals", nil, "network dao-proposals")
 	if err != nil {
 		return api.NetworkDAOProposalsResponse{}, fmt.Errorf("could not request active DAO proposals: %w", err)
 	}
@@ -137,7 +162,10 @@ func (c *Client) GetActiveDAOProposals() (api.NetworkDAOProposalsResponse, error
 
 // Download a rewards info file from IPFS for the given interval
 func (c *Client) DownloadRewardsFile(interval uint64) (api.DownloadRewardsFileResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("network download-rewards-file %d", interval))
+	responseBytes, err := c.callApiRoute("network/download-rewards-file", api.NetworkDownloadRewardsFileRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Interval:          interval,
+	}, fmt.Sprintf("network download-rewards-file %d", interval))
 	if err != nil {
 		return api.DownloadRewardsFileResponse{}, fmt.Errorf("could not download rewards file: %w", err)
 	}
@@ -153,7 +181,7 @@ func (c *Client) DownloadRewardsFile(interval uint64) (api.DownloadRewardsFileRe
 
 // Check if Atlas has been deployed yet
 func (c *Client) IsAtlasDeployed() (api.IsAtlasDeployedResponse, error) {
-	responseBytes, err := c.callAPI("network is-atlas-deployed")
+	responseBytes, err := c.callApiRoute("network/is-atlas-deployed", nil, "network is-atlas-deployed")
 	if err != nil {
 		return api.IsAtlasDeployedResponse{}, fmt.Errorf("could not check if Atlas is deployed: %w", err)
 	}
@@ -169,7 +197,7 @@ func (c *Client) IsAtlasDeployed() (api.IsAtlasDeployedResponse, error) {
 
 // Get the address of the latest minipool delegate contract
 func (c *Client) GetLatestDelegate() (api.GetLatestDelegateResponse, error) {
-	responseBytes, err := c.callAPI("network latest-delegate")
+	responseBytes, err := c.callApiRoute("network/latest-delegate", nil, "network latest-delegate")
 	if err != nil {
 		return api.GetLatestDelegateResponse{}, fmt.Errorf("could not get latest delegate: %w", err)
 	}
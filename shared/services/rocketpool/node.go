@@ -17,7 +17,7 @@ import (
 
 // Get node status
 func (c *Client) NodeStatus() (api.NodeStatusResponse, error) {
-	responseBytes, err := c.callAPI("node status")
+	responseBytes, err := c.callApiRoute("node/status", nil, "node status")
 	if err != nil {
 		return api.NodeStatusResponse{}, fmt.Errorf("Could not get node status: %w", err)
 	}
@@ -53,7 +53,10 @@ func (c *Client) NodeStatus() (api.NodeStatusResponse, error) {
 
 // Check whether the node can be registered
 func (c *Client) CanRegisterNode(timezoneLocation string) (api.CanRegisterNodeResponse, error) {
-	responseBytes, err := c.callAPI("node can-register", timezoneLocation)
+	responseBytes, err := c.callApiRoute("node/can-register", api.NodeTimezoneRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		TimezoneLocation:  timezoneLocation,
+	}, "node can-register", timezoneLocation)
 	if err != nil {
 		return api.CanRegisterNodeResponse{}, fmt.Errorf("Could not get can register node status: %w", err)
 	}
@@ -69,7 +72,10 @@ func (c *Client) CanRegisterNode(timezoneLocation string) (api.CanRegisterNodeRe
 
 // Register the node
 func (c *Client) RegisterNode(timezoneLocation string) (api.RegisterNodeResponse, error) {
-	responseBytes, err := c.callAPI("node register", timezoneLocation)
+	responseBytes, err := c.callApiRoute("node/register", api.NodeTimezoneRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		TimezoneLocation:  timezoneLocation,
+	}, "node register", timezoneLocation)
 	if err != nil {
 		return api.RegisterNodeResponse{}, fmt.Errorf("Could not register node: %w", err)
 	}
@@ -85,7 +91,11 @@ func (c *Client) RegisterNode(timezoneLocation string) (api.RegisterNodeResponse
 
 // Checks if the node's withdrawal address can be set
 func (c *Client) CanSetNodeWithdrawalAddress(withdrawalAddress common.Address, confirm bool) (api.CanSetNodeWithdrawalAddressResponse, error) {
-	responseBytes, err := c.callAPI("node can-set-withdrawal-address", withdrawalAddress.Hex(), strconv.FormatBool(confirm))
+	responseBytes, err := c.callApiRoute("node/can-set-withdrawal-address", api.NodeSetWithdrawalAddressRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		WithdrawalAddress: withdrawalAddress,
+		Confirm:           confirm,
+	}, "node can-set-withdrawal-address", withdrawalAddress.Hex(), strconv.FormatBool(confirm))
 	if err != nil {
 		return api.CanSetNodeWithdrawalAddressResponse{}, fmt.Errorf("Could not get can set node withdrawal address: %w", err)
 	}
@@ -101,7 +111,11 @@ func (c *Client) CanSetNodeWithdrawalAddress(withdrawalAddress common.Address, c
 
 // Set the node's withdrawal address
 func (c *Client) SetNodeWithdrawalAddress(withdrawalAddress common.Address, confirm bool) (api.SetNodeWithdrawalAddressResponse, error) {
-	responseBytes, err := c.callAPI("node set-withdrawal-address", withdrawalAddress.Hex(), strconv.FormatBool(confirm))
+	responseBytes, err := c.callApiRoute("node/set-withdrawal-address", api.NodeSetWithdrawalAddressRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		WithdrawalAddress: withdrawalAddress,
+		Confirm:           confirm,
+	}, "node set-withdrawal-address", withdrawalAddress.Hex(), strconv.FormatBool(confirm))
 	if err != nil {
 		return api.SetNodeWithdrawalAddressResponse{}, fmt.Errorf("Could not set node withdrawal address: %w", err)
 	}
@@ -117,7 +131,7 @@ func (c *Client) SetNodeWithdrawalAddress(withdrawalAddress common.Address, conf
 
 // Checks if the node's withdrawal address can be confirmed
 func (c *Client) CanConfirmNodeWithdrawalAddress() (api.CanSetNodeWithdrawalAddressResponse, error) {
-	responseBytes, err := c.callAPI("node can-confirm-withdrawal-address")
+	responseBytes, err := c.callApiRoute("node/can-confirm-withdrawal-address", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "node can-confirm-withdrawal-address")
 	if err != nil {
 		return api.CanSetNodeWithdrawalAddressResponse{}, fmt.Errorf("Could not get can confirm node withdrawal address: %w", err)
 	}
@@ -133,7 +147,7 @@ func (c *Client) CanConfirmNodeWithdrawalAddress() (api.CanSetNodeWithdrawalAddr
 
 // Confirm the node's withdrawal address
 func (c *Client) ConfirmNodeWithdrawalAddress() (api.SetNodeWithdrawalAddressResponse, error) {
-	responseBytes, err := c.callAPI("node confirm-withdrawal-address")
+	responseBytes, err := c.callApiRoute("node/confirm-withdrawal-address", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "node confirm-withdrawal-address")
 	if err != nil {
 		return api.SetNodeWithdrawalAddressResponse{}, fmt.Errorf("Could not confirm node withdrawal address: %w", err)
 	}
@@ -149,7 +163,10 @@ func (c *Client) ConfirmNodeWithdrawalAddress() (api.SetNodeWithdrawalAddressRes
 
 // Checks if the node's timezone location can be set
 func (c *Client) CanSetNodeTimezone(timezoneLocation string) (api.CanSetNodeTimezoneResponse, error) {
-	responseBytes, err := c.callAPI("node can-set-timezone", timezoneLocation)
+	responseBytes, err := c.callApiRoute("node/can-set-timezone", api.NodeTimezoneRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		TimezoneLocation:  timezoneLocation,
+	}, "node can-set-timezone", timezoneLocation)
 	if err != nil {
 		return api.CanSetNodeTimezoneResponse{}, fmt.Errorf("Could not get can set node timezone: %w", err)
 	}
@@ -165,7 +182,10 @@ func (c *Client) CanSetNodeTimezone(timezoneLocation string) (api.CanSetNodeTime
 
 // Set the node's timezone location
 func (c *Client) SetNodeTimezone(timezoneLocation string) (api.SetNodeTimezoneResponse, error) {
-	responseBytes, err := c.callAPI("node set-timezone", timezoneLocation)
+	responseBytes, err := c.callApiRoute("node/set-timezone", api.NodeTimezoneRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		TimezoneLocation:  timezoneLocation,
+	}, "node set-timezone", timezoneLocation)
 	if err != nil {
 		return api.SetNodeTimezoneResponse{}, fmt.Errorf("Could not set node timezone: %w", err)
 	}
@@ -181,7 +201,10 @@ func (c *Client) SetNodeTimezone(timezoneLocation string) (api.SetNodeTimezoneRe
 
 // Check whether the node can swap RPL tokens
 func (c *Client) CanNodeSwapRpl(amountWei *big.Int) (api.CanNodeSwapRplResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node can-swap-rpl %s", amountWei.String()))
+	responseBytes, err := c.callApiRoute("node/can-swap-rpl", api.NodeRplAmountRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+	}, fmt.Sprintf("node can-swap-rpl %s", amountWei.String()))
 	if err != nil {
 		return api.CanNodeSwapRplResponse{}, fmt.Errorf("Could not get can node swap RPL status: %w", err)
 	}
@@ -197,7 +220,10 @@ func (c *Client) CanNodeSwapRpl(amountWei *big.Int) (api.CanNodeSwapRplResponse,
 
 // Get the gas estimate for approving legacy RPL interaction
 func (c *Client) NodeSwapRplApprovalGas(amountWei *big.Int) (api.NodeSwapRplApproveGasResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node get-swap-rpl-approval-gas %s", amountWei.String()))
+	responseBytes, err := c.callApiRoute("node/get-swap-rpl-approval-gas", api.NodeRplAmountRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+	}, fmt.Sprintf("node get-swap-rpl-approval-gas %s", amountWei.String()))
 	if err != nil {
 		return api.NodeSwapRplApproveGasResponse{}, fmt.Errorf("Could not get old RPL approval gas: %w", err)
 	}
@@ -213,7 +239,10 @@ func (c *Client) NodeSwapRplApprovalGas(amountWei *big.Int) (api.NodeSwapRplAppr
 
 // Approves old RPL for a token swap
 func (c *Client) NodeSwapRplApprove(amountWei *big.Int) (api.NodeSwapRplApproveResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node swap-rpl-approve-rpl %s", amountWei.String()))
+	responseBytes, err := c.callApiRoute("node/swap-rpl-approve-rpl", api.NodeRplAmountRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+	}, fmt.Sprintf("node swap-rpl-approve-rpl %s", amountWei.String()))
 	if err != nil {
 		return api.NodeSwapRplApproveResponse{}, fmt.Errorf("Could not approve old RPL: %w", err)
 	}
@@ -229,7 +258,11 @@ func (c *Client) NodeSwapRplApprove(amountWei *big.Int) (api.NodeSwapRplApproveR
 
 // Swap node's old RPL tokens for new RPL tokens, waiting for the approval to be included in a block first
 func (c *Client) NodeWaitAndSwapRpl(amountWei *big.Int, approvalTxHash common.Hash) (api.NodeSwapRplSwapResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node wait-and-swap-rpl %s %s", amountWei.String(), approvalTxHash.String()))
+	responseBytes, err := c.callApiRoute("node/wait-and-swap-rpl", api.NodeWaitForRplApprovalRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+		Hash:              approvalTxHash,
+	}, fmt.Sprintf("node wait-and-swap-rpl %s %s", amountWei.String(), approvalTxHash.String()))
 	if err != nil {
 		return api.NodeSwapRplSwapResponse{}, fmt.Errorf("Could not swap node's RPL tokens: %w", err)
 	}
@@ -245,7 +278,10 @@ func (c *Client) NodeWaitAndSwapRpl(amountWei *big.Int, approvalTxHash common.Ha
 
 // Swap node's old RPL tokens for new RPL tokens
 func (c *Client) NodeSwapRpl(amountWei *big.Int) (api.NodeSwapRplSwapResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node swap-rpl %s", amountWei.String()))
+	responseBytes, err := c.callApiRoute("node/swap-rpl", api.NodeRplAmountRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+	}, fmt.Sprintf("node swap-rpl %s", amountWei.String()))
 	if err != nil {
 		return api.NodeSwapRplSwapResponse{}, fmt.Errorf("Could not swap node's RPL tokens: %w", err)
 	}
@@ -261,7 +297,7 @@ func (c *Client) NodeSwapRpl(amountWei *big.Int) (api.NodeSwapRplSwapResponse, e
 
 // Get a node's legacy RPL allowance for swapping on the new RPL contract
 func (c *Client) GetNodeSwapRplAllowance() (api.NodeSwapRplAllowanceResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node swap-rpl-allowance"))
+	responseBytes, err := c.callApiRoute("node/swap-rpl-allowance", nil, fmt.Sprintf("node swap-rpl-allowance"))
 	if err != nil {
 		return api.NodeSwapRplAllowanceResponse{}, fmt.Errorf("Could not get node swap RPL allowance: %w", err)
 	}
@@ -277,7 +313,10 @@ func (c *Client) GetNodeSwapRplAllowance() (api.NodeSwapRplAllowanceResponse, er
 
 // Check whether the node can stake RPL
 func (c *Client) CanNodeStakeRpl(amountWei *big.Int) (api.CanNodeStakeRplResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node can-stake-rpl %s", amountWei.String()))
+	responseBytes, err := c.callApiRoute("node/can-stake-rpl", api.NodeRplAmountRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+	}, fmt.Sprintf("node can-stake-rpl %s", amountWei.String()))
 	if err != nil {
 		return api.CanNodeStakeRplResponse{}, fmt.Errorf("Could not get can node stake RPL status: %w", err)
 	}
@@ -293,7 +332,10 @@ func (c *Client) CanNodeStakeRpl(amountWei *big.Int) (api.CanNodeStakeRplRespons
 
 // Get the gas estimate for approving new RPL interaction
 func (c *Client) NodeStakeRplApprovalGas(amountWei *big.Int) (api.NodeStakeRplApproveGasResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node get-stake-rpl-approval-gas %s", amountWei.String()))
+	responseBytes, err := c.callApiRoute("node/get-stake-rpl-approval-gas", api.NodeRplAmountRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+	}, fmt.Sprintf("node get-stake-rpl-approval-gas %s", amountWei.String()))
 	if err != nil {
 		return api.NodeStakeRplApproveGasResponse{}, fmt.Errorf("Could not get new RPL approval gas: %w", err)
 	}
@@ -309,7 +351,10 @@ func (c *Client) NodeStakeRplApprovalGas(amountWei *big.Int) (api.NodeStakeRplAp
 
 // Approve RPL for staking against the node
 func (c *Client) NodeStakeRplApprove(amountWei *big.Int) (api.NodeStakeRplApproveResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node stake-rpl-approve-rpl %s", amountWei.String()))
+	responseBytes, err := c.callApiRoute("node/stake-rpl-approve-rpl", api.NodeRplAmountRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+	}, fmt.Sprintf("node stake-rpl-approve-rpl %s", amountWei.String()))
 	if err != nil {
 		return api.NodeStakeRplApproveResponse{}, fmt.Errorf("Could not approve RPL for staking: %w", err)
 	}
@@ -325,7 +370,11 @@ func (c *Client) NodeStakeRplApprove(amountWei *big.Int) (api.NodeStakeRplApprov
 
 // Stake RPL against the node waiting for approvalTxHash to be included in a block first
 func (c *Client) NodeWaitAndStakeRpl(amountWei *big.Int, approvalTxHash common.Hash) (api.NodeStakeRplStakeResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node wait-and-stake-rpl %s %s", amountWei.String(), approvalTxHash.String()))
+	responseBytes, err := c.callApiRoute("node/wait-and-stake-rpl", api.NodeWaitForRplApprovalRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+		Hash:              approvalTxHash,
+	}, fmt.Sprintf("node wait-and-stake-rpl %s %s", amountWei.String(), approvalTxHash.String()))
 	if err != nil {
 		return api.NodeStakeRplStakeResponse{}, fmt.Errorf("Could not stake node RPL: %w", err)
 	}
@@ -341,7 +390,10 @@ func (c *Client) NodeWaitAndStakeRpl(amountWei *big.Int, approvalTxHash common.H
 
 // Stake RPL against the node
 func (c *Client) NodeStakeRpl(amountWei *big.Int) (api.NodeStakeRplStakeResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node stake-rpl %s", amountWei.String()))
+	responseBytes, err := c.callApiRoute("node/stake-rpl", api.NodeRplAmountRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+	}, fmt.Sprintf("node stake-rpl %s", amountWei.String()))
 	if err != nil {
 		return api.NodeStakeRplStakeResponse{}, fmt.Errorf("Could not stake node RPL: %w", err)
 	}
@@ -357,7 +409,7 @@ func (c *Client) NodeStakeRpl(amountWei *big.Int) (api.NodeStakeRplStakeResponse
 
 // Get a node's RPL allowance for the staking contract
 func (c *Client) GetNodeStakeRplAllowance() (api.NodeStakeRplAllowanceResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node stake-rpl-allowance"))
+	responseBytes, err := c.callApiRoute("node/stake-rpl-allowance", nil, fmt.Sprintf("node stake-rpl-allowance"))
 	if err != nil {
 		return api.NodeStakeRplAllowanceResponse{}, fmt.Errorf("Could not get node stake RPL allowance: %w", err)
 	}
@@ -373,7 +425,11 @@ func (c *Client) GetNodeStakeRplAllowance() (api.NodeStakeRplAllowanceResponse,
 
 // Checks if the node operate can set RPL stake for allowed
 func (c *Client) CanSetStakeRPLForAllowed(caller common.Address, allowed bool) (api.CanSetStakeRplForAllowedResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node can-set-stake-rpl-for-allowed %s %t", caller.Hex(), allowed))
+	responseBytes, err := c.callApiRoute("node/can-set-stake-rpl-for-allowed", api.NodeStakeRplForAllowedRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		CallerAddress:     caller,
+		Allowed:           allowed,
+	}, fmt.Sprintf("node can-set-stake-rpl-for-allowed %s %t", caller.Hex(), allowed))
 	if err != nil {
 		return api.CanSetStakeRplForAllowedResponse{}, fmt.Errorf("Could not get can set stake RPL for allowed: %w", err)
 	}
@@ -389,7 +445,11 @@ func (c *Client) CanSetStakeRPLForAllowed(caller common.Address, allowed bool) (
 
 // Sets the allow state of another address staking on behalf of the node
 func (c *Client) SetStakeRPLForAllowed(caller common.Address, allowed bool) (api.SetStakeRplForAllowedResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node set-stake-rpl-for-allowed %s %t", caller.Hex(), allowed))
+	responseBytes, err := c.callApiRoute("node/set-stake-rpl-for-allowed", api.NodeStakeRplForAllowedRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		CallerAddress:     caller,
+		Allowed:           allowed,
+	}, fmt.Sprintf("node set-stake-rpl-for-allowed %s %t", caller.Hex(), allowed))
 	if err != nil {
 		return api.SetStakeRplForAllowedResponse{}, fmt.Errorf("Could not set stake RPL for allowed: %w", err)
 	}
@@ -405,7 +465,10 @@ func (c *Client) SetStakeRPLForAllowed(caller common.Address, allowed bool) (api
 
 // Check whether the node can withdraw RPL
 func (c *Client) CanNodeWithdrawRpl(amountWei *big.Int) (api.CanNodeWithdrawRplResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node can-withdraw-rpl %s", amountWei.String()))
+	responseBytes, err := c.callApiRoute("node/can-withdraw-rpl", api.NodeRplAmountRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+	}, fmt.Sprintf("node can-withdraw-rpl %s", amountWei.String()))
 	if err != nil {
 		return api.CanNodeWithdrawRplResponse{}, fmt.Errorf("Could not get can node withdraw RPL status: %w", err)
 	}
@@ -421,7 +484,10 @@ func (c *Client) CanNodeWithdrawRpl(amountWei *big.Int) (api.CanNodeWithdrawRplR
 
 // Withdraw RPL staked against the node
 func (c *Client) NodeWithdrawRpl(amountWei *big.Int) (api.NodeWithdrawRplResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node withdraw-rpl %s", amountWei.String()))
+	responseBytes, err := c.callApiRoute("node/withdraw-rpl", api.NodeRplAmountRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+	}, fmt.Sprintf("node withdraw-rpl %s", amountWei.String()))
 	if err != nil {
 		return api.NodeWithdrawRplResponse{}, fmt.Errorf("Could not withdraw node RPL: %w", err)
 	}
@@ -437,7 +503,12 @@ func (c *Client) NodeWithdrawRpl(amountWei *big.Int) (api.NodeWithdrawRplRespons
 
 // Check whether the node can make a deposit
 func (c *Client) CanNodeDeposit(amountWei *big.Int, minFee float64, salt *big.Int) (api.CanNodeDepositResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node can-deposit %s %f %s", amountWei.String(), minFee, salt.String()))
+	responseBytes, err := c.callApiRoute("node/can-deposit", api.NodeCanDepositRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+		MinNodeFee:        minFee,
+		Salt:              salt,
+	}, fmt.Sprintf("node can-deposit %s %f %s", amountWei.String(), minFee, salt.String()))
 	if err != nil {
 		return api.CanNodeDepositResponse{}, fmt.Errorf("Could not get can node deposit status: %w", err)
 	}
@@ -452,8 +523,23 @@ func (c *Client) CanNodeDeposit(amountWei *big.Int, minFee float64, salt *big.In
 }
 
 // Make a node deposit
-func (c *Client) NodeDeposit(amountWei *big.Int, minFee float64, salt *big.Int, useCreditBalance bool, submit bool) (api.NodeDepositResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node deposit %s %f %s %t %t", amountWei.String(), minFee, salt.String(), useCreditBalance, submit))
+func (c *Client) NodeDeposit(amountWei *big.Int, minFee float64, salt *big.Int, useCreditBalance bool, skipLivenessCheck bool, submit bool) (api.NodeDepositResponse, error) {
+	args := fmt.Sprintf("node deposit %s %f %s %t %t %t", amountWei.String(), minFee, salt.String(), useCreditBalance, skipLivenessCheck, submit)
+	var responseBytes []byte
+	var err error
+	if submit {
+		responseBytes, err = c.callApiRoute("node/deposit", api.NodeDepositRequest{
+			ApiServerSettings: c.getApiServerSettings(),
+			AmountWei:         amountWei,
+			MinNodeFee:        minFee,
+			Salt:              salt,
+			UseCreditBalance:  useCreditBalance,
+			SkipLivenessCheck: skipLivenessCheck,
+		}, args)
+	} else {
+		// The API server always submits deposits, so unsubmitted ones need the API process to print the transaction
+		responseBytes, err = c.callAPI(args)
+	}
 	if err != nil {
 		return api.NodeDepositResponse{}, fmt.Errorf("Could not make node deposit: %w", err)
 	}
@@ -469,7 +555,12 @@ func (c *Client) NodeDeposit(amountWei *big.Int, minFee float64, salt *big.Int,
 
 // Check whether the node can send tokens
 func (c *Client) CanNodeSend(amountWei *big.Int, token string, toAddress common.Address) (api.CanNodeSendResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node can-send %s %s %s", amountWei.String(), token, toAddress.Hex()))
+	responseBytes, err := c.callApiRoute("node/can-send", api.NodeSendRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+		Token:             token,
+		ToAddress:         toAddress,
+	}, fmt.Sprintf("node can-send %s %s %s", amountWei.String(), token, toAddress.Hex()))
 	if err != nil {
 		return api.CanNodeSendResponse{}, fmt.Errorf("Could not get can node send status: %w", err)
 	}
@@ -485,7 +576,12 @@ func (c *Client) CanNodeSend(amountWei *big.Int, token string, toAddress common.
 
 // Send tokens from the node to an address
 func (c *Client) NodeSend(amountWei *big.Int, token string, toAddress common.Address) (api.NodeSendResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node send %s %s %s", amountWei.String(), token, toAddress.Hex()))
+	responseBytes, err := c.callApiRoute("node/send", api.NodeSendRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+		Token:             token,
+		ToAddress:         toAddress,
+	}, fmt.Sprintf("node send %s %s %s", amountWei.String(), token, toAddress.Hex()))
 	if err != nil {
 		return api.NodeSendResponse{}, fmt.Errorf("Could not send tokens from node: %w", err)
 	}
@@ -501,7 +597,11 @@ func (c *Client) NodeSend(amountWei *big.Int, token string, toAddress common.Add
 
 // Check whether the node can burn tokens
 func (c *Client) CanNodeBurn(amountWei *big.Int, token string) (api.CanNodeBurnResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node can-burn %s %s", amountWei.String(), token))
+	responseBytes, err := c.callApiRoute("node/can-burn", api.NodeBurnRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+		Token:             token,
+	}, fmt.Sprintf("node can-burn %s %s", amountWei.String(), token))
 	if err != nil {
 		return api.CanNodeBurnResponse{}, fmt.Errorf("Could not get can node burn status: %w", err)
 	}
@@ -517,7 +617,11 @@ func (c *Client) CanNodeBurn(amountWei *big.Int, token string) (api.CanNodeBurnR
 
 // Burn tokens owned by the node for ETH
 func (c *Client) NodeBurn(amountWei *big.Int, token string) (api.NodeBurnResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node burn %s %s", amountWei.String(), token))
+	responseBytes, err := c.callApiRoute("node/burn", api.NodeBurnRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+		Token:             token,
+	}, fmt.Sprintf("node burn %s %s", amountWei.String(), token))
 	if err != nil {
 		return api.NodeBurnResponse{}, fmt.Errorf("Could not burn tokens owned by node: %w", err)
 	}
@@ -533,7 +637,7 @@ func (c *Client) NodeBurn(amountWei *big.Int, token string) (api.NodeBurnRespons
 
 // Get node sync progress
 func (c *Client) NodeSync() (api.NodeSyncProgressResponse, error) {
-	responseBytes, err := c.callAPI("node sync")
+	responseBytes, err := c.callApiRoute("node/sync", nil, "node sync")
 	if err != nil {
 		return api.NodeSyncProgressResponse{}, fmt.Errorf("Could not get node sync: %w", err)
 	}
@@ -549,7 +653,7 @@ func (c *Client) NodeSync() (api.NodeSyncProgressResponse, error) {
 
 // Check whether the node has RPL rewards available to claim
 func (c *Client) CanNodeClaimRpl() (api.CanNodeClaimRplResponse, error) {
-	responseBytes, err := c.callAPI("node can-claim-rpl-rewards")
+	responseBytes, err := c.callApiRoute("node/can-claim-rpl-rewards", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "node can-claim-rpl-rewards")
 	if err != nil {
 		return api.CanNodeClaimRplResponse{}, fmt.Errorf("Could not get can node claim rpl rewards status: %w", err)
 	}
@@ -565,7 +669,7 @@ func (c *Client) CanNodeClaimRpl() (api.CanNodeClaimRplResponse, error) {
 
 // Claim available RPL rewards
 func (c *Client) NodeClaimRpl() (api.NodeClaimRplResponse, error) {
-	responseBytes, err := c.callAPI("node claim-rpl-rewards")
+	responseBytes, err := c.callApiRoute("node/claim-rpl-rewards", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "node claim-rpl-rewards")
 	if err != nil {
 		return api.NodeClaimRplResponse{}, fmt.Errorf("Could not claim rpl rewards: %w", err)
 	}
@@ -581,7 +685,7 @@ func (c *Client) NodeClaimRpl() (api.NodeClaimRplResponse, error) {
 
 // Get node RPL rewards status
 func (c *Client) NodeRewards() (api.NodeRewardsResponse, error) {
-	responseBytes, err := c.callAPI("node rewards")
+	responseBytes, err := c.callApiRoute("node/rewards", nil, "node rewards")
 	if err != nil {
 		return api.NodeRewardsResponse{}, fmt.Errorf("Could not get node rewards: %w", err)
 	}
@@ -595,9 +699,43 @@ func (c *Client) NodeRewards() (api.NodeRewardsResponse, error) {
 	return response, nil
 }
 
+// Estimate the node's Smoothing Pool and collateral rewards for the current interval so far
+func (c *Client) EstimateSmoothingPoolRewards() (api.NodeEstimateSmoothingPoolRewardsResponse, error) {
+	responseBytes, err := c.callApiRoute("node/estimate-smoothing-pool-rewards", nil, "node estimate-smoothing-pool-rewards")
+	if err != nil {
+		return api.NodeEstimateSmoothingPoolRewardsResponse{}, fmt.Errorf("Could not estimate Smoothing Pool rewards: %w", err)
+	}
+	var response api.NodeEstimateSmoothingPoolRewardsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeEstimateSmoothingPoolRewardsResponse{}, fmt.Errorf("Could not decode Smoothing Pool rewards estimate response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeEstimateSmoothingPoolRewardsResponse{}, fmt.Errorf("Could not estimate Smoothing Pool rewards: %s", response.Error)
+	}
+	if response.SmoothingPoolBalance == nil {
+		response.SmoothingPoolBalance = big.NewInt(0)
+	}
+	if response.PoolStakerSmoothingPoolEth == nil {
+		response.PoolStakerSmoothingPoolEth = big.NewInt(0)
+	}
+	if response.NodeOperatorSmoothingPoolEth == nil {
+		response.NodeOperatorSmoothingPoolEth = big.NewInt(0)
+	}
+	if response.NodeSmoothingPoolEth == nil {
+		response.NodeSmoothingPoolEth = big.NewInt(0)
+	}
+	if response.NodeCollateralRpl == nil {
+		response.NodeCollateralRpl = big.NewInt(0)
+	}
+	if response.TotalCollateralRpl == nil {
+		response.TotalCollateralRpl = big.NewInt(0)
+	}
+	return response, nil
+}
+
 // Get the deposit contract info for Rocket Pool and the Beacon Client
 func (c *Client) DepositContractInfo() (api.DepositContractInfoResponse, error) {
-	responseBytes, err := c.callAPI("node deposit-contract-info")
+	responseBytes, err := c.callApiRoute("node/deposit-contract-info", nil, "node deposit-contract-info")
 	if err != nil {
 		return api.DepositContractInfoResponse{}, fmt.Errorf("Could not get deposit contract info: %w", err)
 	}
@@ -613,7 +751,10 @@ func (c *Client) DepositContractInfo() (api.DepositContractInfoResponse, error)
 
 // Estimate the gas required to set a voting snapshot delegate
 func (c *Client) EstimateSetSnapshotDelegateGas(address common.Address) (api.EstimateSetSnapshotDelegateGasResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node estimate-set-snapshot-delegate-gas %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("node/estimate-set-snapshot-delegate-gas", api.NodeSetSnapshotDelegateRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Delegate:          address,
+	}, fmt.Sprintf("node estimate-set-snapshot-delegate-gas %s", address.Hex()))
 	if err != nil {
 		return api.EstimateSetSnapshotDelegateGasResponse{}, fmt.Errorf("Could not get estimate-set-snapshot-delegate-gas response: %w", err)
 	}
@@ -629,7 +770,10 @@ func (c *Client) EstimateSetSnapshotDelegateGas(address common.Address) (api.Est
 
 // Set a voting snapshot delegate for the node
 func (c *Client) SetSnapshotDelegate(address common.Address) (api.SetSnapshotDelegateResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node set-snapshot-delegate %s", address.Hex()))
+	responseBytes, err := c.callApiRoute("node/set-snapshot-delegate", api.NodeSetSnapshotDelegateRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Delegate:          address,
+	}, fmt.Sprintf("node set-snapshot-delegate %s", address.Hex()))
 	if err != nil {
 		return api.SetSnapshotDelegateResponse{}, fmt.Errorf("Could not get set-snapshot-delegate response: %w", err)
 	}
@@ -645,7 +789,7 @@ func (c *Client) SetSnapshotDelegate(address common.Address) (api.SetSnapshotDel
 
 // Estimate the gas required to clear the node's voting snapshot delegate
 func (c *Client) EstimateClearSnapshotDelegateGas() (api.EstimateClearSnapshotDelegateGasResponse, error) {
-	responseBytes, err := c.callAPI("node estimate-clear-snapshot-delegate-gas")
+	responseBytes, err := c.callApiRoute("node/estimate-clear-snapshot-delegate-gas", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "node estimate-clear-snapshot-delegate-gas")
 	if err != nil {
 		return api.EstimateClearSnapshotDelegateGasResponse{}, fmt.Errorf("Could not get estimate-clear-snapshot-delegate-gas response: %w", err)
 	}
@@ -661,7 +805,7 @@ func (c *Client) EstimateClearSnapshotDelegateGas() (api.EstimateClearSnapshotDe
 
 // Clear the node's voting snapshot delegate
 func (c *Client) ClearSnapshotDelegate() (api.ClearSnapshotDelegateResponse, error) {
-	responseBytes, err := c.callAPI("node clear-snapshot-delegate")
+	responseBytes, err := c.callApiRoute("node/clear-snapshot-delegate", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "node clear-snapshot-delegate")
 	if err != nil {
 		return api.ClearSnapshotDelegateResponse{}, fmt.Errorf("Could not get clear-snapshot-delegate response: %w", err)
 	}
@@ -677,7 +821,7 @@ func (c *Client) ClearSnapshotDelegate() (api.ClearSnapshotDelegateResponse, err
 
 // Get the initialization status of the fee distributor contract
 func (c *Client) IsFeeDistributorInitialized() (api.NodeIsFeeDistributorInitializedResponse, error) {
-	responseBytes, err := c.callAPI("node is-fee-distributor-initialized")
+	responseBytes, err := c.callApiRoute("node/is-fee-distributor-initialized", nil, "node is-fee-distributor-initialized")
 	if err != nil {
 		return api.NodeIsFeeDistributorInitializedResponse{}, fmt.Errorf("Could not get fee distributor initialization status: %w", err)
 	}
@@ -693,7 +837,7 @@ func (c *Client) IsFeeDistributorInitialized() (api.NodeIsFeeDistributorInitiali
 
 // Get the gas cost for initializing the fee distributor contract
 func (c *Client) GetInitializeFeeDistributorGas() (api.NodeInitializeFeeDistributorGasResponse, error) {
-	responseBytes, err := c.callAPI("node get-initialize-fee-distributor-gas")
+	responseBytes, err := c.callApiRoute("node/get-initialize-fee-distributor-gas", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "node get-initialize-fee-distributor-gas")
 	if err != nil {
 		return api.NodeInitializeFeeDistributorGasResponse{}, fmt.Errorf("Could not get initialize fee distributor gas: %w", err)
 	}
@@ -709,7 +853,7 @@ func (c *Client) GetInitializeFeeDistributorGas() (api.NodeInitializeFeeDistribu
 
 // Initialize the fee distributor contract
 func (c *Client) InitializeFeeDistributor() (api.NodeInitializeFeeDistributorResponse, error) {
-	responseBytes, err := c.callAPI("node initialize-fee-distributor")
+	responseBytes, err := c.callApiRoute("node/initialize-fee-distributor", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "node initialize-fee-distributor")
 	if err != nil {
 		return api.NodeInitializeFeeDistributorResponse{}, fmt.Errorf("Could not initialize fee distributor: %w", err)
 	}
@@ -725,7 +869,7 @@ func (c *Client) InitializeFeeDistributor() (api.NodeInitializeFeeDistributorRes
 
 // Check if distributing ETH from the node's fee distributor is possible
 func (c *Client) CanDistribute() (api.NodeCanDistributeResponse, error) {
-	responseBytes, err := c.callAPI("node can-distribute")
+	responseBytes, err := c.callApiRoute("node/can-distribute", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "node can-distribute")
 	if err != nil {
 		return api.NodeCanDistributeResponse{}, fmt.Errorf("Could not get can distribute: %w", err)
 	}
@@ -741,7 +885,7 @@ func (c *Client) CanDistribute() (api.NodeCanDistributeResponse, error) {
 
 // Distribute ETH from the node's fee distributor
 func (c *Client) Distribute() (api.NodeDistributeResponse, error) {
-	responseBytes, err := c.callAPI("node distribute")
+	responseBytes, err := c.callApiRoute("node/distribute", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "node distribute")
 	if err != nil {
 		return api.NodeDistributeResponse{}, fmt.Errorf("Could not distribute ETH: %w", err)
 	}
@@ -757,7 +901,7 @@ func (c *Client) Distribute() (api.NodeDistributeResponse, error) {
 
 // Get info about your eligible rewards periods, including balances and Merkle proofs
 func (c *Client) GetRewardsInfo() (api.NodeGetRewardsInfoResponse, error) {
-	responseBytes, err := c.callAPI("node get-rewards-info")
+	responseBytes, err := c.callApiRoute("node/get-rewards-info", nil, "node get-rewards-info")
 	if err != nil {
 		return api.NodeGetRewardsInfoResponse{}, fmt.Errorf("Could not get rewards info: %w", err)
 	}
@@ -777,7 +921,10 @@ func (c *Client) CanNodeClaimRewards(indices []uint64) (api.CanNodeClaimRewardsR
 	for _, index := range indices {
 		indexStrings = append(indexStrings, fmt.Sprint(index))
 	}
-	responseBytes, err := c.callAPI("node can-claim-rewards", strings.Join(indexStrings, ","))
+	responseBytes, err := c.callApiRoute("node/can-claim-rewards", api.NodeClaimRewardsRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Indices:           indices,
+	}, "node can-claim-rewards", strings.Join(indexStrings, ","))
 	if err != nil {
 		return api.CanNodeClaimRewardsResponse{}, fmt.Errorf("Could not check if can claim rewards: %w", err)
 	}
@@ -797,7 +944,10 @@ func (c *Client) NodeClaimRewards(indices []uint64) (api.NodeClaimRewardsRespons
 	for _, index := range indices {
 		indexStrings = append(indexStrings, fmt.Sprint(index))
 	}
-	responseBytes, err := c.callAPI("node claim-rewards", strings.Join(indexStrings, ","))
+	responseBytes, err := c.callApiRoute("node/claim-rewards", api.NodeClaimRewardsRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Indices:           indices,
+	}, "node claim-rewards", strings.Join(indexStrings, ","))
 	if err != nil {
 		return api.NodeClaimRewardsResponse{}, fmt.Errorf("Could not claim rewards: %w", err)
 	}
@@ -817,7 +967,11 @@ func (c *Client) CanNodeClaimAndStakeRewards(indices []uint64, stakeAmountWei *b
 	for _, index := range indices {
 		indexStrings = append(indexStrings, fmt.Sprint(index))
 	}
-	responseBytes, err := c.callAPI("node can-claim-and-stake-rewards", strings.Join(indexStrings, ","), stakeAmountWei.String())
+	responseBytes, err := c.callApiRoute("node/can-claim-and-stake-rewards", api.NodeClaimAndStakeRewardsRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Indices:           indices,
+		StakeAmount:       stakeAmountWei,
+	}, "node can-claim-and-stake-rewards", strings.Join(indexStrings, ","), stakeAmountWei.String())
 	if err != nil {
 		return api.CanNodeClaimAndStakeRewardsResponse{}, fmt.Errorf("Could not check if can claim and stake rewards: %w", err)
 	}
@@ -837,7 +991,11 @@ func (c *Client) NodeClaimAndStakeRewards(indices []uint64, stakeAmountWei *big.
 	for _, index := range indices {
 		indexStrings = append(indexStrings, fmt.Sprint(index))
 	}
-	responseBytes, err := c.callAPI("node claim-and-stake-rewards", strings.Join(indexStrings, ","), stakeAmountWei.String())
+	responseBytes, err := c.callApiRoute("node/claim-and-stake-rewards", api.NodeClaimAndStakeRewardsRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Indices:           indices,
+		StakeAmount:       stakeAmountWei,
+	}, "node claim-and-stake-rewards", strings.Join(indexStrings, ","), stakeAmountWei.String())
 	if err != nil {
 		return api.NodeClaimAndStakeRewardsResponse{}, fmt.Errorf("Could not claim and stake rewards: %w", err)
 	}
@@ -853,7 +1011,7 @@ func (c *Client) NodeClaimAndStakeRewards(indices []uint64, stakeAmountWei *big.
 
 // Check whether or not the node is opted into the Smoothing Pool
 func (c *Client) NodeGetSmoothingPoolRegistrationStatus() (api.GetSmoothingPoolRegistrationStatusResponse, error) {
-	responseBytes, err := c.callAPI("node get-smoothing-pool-registration-status")
+	responseBytes, err := c.callApiRoute("node/get-smoothing-pool-registration-status", nil, "node get-smoothing-pool-registration-status")
 	if err != nil {
 		return api.GetSmoothingPoolRegistrationStatusResponse{}, fmt.Errorf("Could not get smoothing pool registration status: %w", err)
 	}
@@ -869,7 +1027,10 @@ func (c *Client) NodeGetSmoothingPoolRegistrationStatus() (api.GetSmoothingPoolR
 
 // Check if the node's Smoothing Pool status can be changed
 func (c *Client) CanNodeSetSmoothingPoolStatus(status bool) (api.CanSetSmoothingPoolRegistrationStatusResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node can-set-smoothing-pool-status %t", status))
+	responseBytes, err := c.callApiRoute("node/can-set-smoothing-pool-status", api.NodeSetSmoothingPoolStatusRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Status:            status,
+	}, fmt.Sprintf("node can-set-smoothing-pool-status %t", status))
 	if err != nil {
 		return api.CanSetSmoothingPoolRegistrationStatusResponse{}, fmt.Errorf("Could not get can-set-smoothing-pool-status: %w", err)
 	}
@@ -885,7 +1046,10 @@ func (c *Client) CanNodeSetSmoothingPoolStatus(status bool) (api.CanSetSmoothing
 
 // Sets the node's Smoothing Pool opt-in status
 func (c *Client) NodeSetSmoothingPoolStatus(status bool) (api.SetSmoothingPoolRegistrationStatusResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node set-smoothing-pool-status %t", status))
+	responseBytes, err := c.callApiRoute("node/set-smoothing-pool-status", api.NodeSetSmoothingPoolStatusRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Status:            status,
+	}, fmt.Sprintf("node set-smoothing-pool-status %t", status))
 	if err != nil {
 		return api.SetSmoothingPoolRegistrationStatusResponse{}, fmt.Errorf("Could not set smoothing pool status: %w", err)
 	}
@@ -900,7 +1064,10 @@ func (c *Client) NodeSetSmoothingPoolStatus(status bool) (api.SetSmoothingPoolRe
 }
 
 func (c *Client) ResolveEnsName(name string) (api.ResolveEnsNameResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node resolve-ens-name %s", name))
+	responseBytes, err := c.callApiRoute("node/resolve-ens-name", api.NodeResolveEnsNameRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Name:              name,
+	}, fmt.Sprintf("node resolve-ens-name %s", name))
 	if err != nil {
 		return api.ResolveEnsNameResponse{}, fmt.Errorf("Could not resolve ENS name: %w", err)
 	}
@@ -914,7 +1081,10 @@ func (c *Client) ResolveEnsName(name string) (api.ResolveEnsNameResponse, error)
 	return response, nil
 }
 func (c *Client) ReverseResolveEnsName(name string) (api.ResolveEnsNameResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node reverse-resolve-ens-name %s", name))
+	responseBytes, err := c.callApiRoute("node/reverse-resolve-ens-name", api.NodeReverseResolveEnsNameRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Address:           name,
+	}, fmt.Sprintf("node reverse-resolve-ens-name %s", name))
 	if err != nil {
 		return api.ResolveEnsNameResponse{}, fmt.Errorf("Could not reverse resolve ENS name: %w", err)
 	}
@@ -932,7 +1102,10 @@ func (c *Client) ReverseResolveEnsName(name string) (api.ResolveEnsNameResponse,
 func (c *Client) SignMessage(message string) (api.NodeSignResponse, error) {
 	// Ignore sync status so we can sign messages even without ready clients
 	c.ignoreSyncCheck = true
-	responseBytes, err := c.callAPI("node sign-message", message)
+	responseBytes, err := c.callApiRoute("node/sign-message", api.NodeSignMessageRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Message:           message,
+	}, "node sign-message", message)
 	if err != nil {
 		return api.NodeSignResponse{}, fmt.Errorf("Could not sign message: %w", err)
 	}
@@ -947,9 +1120,60 @@ func (c *Client) SignMessage(message string) (api.NodeSignResponse, error) {
 	return response, nil
 }
 
+// Use the node private key to sign EIP-712 typed data; typed data without a chain ID is only signed if allowNoChainID is set
+func (c *Client) SignTypedData(typedData string, allowNoChainID bool) (api.NodeSignResponse, error) {
+	// Ignore sync status so we can sign messages even without ready clients
+	c.ignoreSyncCheck = true
+	responseBytes, err := c.callApiRoute("node/sign-typed-data", api.NodeSignTypedDataRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Data:              typedData,
+		AllowNoChainID:    allowNoChainID,
+	}, "node sign-typed-data", typedData, strconv.FormatBool(allowNoChainID))
+	if err != nil {
+		return api.NodeSignResponse{}, fmt.Errorf("Could not sign typed data: %w", err)
+	}
+
+	var response api.NodeSignResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeSignResponse{}, fmt.Errorf("Could not decode node sign response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeSignResponse{}, fmt.Errorf("Could not sign typed data: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Use the node private key to sign a Sign-In with Ethereum message
+func (c *Client) SignSiweMessage(message string) (api.NodeSignResponse, error) {
+	// Ignore sync status so we can sign messages even without ready clients
+	c.ignoreSyncCheck = true
+	responseBytes, err := c.callApiRoute("node/sign-siwe", api.NodeSignMessageRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Message:           message,
+	}, "node sign-siwe", message)
+	if err != nil {
+		return api.NodeSignResponse{}, fmt.Errorf("Could not sign Sign-In with Ethereum message: %w", err)
+	}
+
+	var response api.NodeSignResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeSignResponse{}, fmt.Errorf("Could not decode node sign response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeSignResponse{}, fmt.Errorf("Could not sign Sign-In with Ethereum message: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check whether a vacant minipool can be created for solo staker migration
 func (c *Client) CanCreateVacantMinipool(amountWei *big.Int, minFee float64, salt *big.Int, pubkey types.ValidatorPubkey) (api.CanCreateVacantMinipoolResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node can-create-vacant-minipool %s %f %s %s", amountWei.String(), minFee, salt.String(), pubkey.Hex()))
+	responseBytes, err := c.callApiRoute("node/can-create-vacant-minipool", api.NodeCreateVacantMinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+		MinNodeFee:        minFee,
+		Salt:              salt,
+		Pubkey:            pubkey,
+	}, fmt.Sprintf("node can-create-vacant-minipool %s %f %s %s", amountWei.String(), minFee, salt.String(), pubkey.Hex()))
 	if err != nil {
 		return api.CanCreateVacantMinipoolResponse{}, fmt.Errorf("Could not get can create vacant minipool status: %w", err)
 	}
@@ -965,7 +1189,13 @@ func (c *Client) CanCreateVacantMinipool(amountWei *big.Int, minFee float64, sal
 
 // Create a vacant minipool, which can be used to migrate a solo staker
 func (c *Client) CreateVacantMinipool(amountWei *big.Int, minFee float64, salt *big.Int, pubkey types.ValidatorPubkey) (api.CreateVacantMinipoolResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node create-vacant-minipool %s %f %s %s", amountWei.String(), minFee, salt.String(), pubkey.Hex()))
+	responseBytes, err := c.callApiRoute("node/create-vacant-minipool", api.NodeCreateVacantMinipoolRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		AmountWei:         amountWei,
+		MinNodeFee:        minFee,
+		Salt:              salt,
+		Pubkey:            pubkey,
+	}, fmt.Sprintf("node create-vacant-minipool %s %f %s %s", amountWei.String(), minFee, salt.String(), pubkey.Hex()))
 	if err != nil {
 		return api.CreateVacantMinipoolResponse{}, fmt.Errorf("Could not get create vacant minipool status: %w", err)
 	}
@@ -981,7 +1211,7 @@ func (c *Client) CreateVacantMinipool(amountWei *big.Int, minFee float64, salt *
 
 // Get the node's collateral info, including pending bond reductions
 func (c *Client) CheckCollateral() (api.CheckCollateralResponse, error) {
-	responseBytes, err := c.callAPI("node check-collateral")
+	responseBytes, err := c.callApiRoute("node/check-collateral", nil, "node check-collateral")
 	if err != nil {
 		return api.CheckCollateralResponse{}, fmt.Errorf("Could not get check-collateral status: %w", err)
 	}
@@ -997,7 +1227,7 @@ func (c *Client) CheckCollateral() (api.CheckCollateralResponse, error) {
 
 // Get the ETH balance of the node address
 func (c *Client) GetEthBalance() (api.NodeEthBalanceResponse, error) {
-	responseBytes, err := c.callAPI("node get-eth-balance")
+	responseBytes, err := c.callApiRoute("node/get-eth-balance", nil, "node get-eth-balance")
 	if err != nil {
 		return api.NodeEthBalanceResponse{}, fmt.Errorf("Could not get get-eth-balance status: %w", err)
 	}
@@ -1013,7 +1243,11 @@ func (c *Client) GetEthBalance() (api.NodeEthBalanceResponse, error) {
 
 // Estimates the gas for sending a zero-value message with a payload
 func (c *Client) CanSendMessage(address common.Address, message []byte) (api.CanNodeSendMessageResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node can-send-message %s %s", address.Hex(), hex.EncodeToString(message)))
+	responseBytes, err := c.callApiRoute("node/can-send-message", api.NodeSendMessageRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Address:           address,
+		Message:           message,
+	}, fmt.Sprintf("node can-send-message %s %s", address.Hex(), hex.EncodeToString(message)))
 	if err != nil {
 		return api.CanNodeSendMessageResponse{}, fmt.Errorf("Could not get can-send-message response: %w", err)
 	}
@@ -1029,7 +1263,11 @@ func (c *Client) CanSendMessage(address common.Address, message []byte) (api.Can
 
 // Sends a zero-value message with a payload
 func (c *Client) SendMessage(address common.Address, message []byte) (api.NodeSendMessageResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("node send-message %s %s", address.Hex(), hex.EncodeToString(message)))
+	responseBytes, err := c.callApiRoute("node/send-message", api.NodeSendMessageRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Address:           address,
+		Message:           message,
+	}, fmt.Sprintf("node send-message %s %s", address.Hex(), hex.EncodeToString(message)))
 	if err != nil {
 		return api.NodeSendMessageResponse{}, fmt.Errorf("Could not get send-message response: %w", err)
 	}
@@ -1042,3 +1280,76 @@ func (c *Client) SendMessage(address common.Address, message []byte) (api.NodeSe
 	}
 	return response, nil
 }
+
+// Get the node's transactions that haven't been mined yet
+func (c *Client) GetPendingTransactions() (api.NodeTransactionsResponse, error) {
+	responseBytes, err := c.callApiRoute("node/tx-list", nil, "node tx-list")
+	if err != nil {
+		return api.NodeTransactionsResponse{}, fmt.Errorf("Could not get pending transactions: %w", err)
+	}
+	var response api.NodeTransactionsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeTransactionsResponse{}, fmt.Errorf("Could not decode pending transactions response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeTransactionsResponse{}, fmt.Errorf("Could not get pending transactions: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Check whether a pending transaction can be cancelled, and get the fees that would take
+func (c *Client) CanCancelTransaction(nonce uint64) (api.CanCancelNodeTransactionResponse, error) {
+	responseBytes, err := c.callApiRoute("node/can-cancel-tx", api.NodeCancelTransactionRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Nonce:             nonce,
+	}, fmt.Sprintf("node can-cancel-tx %d", nonce))
+	if err != nil {
+		return api.CanCancelNodeTransactionResponse{}, fmt.Errorf("Could not get can cancel transaction status: %w", err)
+	}
+	var response api.CanCancelNodeTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanCancelNodeTransactionResponse{}, fmt.Errorf("Could not decode can cancel transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanCancelNodeTransactionResponse{}, fmt.Errorf("Could not get can cancel transaction status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Cancel a pending transaction by replacing it with an empty transfer to the node account
+func (c *Client) CancelTransaction(nonce uint64) (api.CancelNodeTransactionResponse, error) {
+	responseBytes, err := c.callApiRoute("node/cancel-tx", api.NodeCancelTransactionRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Nonce:             nonce,
+	}, fmt.Sprintf("node cancel-tx %d", nonce))
+	if err != nil {
+		return api.CancelNodeTransactionResponse{}, fmt.Errorf("Could not cancel transaction: %w", err)
+	}
+	var response api.CancelNodeTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CancelNodeTransactionResponse{}, fmt.Errorf("Could not decode cancel transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CancelNodeTransactionResponse{}, fmt.Errorf("Could not cancel transaction: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Broadcast a transaction that was signed on another machine
+func (c *Client) BroadcastTransaction(signedTx string) (api.BroadcastTransactionResponse, error) {
+	responseBytes, err := c.callApiRoute("node/broadcast-tx", api.NodeBroadcastTransactionRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		SignedTx:          signedTx,
+	}, "node broadcast-tx", signedTx)
+	if err != nil {
+		return api.BroadcastTransactionResponse{}, fmt.Errorf("Could not broadcast transaction: %w", err)
+	}
+	var response api.BroadcastTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BroadcastTransactionResponse{}, fmt.Errorf("Could not decode broadcast transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BroadcastTransactionResponse{}, fmt.Errorf("Could not broadcast transaction: %s", response.Error)
+	}
+	return response, nil
+}
@@ -12,7 +12,7 @@ import (
 
 // Get oracle DAO status
 func (c *Client) TNDAOStatus() (api.TNDAOStatusResponse, error) {
-	responseBytes, err := c.callAPI("odao status")
+	responseBytes, err := c.callApiRoute("odao/status", nil, "odao status")
 	if err != nil {
 		return api.TNDAOStatusResponse{}, fmt.Errorf("Could not get oracle DAO status: %w", err)
 	}
@@ -28,7 +28,7 @@ func (c *Client) TNDAOStatus() (api.TNDAOStatusResponse, error) {
 
 // Get oracle DAO members
 func (c *Client) TNDAOMembers() (api.TNDAOMembersResponse, error) {
-	responseBytes, err := c.callAPI("odao members")
+	responseBytes, err := c.callApiRoute("odao/members", nil, "odao members")
 	if err != nil {
 		return api.TNDAOMembersResponse{}, fmt.Errorf("Could not get oracle DAO members: %w", err)
 	}
@@ -48,9 +48,31 @@ func (c *Client) TNDAOMembers() (api.TNDAOMembersResponse, error) {
 	return response, nil
 }
 
+// Get the illegal fee recipients detected by the watchtower
+func (c *Client) TNDAOPenalties() (api.TNDAOPenaltiesResponse, error) {
+	responseBytes, err := c.callApiRoute("odao/penalties", nil, "odao penalties")
+	if err != nil {
+		return api.TNDAOPenaltiesResponse{}, fmt.Errorf("Could not get oracle DAO penalties: %w", err)
+	}
+	var response api.TNDAOPenaltiesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.TNDAOPenaltiesResponse{}, fmt.Errorf("Could not decode oracle DAO penalties response: %w", err)
+	}
+	if response.Error != "" {
+		return api.TNDAOPenaltiesResponse{}, fmt.Errorf("Could not get oracle DAO penalties: %s", response.Error)
+	}
+	for i := 0; i < len(response.Violations); i++ {
+		violation := &response.Violations[i]
+		if violation.MevAmount == nil {
+			violation.MevAmount = big.NewInt(0)
+		}
+	}
+	return response, nil
+}
+
 // Get oracle DAO proposals
 func (c *Client) TNDAOProposals() (api.TNDAOProposalsResponse, error) {
-	responseBytes, err := c.callAPI("odao proposals")
+	responseBytes, err := c.callApiRoute("odao/proposals", nil, "odao proposals")
 	if err != nil {
 		return api.TNDAOProposalsResponse{}, fmt.Errorf("Could not get oracle DAO proposals: %w", err)
 	}
@@ -66,7 +88,10 @@ func (c *Client) TNDAOProposals() (api.TNDAOProposalsResponse, error) {
 
 // Get a single oracle DAO proposal
 func (c *Client) TNDAOProposal(id uint64) (api.TNDAOProposalResponse, error) {
-	responseBytes, err := c.callAPI("odao proposal-details", string(id))
+	responseBytes, err := c.callApiRoute("odao/proposal-details", api.TNDAOProposalDetailsRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Id:                id,
+	}, fmt.Sprintf("odao proposal-details %d", id))
 	if err != nil {
 		return api.TNDAOProposalResponse{}, fmt.Errorf("Could not get oracle DAO proposal: %w", err)
 	}
@@ -82,7 +107,12 @@ func (c *Client) TNDAOProposal(id uint64) (api.TNDAOProposalResponse, error) {
 
 // Check whether the node can propose inviting a new member
 func (c *Client) CanProposeInviteToTNDAO(memberAddress common.Address, memberId, memberUrl string) (api.CanProposeTNDAOInviteResponse, error) {
-	responseBytes, err := c.callAPI("odao can-propose-invite", memberAddress.Hex(), memberId, memberUrl)
+	responseBytes, err := c.callApiRoute("odao/can-propose-invite", api.TNDAOProposeInviteRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MemberAddress:     memberAddress,
+		MemberId:          memberId,
+		MemberUrl:         memberUrl,
+	}, "odao can-propose-invite", memberAddress.Hex(), memberId, memberUrl)
 	if err != nil {
 		return api.CanProposeTNDAOInviteResponse{}, fmt.Errorf("Could not get can propose oracle DAO invite status: %w", err)
 	}
@@ -98,7 +128,12 @@ func (c *Client) CanProposeInviteToTNDAO(memberAddress common.Address, memberId,
 
 // Propose inviting a new member
 func (c *Client) ProposeInviteToTNDAO(memberAddress common.Address, memberId, memberUrl string) (api.ProposeTNDAOInviteResponse, error) {
-	responseBytes, err := c.callAPI("odao propose-invite", memberAddress.Hex(), memberId, memberUrl)
+	responseBytes, err := c.callApiRoute("odao/propose-invite", api.TNDAOProposeInviteRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MemberAddress:     memberAddress,
+		MemberId:          memberId,
+		MemberUrl:         memberUrl,
+	}, "odao propose-invite", memberAddress.Hex(), memberId, memberUrl)
 	if err != nil {
 		return api.ProposeTNDAOInviteResponse{}, fmt.Errorf("Could not propose oracle DAO invite: %w", err)
 	}
@@ -114,7 +149,7 @@ func (c *Client) ProposeInviteToTNDAO(memberAddress common.Address, memberId, me
 
 // Check whether the node can propose leaving the oracle DAO
 func (c *Client) CanProposeLeaveTNDAO() (api.CanProposeTNDAOLeaveResponse, error) {
-	responseBytes, err := c.callAPI("odao can-propose-leave")
+	responseBytes, err := c.callApiRoute("odao/can-propose-leave", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "odao can-propose-leave")
 	if err != nil {
 		return api.CanProposeTNDAOLeaveResponse{}, fmt.Errorf("Could not get can propose leaving oracle DAO status: %w", err)
 	}
@@ -130,7 +165,7 @@ func (c *Client) CanProposeLeaveTNDAO() (api.CanProposeTNDAOLeaveResponse, error
 
 // Propose leaving the oracle DAO
 func (c *Client) ProposeLeaveTNDAO() (api.ProposeTNDAOLeaveResponse, error) {
-	responseBytes, err := c.callAPI("odao propose-leave")
+	responseBytes, err := c.callApiRoute("odao/propose-leave", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "odao propose-leave")
 	if err != nil {
 		return api.ProposeTNDAOLeaveResponse{}, fmt.Errorf("Could not propose leaving oracle DAO: %w", err)
 	}
@@ -178,7 +213,11 @@ func (c *Client) ProposeReplaceTNDAOMember(memberAddress common.Address, memberI
 
 // Check whether the node can propose kicking a member
 func (c *Client) CanProposeKickFromTNDAO(memberAddress common.Address, fineAmountWei *big.Int) (api.CanProposeTNDAOKickResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-propose-kick %s %s", memberAddress.Hex(), fineAmountWei.String()))
+	responseBytes, err := c.callApiRoute("odao/can-propose-kick", api.TNDAOProposeKickRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MemberAddress:     memberAddress,
+		FineAmountWei:     fineAmountWei,
+	}, fmt.Sprintf("odao can-propose-kick %s %s", memberAddress.Hex(), fineAmountWei.String()))
 	if err != nil {
 		return api.CanProposeTNDAOKickResponse{}, fmt.Errorf("Could not get can propose kicking oracle DAO member status: %w", err)
 	}
@@ -194,7 +233,11 @@ func (c *Client) CanProposeKickFromTNDAO(memberAddress common.Address, fineAmoun
 
 // Propose kicking a member
 func (c *Client) ProposeKickFromTNDAO(memberAddress common.Address, fineAmountWei *big.Int) (api.ProposeTNDAOKickResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao propose-kick %s %s", memberAddress.Hex(), fineAmountWei.String()))
+	responseBytes, err := c.callApiRoute("odao/propose-kick", api.TNDAOProposeKickRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		MemberAddress:     memberAddress,
+		FineAmountWei:     fineAmountWei,
+	}, fmt.Sprintf("odao propose-kick %s %s", memberAddress.Hex(), fineAmountWei.String()))
 	if err != nil {
 		return api.ProposeTNDAOKickResponse{}, fmt.Errorf("Could not propose kicking oracle DAO member: %w", err)
 	}
@@ -210,7 +253,10 @@ func (c *Client) ProposeKickFromTNDAO(memberAddress common.Address, fineAmountWe
 
 // Check whether the node can cancel a proposal
 func (c *Client) CanCancelTNDAOProposal(proposalId uint64) (api.CanCancelTNDAOProposalResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-cancel-proposal %d", proposalId))
+	responseBytes, err := c.callApiRoute("odao/can-cancel-proposal", api.TNDAOProposalRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		ProposalId:        proposalId,
+	}, fmt.Sprintf("odao can-cancel-proposal %d", proposalId))
 	if err != nil {
 		return api.CanCancelTNDAOProposalResponse{}, fmt.Errorf("Could not get can cancel oracle DAO proposal status: %w", err)
 	}
@@ -226,7 +272,10 @@ func (c *Client) CanCancelTNDAOProposal(proposalId uint64) (api.CanCancelTNDAOPr
 
 // Cancel a proposal made by the node
 func (c *Client) CancelTNDAOProposal(proposalId uint64) (api.CancelTNDAOProposalResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao cancel-proposal %d", proposalId))
+	responseBytes, err := c.callApiRoute("odao/cancel-proposal", api.TNDAOProposalRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		ProposalId:        proposalId,
+	}, fmt.Sprintf("odao cancel-proposal %d", proposalId))
 	if err != nil {
 		return api.CancelTNDAOProposalResponse{}, fmt.Errorf("Could not cancel oracle DAO proposal: %w", err)
 	}
@@ -242,7 +291,10 @@ func (c *Client) CancelTNDAOProposal(proposalId uint64) (api.CancelTNDAOProposal
 
 // Check whether the node can vote on a proposal
 func (c *Client) CanVoteOnTNDAOProposal(proposalId uint64) (api.CanVoteOnTNDAOProposalResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-vote-proposal %d", proposalId))
+	responseBytes, err := c.callApiRoute("odao/can-vote-proposal", api.TNDAOProposalRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		ProposalId:        proposalId,
+	}, fmt.Sprintf("odao can-vote-proposal %d", proposalId))
 	if err != nil {
 		return api.CanVoteOnTNDAOProposalResponse{}, fmt.Errorf("Could not get can vote on oracle DAO proposal status: %w", err)
 	}
@@ -258,7 +310,11 @@ func (c *Client) CanVoteOnTNDAOProposal(proposalId uint64) (api.CanVoteOnTNDAOPr
 
 // Vote on a proposal
 func (c *Client) VoteOnTNDAOProposal(proposalId uint64, support bool) (api.VoteOnTNDAOProposalResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao vote-proposal %d %t", proposalId, support))
+	responseBytes, err := c.callApiRoute("odao/vote-proposal", api.TNDAOVoteProposalRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		ProposalId:        proposalId,
+		Support:           support,
+	}, fmt.Sprintf("odao vote-proposal %d %t", proposalId, support))
 	if err != nil {
 		return api.VoteOnTNDAOProposalResponse{}, fmt.Errorf("Could not vote on oracle DAO proposal: %w", err)
 	}
@@ -274,7 +330,10 @@ func (c *Client) VoteOnTNDAOProposal(proposalId uint64, support bool) (api.VoteO
 
 // Check whether the node can execute a proposal
 func (c *Client) CanExecuteTNDAOProposal(proposalId uint64) (api.CanExecuteTNDAOProposalResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-execute-proposal %d", proposalId))
+	responseBytes, err := c.callApiRoute("odao/can-execute-proposal", api.TNDAOProposalRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		ProposalId:        proposalId,
+	}, fmt.Sprintf("odao can-execute-proposal %d", proposalId))
 	if err != nil {
 		return api.CanExecuteTNDAOProposalResponse{}, fmt.Errorf("Could not get can execute oracle DAO proposal status: %w", err)
 	}
@@ -290,7 +349,10 @@ func (c *Client) CanExecuteTNDAOProposal(proposalId uint64) (api.CanExecuteTNDAO
 
 // Execute a proposal
 func (c *Client) ExecuteTNDAOProposal(proposalId uint64) (api.ExecuteTNDAOProposalResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao execute-proposal %d", proposalId))
+	responseBytes, err := c.callApiRoute("odao/execute-proposal", api.TNDAOProposalRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		ProposalId:        proposalId,
+	}, fmt.Sprintf("odao execute-proposal %d", proposalId))
 	if err != nil {
 		return api.ExecuteTNDAOProposalResponse{}, fmt.Errorf("Could not execute oracle DAO proposal: %w", err)
 	}
@@ -306,7 +368,7 @@ func (c *Client) ExecuteTNDAOProposal(proposalId uint64) (api.ExecuteTNDAOPropos
 
 // Check whether the node can join the oracle DAO
 func (c *Client) CanJoinTNDAO() (api.CanJoinTNDAOResponse, error) {
-	responseBytes, err := c.callAPI("odao can-join")
+	responseBytes, err := c.callApiRoute("odao/can-join", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "odao can-join")
 	if err != nil {
 		return api.CanJoinTNDAOResponse{}, fmt.Errorf("Could not get can join oracle DAO status: %w", err)
 	}
@@ -322,7 +384,7 @@ func (c *Client) CanJoinTNDAO() (api.CanJoinTNDAOResponse, error) {
 
 // Join the oracle DAO (requires an executed invite proposal)
 func (c *Client) ApproveRPLToJoinTNDAO() (api.JoinTNDAOApproveResponse, error) {
-	responseBytes, err := c.callAPI("odao join-approve-rpl")
+	responseBytes, err := c.callApiRoute("odao/join-approve-rpl", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "odao join-approve-rpl")
 	if err != nil {
 		return api.JoinTNDAOApproveResponse{}, fmt.Errorf("Could not approve RPL for joining oracle DAO: %w", err)
 	}
@@ -338,7 +400,10 @@ func (c *Client) ApproveRPLToJoinTNDAO() (api.JoinTNDAOApproveResponse, error) {
 
 // Join the oracle DAO (requires an executed invite proposal)
 func (c *Client) JoinTNDAO(approvalTxHash common.Hash) (api.JoinTNDAOJoinResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao join %s", approvalTxHash.String()))
+	responseBytes, err := c.callApiRoute("odao/join", api.TNDAOJoinRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Hash:              approvalTxHash,
+	}, fmt.Sprintf("odao join %s", approvalTxHash.String()))
 	if err != nil {
 		return api.JoinTNDAOJoinResponse{}, fmt.Errorf("Could not join oracle DAO: %w", err)
 	}
@@ -354,7 +419,7 @@ func (c *Client) JoinTNDAO(approvalTxHash common.Hash) (api.JoinTNDAOJoinRespons
 
 // Check whether the node can leave the oracle DAO
 func (c *Client) CanLeaveTNDAO() (api.CanLeaveTNDAOResponse, error) {
-	responseBytes, err := c.callAPI("odao can-leave")
+	responseBytes, err := c.callApiRoute("odao/can-leave", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "odao can-leave")
 	if err != nil {
 		return api.CanLeaveTNDAOResponse{}, fmt.Errorf("Could not get can leave oracle DAO status: %w", err)
 	}
@@ -370,7 +435,10 @@ func (c *Client) CanLeaveTNDAO() (api.CanLeaveTNDAOResponse, error) {
 
 // Leave the oracle DAO (requires an executed leave proposal)
 func (c *Client) LeaveTNDAO(bondRefundAddress common.Address) (api.LeaveTNDAOResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao leave %s", bondRefundAddress.Hex()))
+	responseBytes, err := c.callApiRoute("odao/leave", api.TNDAOLeaveRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		BondRefundAddress: bondRefundAddress,
+	}, fmt.Sprintf("odao leave %s", bondRefundAddress.Hex()))
 	if err != nil {
 		return api.LeaveTNDAOResponse{}, fmt.Errorf("Could not leave oracle DAO: %w", err)
 	}
@@ -432,7 +500,10 @@ func (c *Client) CanProposeTNDAOSetting() (api.CanProposeTNDAOSettingResponse, e
 	return response, nil
 }
 func (c *Client) CanProposeTNDAOSettingMembersQuorum(quorum float64) (api.CanProposeTNDAOSettingResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-propose-members-quorum %f", quorum))
+	responseBytes, err := c.callApiRoute("odao/can-propose-members-quorum", api.TNDAOProposeMembersQuorumRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Quorum:            quorum,
+	}, fmt.Sprintf("odao can-propose-members-quorum %f", quorum))
 	if err != nil {
 		return api.CanProposeTNDAOSettingResponse{}, fmt.Errorf("Could not get can propose setting members.quorum: %w", err)
 	}
@@ -446,7 +517,10 @@ func (c *Client) CanProposeTNDAOSettingMembersQuorum(quorum float64) (api.CanPro
 	return response, nil
 }
 func (c *Client) CanProposeTNDAOSettingMembersRplBond(bondAmountWei *big.Int) (api.CanProposeTNDAOSettingResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-propose-members-rplbond %s", bondAmountWei.String()))
+	responseBytes, err := c.callApiRoute("odao/can-propose-members-rplbond", api.TNDAOProposeMembersRplBondRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		BondAmountWei:     bondAmountWei,
+	}, fmt.Sprintf("odao can-propose-members-rplbond %s", bondAmountWei.String()))
 	if err != nil {
 		return api.CanProposeTNDAOSettingResponse{}, fmt.Errorf("Could not get can propose setting members.rplbond: %w", err)
 	}
@@ -460,7 +534,10 @@ func (c *Client) CanProposeTNDAOSettingMembersRplBond(bondAmountWei *big.Int) (a
 	return response, nil
 }
 func (c *Client) CanProposeTNDAOSettingMinipoolUnbondedMax(unbondedMinipoolMax uint64) (api.CanProposeTNDAOSettingResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-propose-members-minipool-unbonded-max %d", unbondedMinipoolMax))
+	responseBytes, err := c.callApiRoute("odao/can-propose-members-minipool-unbonded-max", api.TNDAOProposeMinipoolUnbondedMaxRequest{
+		ApiServerSettings:   c.getApiServerSettings(),
+		UnbondedMinipoolMax: unbondedMinipoolMax,
+	}, fmt.Sprintf("odao can-propose-members-minipool-unbonded-max %d", unbondedMinipoolMax))
 	if err != nil {
 		return api.CanProposeTNDAOSettingResponse{}, fmt.Errorf("Could not get can propose setting members.minipool.unbonded.max: %w", err)
 	}
@@ -474,7 +551,10 @@ func (c *Client) CanProposeTNDAOSettingMinipoolUnbondedMax(unbondedMinipoolMax u
 	return response, nil
 }
 func (c *Client) CanProposeTNDAOSettingProposalCooldown(proposalCooldownTimespan uint64) (api.CanProposeTNDAOSettingResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-propose-proposal-cooldown %d", proposalCooldownTimespan))
+	responseBytes, err := c.callApiRoute("odao/can-propose-proposal-cooldown", api.TNDAOProposeProposalCooldownRequest{
+		ApiServerSettings:      c.getApiServerSettings(),
+		ProposalCooldownBlocks: proposalCooldownTimespan,
+	}, fmt.Sprintf("odao can-propose-proposal-cooldown %d", proposalCooldownTimespan))
 	if err != nil {
 		return api.CanProposeTNDAOSettingResponse{}, fmt.Errorf("Could not get can propose setting proposal.cooldown.time: %w", err)
 	}
@@ -488,7 +568,10 @@ func (c *Client) CanProposeTNDAOSettingProposalCooldown(proposalCooldownTimespan
 	return response, nil
 }
 func (c *Client) CanProposeTNDAOSettingProposalVoteTimespan(proposalVoteTimespan uint64) (api.CanProposeTNDAOSettingResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-propose-proposal-vote-timespan %d", proposalVoteTimespan))
+	responseBytes, err := c.callApiRoute("odao/can-propose-proposal-vote-timespan", api.TNDAOProposeProposalVoteTimespanRequest{
+		ApiServerSettings:    c.getApiServerSettings(),
+		ProposalVoteTimespan: proposalVoteTimespan,
+	}, fmt.Sprintf("odao can-propose-proposal-vote-timespan %d", proposalVoteTimespan))
 	if err != nil {
 		return api.CanProposeTNDAOSettingResponse{}, fmt.Errorf("Could not get can propose setting proposal.vote.time: %w", err)
 	}
@@ -502,7 +585,10 @@ func (c *Client) CanProposeTNDAOSettingProposalVoteTimespan(proposalVoteTimespan
 	return response, nil
 }
 func (c *Client) CanProposeTNDAOSettingProposalVoteDelayTimespan(proposalDelayTimespan uint64) (api.CanProposeTNDAOSettingResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-propose-proposal-vote-delay-timespan %d", proposalDelayTimespan))
+	responseBytes, err := c.callApiRoute("odao/can-propose-proposal-vote-delay-timespan", api.TNDAOProposeProposalVoteDelayTimespanRequest{
+		ApiServerSettings:     c.getApiServerSettings(),
+		ProposalDelayTimespan: proposalDelayTimespan,
+	}, fmt.Sprintf("odao can-propose-proposal-vote-delay-timespan %d", proposalDelayTimespan))
 	if err != nil {
 		return api.CanProposeTNDAOSettingResponse{}, fmt.Errorf("Could not get can propose setting proposal.vote.delay.time: %w", err)
 	}
@@ -516,7 +602,10 @@ func (c *Client) CanProposeTNDAOSettingProposalVoteDelayTimespan(proposalDelayTi
 	return response, nil
 }
 func (c *Client) CanProposeTNDAOSettingProposalExecuteTimespan(proposalExecuteTimespan uint64) (api.CanProposeTNDAOSettingResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-propose-proposal-execute-timespan %d", proposalExecuteTimespan))
+	responseBytes, err := c.callApiRoute("odao/can-propose-proposal-execute-timespan", api.TNDAOProposeProposalExecuteTimespanRequest{
+		ApiServerSettings:       c.getApiServerSettings(),
+		ProposalExecuteTimespan: proposalExecuteTimespan,
+	}, fmt.Sprintf("odao can-propose-proposal-execute-timespan %d", proposalExecuteTimespan))
 	if err != nil {
 		return api.CanProposeTNDAOSettingResponse{}, fmt.Errorf("Could not get can propose setting proposal.execute.time: %w", err)
 	}
@@ -530,7 +619,10 @@ func (c *Client) CanProposeTNDAOSettingProposalExecuteTimespan(proposalExecuteTi
 	return response, nil
 }
 func (c *Client) CanProposeTNDAOSettingProposalActionTimespan(proposalActionTimespan uint64) (api.CanProposeTNDAOSettingResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-propose-proposal-action-timespan %d", proposalActionTimespan))
+	responseBytes, err := c.callApiRoute("odao/can-propose-proposal-action-timespan", api.TNDAOProposeProposalActionTimespanRequest{
+		ApiServerSettings:      c.getApiServerSettings(),
+		ProposalActionTimespan: proposalActionTimespan,
+	}, fmt.Sprintf("odao can-propose-proposal-action-timespan %d", proposalActionTimespan))
 	if err != nil {
 		return api.CanProposeTNDAOSettingResponse{}, fmt.Errorf("Could not get can propose setting proposal.action.time: %w", err)
 	}
@@ -544,7 +636,10 @@ func (c *Client) CanProposeTNDAOSettingProposalActionTimespan(proposalActionTime
 	return response, nil
 }
 func (c *Client) CanProposeTNDAOSettingScrubPeriod(scrubPeriod uint64) (api.CanProposeTNDAOSettingResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-propose-scrub-period %d", scrubPeriod))
+	responseBytes, err := c.callApiRoute("odao/can-propose-scrub-period", api.TNDAOProposeScrubPeriodRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		ScrubPeriod:       scrubPeriod,
+	}, fmt.Sprintf("odao can-propose-scrub-period %d", scrubPeriod))
 	if err != nil {
 		return api.CanProposeTNDAOSettingResponse{}, fmt.Errorf("Could not get can propose setting minipool.scrub.period: %w", err)
 	}
@@ -558,7 +653,10 @@ func (c *Client) CanProposeTNDAOSettingScrubPeriod(scrubPeriod uint64) (api.CanP
 	return response, nil
 }
 func (c *Client) CanProposeTNDAOSettingPromotionScrubPeriod(scrubPeriod uint64) (api.CanProposeTNDAOSettingResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-propose-promotion-scrub-period %d", scrubPeriod))
+	responseBytes, err := c.callApiRoute("odao/can-propose-promotion-scrub-period", api.TNDAOProposeScrubPeriodRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		ScrubPeriod:       scrubPeriod,
+	}, fmt.Sprintf("odao can-propose-promotion-scrub-period %d", scrubPeriod))
 	if err != nil {
 		return api.CanProposeTNDAOSettingResponse{}, fmt.Errorf("Could not get can propose setting minipool.promotion.scrub.period: %w", err)
 	}
@@ -572,7 +670,10 @@ func (c *Client) CanProposeTNDAOSettingPromotionScrubPeriod(scrubPeriod uint64)
 	return response, nil
 }
 func (c *Client) CanProposeTNDAOSettingScrubPenaltyEnabled(enabled bool) (api.CanProposeTNDAOSettingResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-propose-scrub-penalty-enabled %t", enabled))
+	responseBytes, err := c.callApiRoute("odao/can-propose-scrub-penalty-enabled", api.TNDAOProposeScrubPenaltyEnabledRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Enabled:           enabled,
+	}, fmt.Sprintf("odao can-propose-scrub-penalty-enabled %t", enabled))
 	if err != nil {
 		return api.CanProposeTNDAOSettingResponse{}, fmt.Errorf("Could not get can propose setting minipool.scrub.penalty.enabled: %w", err)
 	}
@@ -586,7 +687,10 @@ func (c *Client) CanProposeTNDAOSettingScrubPenaltyEnabled(enabled bool) (api.Ca
 	return response, nil
 }
 func (c *Client) CanProposeTNDAOSettingBondReductionWindowStart(windowStart uint64) (api.CanProposeTNDAOSettingResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-propose-bond-reduction-window-start %d", windowStart))
+	responseBytes, err := c.callApiRoute("odao/can-propose-bond-reduction-window-start", api.TNDAOProposeBondReductionWindowStartRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		WindowStart:       windowStart,
+	}, fmt.Sprintf("odao can-propose-bond-reduction-window-start %d", windowStart))
 	if err != nil {
 		return api.CanProposeTNDAOSettingResponse{}, fmt.Errorf("Could not get can propose setting minipool.bond.reduction.window.start: %w", err)
 	}
@@ -600,7 +704,10 @@ func (c *Client) CanProposeTNDAOSettingBondReductionWindowStart(windowStart uint
 	return response, nil
 }
 func (c *Client) CanProposeTNDAOSettingBondReductionWindowLength(windowLength uint64) (api.CanProposeTNDAOSettingResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-propose-bond-reduction-window-length %d", windowLength))
+	responseBytes, err := c.callApiRoute("odao/can-propose-bond-reduction-window-length", api.TNDAOProposeBondReductionWindowLengthRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		WindowLength:      windowLength,
+	}, fmt.Sprintf("odao can-propose-bond-reduction-window-length %d", windowLength))
 	if err != nil {
 		return api.CanProposeTNDAOSettingResponse{}, fmt.Errorf("Could not get can propose setting minipool.bond.reduction.window.length: %w", err)
 	}
@@ -616,7 +723,10 @@ func (c *Client) CanProposeTNDAOSettingBondReductionWindowLength(windowLength ui
 
 // Propose a setting update
 func (c *Client) ProposeTNDAOSettingMembersQuorum(quorum float64) (api.ProposeTNDAOSettingMembersQuorumResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao propose-members-quorum %f", quorum))
+	responseBytes, err := c.callApiRoute("odao/propose-members-quorum", api.TNDAOProposeMembersQuorumRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Quorum:            quorum,
+	}, fmt.Sprintf("odao propose-members-quorum %f", quorum))
 	if err != nil {
 		return api.ProposeTNDAOSettingMembersQuorumResponse{}, fmt.Errorf("Could not propose oracle DAO setting members.quorum: %w", err)
 	}
@@ -630,7 +740,10 @@ func (c *Client) ProposeTNDAOSettingMembersQuorum(quorum float64) (api.ProposeTN
 	return response, nil
 }
 func (c *Client) ProposeTNDAOSettingMembersRplBond(bondAmountWei *big.Int) (api.ProposeTNDAOSettingMembersRplBondResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao propose-members-rplbond %s", bondAmountWei.String()))
+	responseBytes, err := c.callApiRoute("odao/propose-members-rplbond", api.TNDAOProposeMembersRplBondRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		BondAmountWei:     bondAmountWei,
+	}, fmt.Sprintf("odao propose-members-rplbond %s", bondAmountWei.String()))
 	if err != nil {
 		return api.ProposeTNDAOSettingMembersRplBondResponse{}, fmt.Errorf("Could not propose oracle DAO setting members.rplbond: %w", err)
 	}
@@ -644,7 +757,10 @@ func (c *Client) ProposeTNDAOSettingMembersRplBond(bondAmountWei *big.Int) (api.
 	return response, nil
 }
 func (c *Client) ProposeTNDAOSettingMinipoolUnbondedMax(unbondedMinipoolMax uint64) (api.ProposeTNDAOSettingMinipoolUnbondedMaxResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao propose-members-minipool-unbonded-max %d", unbondedMinipoolMax))
+	responseBytes, err := c.callApiRoute("odao/propose-members-minipool-unbonded-max", api.TNDAOProposeMinipoolUnbondedMaxRequest{
+		ApiServerSettings:   c.getApiServerSettings(),
+		UnbondedMinipoolMax: unbondedMinipoolMax,
+	}, fmt.Sprintf("odao propose-members-minipool-unbonded-max %d", unbondedMinipoolMax))
 	if err != nil {
 		return api.ProposeTNDAOSettingMinipoolUnbondedMaxResponse{}, fmt.Errorf("Could not propose oracle DAO setting members.minipool.unbonded.max: %w", err)
 	}
@@ -658,7 +774,10 @@ func (c *Client) ProposeTNDAOSettingMinipoolUnbondedMax(unbondedMinipoolMax uint
 	return response, nil
 }
 func (c *Client) ProposeTNDAOSettingProposalCooldown(proposalCooldownTimespan uint64) (api.ProposeTNDAOSettingProposalCooldownResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao propose-proposal-cooldown %d", proposalCooldownTimespan))
+	responseBytes, err := c.callApiRoute("odao/propose-proposal-cooldown", api.TNDAOProposeProposalCooldownRequest{
+		ApiServerSettings:      c.getApiServerSettings(),
+		ProposalCooldownBlocks: proposalCooldownTimespan,
+	}, fmt.Sprintf("odao propose-proposal-cooldown %d", proposalCooldownTimespan))
 	if err != nil {
 		return api.ProposeTNDAOSettingProposalCooldownResponse{}, fmt.Errorf("Could not propose oracle DAO setting proposal.cooldown.time: %w", err)
 	}
@@ -672,7 +791,10 @@ func (c *Client) ProposeTNDAOSettingProposalCooldown(proposalCooldownTimespan ui
 	return response, nil
 }
 func (c *Client) ProposeTNDAOSettingProposalVoteTimespan(proposalVoteTimespan uint64) (api.ProposeTNDAOSettingProposalVoteTimespanResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao propose-proposal-vote-timespan %d", proposalVoteTimespan))
+	responseBytes, err := c.callApiRoute("odao/propose-proposal-vote-timespan", api.TNDAOProposeProposalVoteTimespanRequest{
+		ApiServerSettings:    c.getApiServerSettings(),
+		ProposalVoteTimespan: proposalVoteTimespan,
+	}, fmt.Sprintf("odao propose-proposal-vote-timespan %d", proposalVoteTimespan))
 	if err != nil {
 		return api.ProposeTNDAOSettingProposalVoteTimespanResponse{}, fmt.Errorf("Could not propose oracle DAO setting proposal.vote.time: %w", err)
 	}
@@ -686,7 +808,10 @@ func (c *Client) ProposeTNDAOSettingProposalVoteTimespan(proposalVoteTimespan ui
 	return response, nil
 }
 func (c *Client) ProposeTNDAOSettingProposalVoteDelayTimespan(proposalDelayTimespan uint64) (api.ProposeTNDAOSettingProposalVoteDelayTimespanResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao propose-proposal-vote-delay-timespan %d", proposalDelayTimespan))
+	responseBytes, err := c.callApiRoute("odao/propose-proposal-vote-delay-timespan", api.TNDAOProposeProposalVoteDelayTimespanRequest{
+		ApiServerSettings:     c.getApiServerSettings(),
+		ProposalDelayTimespan: proposalDelayTimespan,
+	}, fmt.Sprintf("odao propose-proposal-vote-delay-timespan %d", proposalDelayTimespan))
 	if err != nil {
 		return api.ProposeTNDAOSettingProposalVoteDelayTimespanResponse{}, fmt.Errorf("Could not propose oracle DAO setting proposal.vote.delay.time: %w", err)
 	}
@@ -700,7 +825,10 @@ func (c *Client) ProposeTNDAOSettingProposalVoteDelayTimespan(proposalDelayTimes
 	return response, nil
 }
 func (c *Client) ProposeTNDAOSettingProposalExecuteTimespan(proposalExecuteTimespan uint64) (api.ProposeTNDAOSettingProposalExecuteTimespanResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao propose-proposal-execute-timespan %d", proposalExecuteTimespan))
+	responseBytes, err := c.callApiRoute("odao/propose-proposal-execute-timespan", api.TNDAOProposeProposalExecuteTimespanRequest{
+		ApiServerSettings:       c.getApiServerSettings(),
+		ProposalExecuteTimespan: proposalExecuteTimespan,
+	}, fmt.Sprintf("odao propose-proposal-execute-timespan %d", proposalExecuteTimespan))
 	if err != nil {
 		return api.ProposeTNDAOSettingProposalExecuteTimespanResponse{}, fmt.Errorf("Could not propose oracle DAO setting proposal.execute.time: %w", err)
 	}
@@ -714,7 +842,10 @@ func (c *Client) ProposeTNDAOSettingProposalExecuteTimespan(proposalExecuteTimes
 	return response, nil
 }
 func (c *Client) ProposeTNDAOSettingProposalActionTimespan(proposalActionTimespan uint64) (api.ProposeTNDAOSettingProposalActionTimespanResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao propose-proposal-action-timespan %d", proposalActionTimespan))
+	responseBytes, err := c.callApiRoute("odao/propose-proposal-action-timespan", api.TNDAOProposeProposalActionTimespanRequest{
+		ApiServerSettings:      c.getApiServerSettings(),
+		ProposalActionTimespan: proposalActionTimespan,
+	}, fmt.Sprintf("odao propose-proposal-action-timespan %d", proposalActionTimespan))
 	if err != nil {
 		return api.ProposeTNDAOSettingProposalActionTimespanResponse{}, fmt.Errorf("Could not propose oracle DAO setting proposal.action.time: %w", err)
 	}
@@ -728,7 +859,10 @@ func (c *Client) ProposeTNDAOSettingProposalActionTimespan(proposalActionTimespa
 	return response, nil
 }
 func (c *Client) ProposeTNDAOSettingScrubPeriod(scrubPeriod uint64) (api.ProposeTNDAOSettingScrubPeriodResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao propose-scrub-period %d", scrubPeriod))
+	responseBytes, err := c.callApiRoute("odao/propose-scrub-period", api.TNDAOProposeScrubPeriodRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		ScrubPeriod:       scrubPeriod,
+	}, fmt.Sprintf("odao propose-scrub-period %d", scrubPeriod))
 	if err != nil {
 		return api.ProposeTNDAOSettingScrubPeriodResponse{}, fmt.Errorf("Could not propose oracle DAO setting minipool.scrub.period: %w", err)
 	}
@@ -742,7 +876,10 @@ func (c *Client) ProposeTNDAOSettingScrubPeriod(scrubPeriod uint64) (api.Propose
 	return response, nil
 }
 func (c *Client) ProposeTNDAOSettingPromotionScrubPeriod(scrubPeriod uint64) (api.ProposeTNDAOSettingPromotionScrubPeriodResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao propose-promotion-scrub-period %d", scrubPeriod))
+	responseBytes, err := c.callApiRoute("odao/propose-promotion-scrub-period", api.TNDAOProposeScrubPeriodRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		ScrubPeriod:       scrubPeriod,
+	}, fmt.Sprintf("odao propose-promotion-scrub-period %d", scrubPeriod))
 	if err != nil {
 		return api.ProposeTNDAOSettingPromotionScrubPeriodResponse{}, fmt.Errorf("Could not propose oracle DAO setting minipool.promotion.scrub.period: %w", err)
 	}
@@ -756,7 +893,10 @@ func (c *Client) ProposeTNDAOSettingPromotionScrubPeriod(scrubPeriod uint64) (ap
 	return response, nil
 }
 func (c *Client) ProposeTNDAOSettingScrubPenaltyEnabled(enabled bool) (api.ProposeTNDAOSettingScrubPenaltyEnabledResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao propose-scrub-penalty-enabled %t", enabled))
+	responseBytes, err := c.callApiRoute("odao/propose-scrub-penalty-enabled", api.TNDAOProposeScrubPenaltyEnabledRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Enabled:           enabled,
+	}, fmt.Sprintf("odao propose-scrub-penalty-enabled %t", enabled))
 	if err != nil {
 		return api.ProposeTNDAOSettingScrubPenaltyEnabledResponse{}, fmt.Errorf("Could not propose oracle DAO setting minipool.scrub.penalty.enabled: %w", err)
 	}
@@ -770,7 +910,10 @@ func (c *Client) ProposeTNDAOSettingScrubPenaltyEnabled(enabled bool) (api.Propo
 	return response, nil
 }
 func (c *Client) ProposeTNDAOSettingBondReductionWindowStart(windowStart uint64) (api.ProposeTNDAOSettingBondReductionWindowStartResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao propose-bond-reduction-window-start %d", windowStart))
+	responseBytes, err := c.callApiRoute("odao/propose-bond-reduction-window-start", api.TNDAOProposeBondReductionWindowStartRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		WindowStart:       windowStart,
+	}, fmt.Sprintf("odao propose-bond-reduction-window-start %d", windowStart))
 	if err != nil {
 		return api.ProposeTNDAOSettingBondReductionWindowStartResponse{}, fmt.Errorf("Could not propose oracle DAO setting minipool.bond.reduction.window.start: %w", err)
 	}
@@ -784,7 +927,10 @@ func (c *Client) ProposeTNDAOSettingBondReductionWindowStart(windowStart uint64)
 	return response, nil
 }
 func (c *Client) ProposeTNDAOSettingBondReductionWindowLength(windowLength uint64) (api.ProposeTNDAOSettingBondReductionWindowLengthResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao propose-bond-reduction-window-length %d", windowLength))
+	responseBytes, err := c.callApiRoute("odao/propose-bond-reduction-window-length", api.TNDAOProposeBondReductionWindowLengthRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		WindowLength:      windowLength,
+	}, fmt.Sprintf("odao propose-bond-reduction-window-length %d", windowLength))
 	if err != nil {
 		return api.ProposeTNDAOSettingBondReductionWindowLengthResponse{}, fmt.Errorf("Could not propose oracle DAO setting minipool.bond.reduction.window.length: %w", err)
 	}
@@ -800,7 +946,7 @@ func (c *Client) ProposeTNDAOSettingBondReductionWindowLength(windowLength uint6
 
 // Get the member settings
 func (c *Client) GetTNDAOMemberSettings() (api.GetTNDAOMemberSettingsResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao get-member-settings"))
+	responseBytes, err := c.callApiRoute("odao/get-member-settings", nil, fmt.Sprintf("odao get-member-settings"))
 	if err != nil {
 		return api.GetTNDAOMemberSettingsResponse{}, fmt.Errorf("Could not get oracle DAO member settings: %w", err)
 	}
@@ -822,7 +968,7 @@ func (c *Client) GetTNDAOMemberSettings() (api.GetTNDAOMemberSettingsResponse, e
 
 // Get the proposal settings
 func (c *Client) GetTNDAOProposalSettings() (api.GetTNDAOProposalSettingsResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao get-proposal-settings"))
+	responseBytes, err := c.callApiRoute("odao/get-proposal-settings", nil, fmt.Sprintf("odao get-proposal-settings"))
 	if err != nil {
 		return api.GetTNDAOProposalSettingsResponse{}, fmt.Errorf("Could not get oracle DAO proposal settings: %w", err)
 	}
@@ -838,7 +984,7 @@ func (c *Client) GetTNDAOProposalSettings() (api.GetTNDAOProposalSettingsRespons
 
 // Get the proposal settings
 func (c *Client) GetTNDAOMinipoolSettings() (api.GetTNDAOMinipoolSettingsResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("odao get-minipool-settings"))
+	responseBytes, err := c.callApiRoute("odao/get-minipool-settings", nil, fmt.Sprintf("odao get-minipool-settings"))
 	if err != nil {
 		return api.GetTNDAOMinipoolSettingsResponse{}, fmt.Errorf("Could not get oracle DAO minipool settings: %w", err)
 	}
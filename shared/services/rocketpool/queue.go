@@ -11,7 +11,7 @@ import (
 
 // Get queue status
 func (c *Client) QueueStatus() (api.QueueStatusResponse, error) {
-	responseBytes, err := c.callAPI("queue status")
+	responseBytes, err := c.callApiRoute("queue/status", nil, "queue status")
 	if err != nil {
 		return api.QueueStatusResponse{}, fmt.Errorf("Could not get queue status: %w", err)
 	}
@@ -33,7 +33,7 @@ func (c *Client) QueueStatus() (api.QueueStatusResponse, error) {
 
 // Check whether the queue can be processed
 func (c *Client) CanProcessQueue() (api.CanProcessQueueResponse, error) {
-	responseBytes, err := c.callAPI("queue can-process")
+	responseBytes, err := c.callApiRoute("queue/can-process", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "queue can-process")
 	if err != nil {
 		return api.CanProcessQueueResponse{}, fmt.Errorf("Could not get can process queue status: %w", err)
 	}
@@ -49,7 +49,7 @@ func (c *Client) CanProcessQueue() (api.CanProcessQueueResponse, error) {
 
 // Process the queue
 func (c *Client) ProcessQueue() (api.ProcessQueueResponse, error) {
-	responseBytes, err := c.callAPI("queue process")
+	responseBytes, err := c.callApiRoute("queue/process", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "queue process")
 	if err != nil {
 		return api.ProcessQueueResponse{}, fmt.Errorf("Could not process queue: %w", err)
 	}
@@ -11,7 +11,7 @@ import (
 // Deletes the data folder including the wallet file, password file, and all validator keys.
 // Don't use this unless you have a very good reason to do it (such as switching from Prater to Mainnet).
 func (c *Client) TerminateDataFolder() (api.TerminateDataFolderResponse, error) {
-	responseBytes, err := c.callAPI("service terminate-data-folder")
+	responseBytes, err := c.callApiRoute("service/terminate-data-folder", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "service terminate-data-folder")
 	if err != nil {
 		return api.TerminateDataFolderResponse{}, fmt.Errorf("Could not delete data folder: %w", err)
 	}
@@ -27,7 +27,7 @@ func (c *Client) TerminateDataFolder() (api.TerminateDataFolderResponse, error)
 
 // Gets the status of the configured Execution and Beacon clients
 func (c *Client) GetClientStatus() (api.ClientStatusResponse, error) {
-	responseBytes, err := c.callAPI("service get-client-status")
+	responseBytes, err := c.callApiRoute("service/get-client-status", nil, "service get-client-status")
 	if err != nil {
 		return api.ClientStatusResponse{}, fmt.Errorf("Could not get client status: %w", err)
 	}
@@ -43,7 +43,7 @@ func (c *Client) GetClientStatus() (api.ClientStatusResponse, error) {
 
 // Restarts the Validator client
 func (c *Client) RestartVc() (api.RestartVcResponse, error) {
-	responseBytes, err := c.callAPI("service restart-vc")
+	responseBytes, err := c.callApiRoute("service/restart-vc", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "service restart-vc")
 	if err != nil {
 		return api.RestartVcResponse{}, fmt.Errorf("Could not get restart-vc status: %w", err)
 	}
@@ -56,3 +56,51 @@ func (c *Client) RestartVc() (api.RestartVcResponse, error) {
 	}
 	return response, nil
 }
+
+// Stops the Validator client
+func (c *Client) StopVc() (api.StopVcResponse, error) {
+	responseBytes, err := c.callApiRoute("service/stop-vc", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "service stop-vc")
+	if err != nil {
+		return api.StopVcResponse{}, fmt.Errorf("Could not get stop-vc status: %w", err)
+	}
+	var response api.StopVcResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.StopVcResponse{}, fmt.Errorf("Could not decode stop-vc response: %w", err)
+	}
+	if response.Error != "" {
+		return api.StopVcResponse{}, fmt.Errorf("Could not get stop-vc status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Gets the run history of the node and watchtower daemon tasks
+func (c *Client) GetTaskStatus() (api.TaskStatusResponse, error) {
+	responseBytes, err := c.callApiRoute("service/task-status", nil, "service task-status")
+	if err != nil {
+		return api.TaskStatusResponse{}, fmt.Errorf("Could not get task status: %w", err)
+	}
+	var response api.TaskStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.TaskStatusResponse{}, fmt.Errorf("Could not decode task status response: %w", err)
+	}
+	if response.Error != "" {
+		return api.TaskStatusResponse{}, fmt.Errorf("Could not get task status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Checks which of the enabled MEV-boost relays are reachable and whether the node's validators are registered with them
+func (c *Client) CheckRelays() (api.CheckRelaysResponse, error) {
+	responseBytes, err := c.callApiRoute("service/check-relays", nil, "service check-relays")
+	if err != nil {
+		return api.CheckRelaysResponse{}, fmt.Errorf("Could not check relays: %w", err)
+	}
+	var response api.CheckRelaysResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CheckRelaysResponse{}, fmt.Errorf("Could not decode check relays response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CheckRelaysResponse{}, fmt.Errorf("Could not check relays: %s", response.Error)
+	}
+	return response, nil
+}
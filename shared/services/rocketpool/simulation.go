@@ -0,0 +1,97 @@
+package rocketpool
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Print a preview of the transactions an API call simulated, if it ran any
+func printSimulations(responseBytes []byte) {
+	var response struct {
+		Simulations []api.TransactionSimulation `json:"simulations"`
+	}
+	if err := json.Unmarshal(responseBytes, &response); err != nil || len(response.Simulations) == 0 {
+		return
+	}
+
+	for _, simulation := range response.Simulations {
+		target := "(contract creation)"
+		if simulation.To != nil {
+			target = simulation.To.Hex()
+		}
+		if simulation.Contract != "" {
+			target = fmt.Sprintf("%s (%s)", target, simulation.Contract)
+		}
+		fmt.Printf("%s=== Simulated transaction to %s ===%s\n", colorYellow, target, colorReset)
+
+		// The simulation itself couldn't run
+		if simulation.Error != "" {
+			fmt.Printf("%sThe transaction couldn't be simulated: %s%s\n\n", colorRed, simulation.Error, colorReset)
+			continue
+		}
+
+		// It would revert
+		if !simulation.Success {
+			fmt.Printf("%sThis transaction would fail: %s%s\n\n", colorRed, simulation.RevertReason, colorReset)
+			continue
+		}
+
+		fmt.Println("This transaction would succeed.")
+		if simulation.Value != nil && simulation.Value.Sign() > 0 {
+			fmt.Printf("It sends %.6f ETH.\n", eth.WeiToEth(simulation.Value))
+		}
+		if !simulation.TraceAvailable {
+			fmt.Printf("Your Execution client couldn't trace it, so the balance changes and events aren't available (%s).\n\n", simulation.TraceError)
+			continue
+		}
+		fmt.Printf("Gas used: %d\n", simulation.GasUsed)
+
+		// Balance changes
+		if len(simulation.BalanceChanges) > 0 {
+			fmt.Println("ETH balance changes (not including gas):")
+			for _, change := range simulation.BalanceChanges {
+				name := change.Address.Hex()
+				if change.Contract != "" {
+					name = fmt.Sprintf("%s (%s)", name, change.Contract)
+				}
+				delta := new(big.Int).Sub(change.After, change.Before)
+				fmt.Printf("\t%s: %+.6f ETH\n", name, eth.WeiToEth(delta))
+			}
+		}
+
+		// Events
+		if len(simulation.Events) > 0 {
+			fmt.Println("Events:")
+			for _, event := range simulation.Events {
+				source := event.Address.Hex()
+				if event.Contract != "" {
+					source = event.Contract
+				}
+				if event.Name == "" {
+					if len(event.Topics) == 0 {
+						fmt.Printf("\t%s: anonymous event\n", source)
+					} else {
+						fmt.Printf("\t%s: unknown event %s\n", source, event.Topics[0].Hex())
+					}
+					continue
+				}
+				fmt.Printf("\t%s.%s\n", source, event.Name)
+				names := make([]string, 0, len(event.Args))
+				for name := range event.Args {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+				for _, name := range names {
+					fmt.Printf("\t\t%s: %s\n", name, event.Args[name])
+				}
+			}
+		}
+		fmt.Println()
+	}
+}
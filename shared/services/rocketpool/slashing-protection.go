@@ -0,0 +1,129 @@
+package rocketpool
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/alessio/shellescape"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	lhkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
+	lokeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lodestar"
+	nmkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
+	prkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/prysm"
+	tkkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/teku"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Config
+const (
+	SlashingProtectionContainerSuffix string = "_slashing_protection"
+)
+
+// Exports the slashing protection database of the Validator Client in the given config, and merges it into the history saved alongside the validator keys.
+// The Validator Client must be stopped first. Returns the number of validators in the export.
+func (c *Client) ExportVcSlashingProtection(cfg *config.RocketPoolConfig) (int, error) {
+
+	commands, image, err := getSlashingProtectionCommands(cfg)
+	if err != nil {
+		return 0, err
+	}
+
+	// Export the database into the validators folder
+	err = c.runSlashingProtectionCommand(cfg, image, commands.Entrypoint, commands.ExportArgs)
+	if err != nil {
+		return 0, fmt.Errorf("error exporting the Validator Client's slashing protection database: %w", err)
+	}
+
+	// Save it
+	response, err := c.SaveVcSlashingProtection(commands.ExportFile)
+	if err != nil {
+		return 0, err
+	}
+	return response.ValidatorCount, nil
+
+}
+
+// Imports the history saved alongside the validator keys into the slashing protection database of the Validator Client in the given config.
+// The Validator Client must be stopped first. Returns the number of validators that were imported.
+func (c *Client) ImportVcSlashingProtection(cfg *config.RocketPoolConfig) (int, error) {
+
+	// Make sure there's something to import
+	history, err := c.GetSlashingProtection()
+	if err != nil {
+		return 0, err
+	}
+	if !history.Exists || len(history.Interchange.Data) == 0 {
+		return 0, nil
+	}
+
+	commands, image, err := getSlashingProtectionCommands(cfg)
+	if err != nil {
+		return 0, err
+	}
+
+	// Import the saved history
+	err = c.runSlashingProtectionCommand(cfg, image, commands.Entrypoint, commands.ImportArgs)
+	if err != nil {
+		return 0, fmt.Errorf("error importing into the Validator Client's slashing protection database: %w", err)
+	}
+	return len(history.Interchange.Data), nil
+
+}
+
+// Runs one of the Validator Client's slashing protection commands in its Docker image, with the validators folder mounted the same way it is for the VC
+func (c *Client) runSlashingProtectionCommand(cfg *config.RocketPoolConfig, image string, entrypoint string, args []string) error {
+
+	validatorsPath, err := homedir.Expand(cfg.Smartnode.GetValidatorKeychainPathInCLI())
+	if err != nil {
+		return fmt.Errorf("error loading validators folder path: %w", err)
+	}
+	container := fmt.Sprintf("%s%s", cfg.Smartnode.ProjectName.Value, SlashingProtectionContainerSuffix)
+
+	cmd := fmt.Sprintf("docker run --rm --name %s -v %s:%s --entrypoint %s %s %s",
+		shellescape.Quote(container),
+		shellescape.Quote(validatorsPath),
+		keystore.ContainerValidatorsPath,
+		shellescape.Quote(entrypoint),
+		shellescape.Quote(image),
+		shellescape.QuoteCommand(args),
+	)
+	return c.printOutput(cmd)
+
+}
+
+// Get the slashing protection commands for the Validator Client in the given config, and the image to run them in
+func getSlashingProtectionCommands(cfg *config.RocketPoolConfig) (keystore.SlashingProtectionCommands, string, error) {
+
+	if cfg.IsNativeMode {
+		return keystore.SlashingProtectionCommands{}, "", errors.New("managing the Validator Client's slashing protection database is not supported in Native Mode; please use your client's own tools instead")
+	}
+
+	network := cfg.Smartnode.Network.Value.(cfgtypes.Network)
+	importFile := config.SlashingProtectionFilename
+	ccCfg, err := cfg.GetSelectedConsensusClientConfig()
+	if err != nil {
+		return keystore.SlashingProtectionCommands{}, "", err
+	}
+	image := ccCfg.GetValidatorImage()
+
+	cc, _ := cfg.GetSelectedConsensusClient()
+	switch cc {
+	case cfgtypes.ConsensusClient_Lighthouse:
+		return lhkeystore.GetSlashingProtectionCommands(network, importFile), image, nil
+	case cfgtypes.ConsensusClient_Lodestar:
+		return lokeystore.GetSlashingProtectionCommands(network, importFile), image, nil
+	case cfgtypes.ConsensusClient_Nimbus:
+		// The Nimbus VC image doesn't come with the tools for its slashing protection database
+		return nmkeystore.GetSlashingProtectionCommands(network, importFile), cfg.Nimbus.BnContainerTag.Value.(string), nil
+	case cfgtypes.ConsensusClient_Prysm:
+		return prkeystore.GetSlashingProtectionCommands(network, importFile), image, nil
+	case cfgtypes.ConsensusClient_Teku:
+		return tkkeystore.GetSlashingProtectionCommands(network, importFile), image, nil
+	default:
+		return keystore.SlashingProtectionCommands{}, "", fmt.Errorf("unknown consensus client [%v] selected", cc)
+	}
+
+}
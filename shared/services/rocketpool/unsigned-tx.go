@@ -0,0 +1,84 @@
+package rocketpool
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Formats transactions built with --unsigned-tx are exported in
+const (
+	UnsignedTxFormat_Json string = "json"
+	UnsignedTxFormat_Rlp  string = "rlp"
+)
+
+// Returned when a command would wait for a transaction that was exported for offline signing instead of being sent
+var ErrTransactionNotSent = errors.New("the transaction was exported for offline signing and has not been sent")
+
+// Check if the client builds transactions for offline signing instead of sending them
+func (c *Client) IsUnsignedTxMode() bool {
+	return c.unsignedTx
+}
+
+// Save the transactions an API call built for offline signing, if it built any
+func (c *Client) saveUnsignedTransactions(responseBytes []byte) error {
+	var response struct {
+		UnsignedTransactions []api.UnsignedTransaction `json:"unsignedTransactions"`
+	}
+	if err := json.Unmarshal(responseBytes, &response); err != nil || len(response.UnsignedTransactions) == 0 {
+		return nil
+	}
+
+	for _, tx := range response.UnsignedTransactions {
+		var path string
+		var contents []byte
+		switch c.unsignedTxFormat {
+		case UnsignedTxFormat_Rlp:
+			path = fmt.Sprintf("unsigned-tx-%d.txt", tx.Nonce)
+			contents = []byte(hexutil.Encode(tx.Rlp))
+		default:
+			path = fmt.Sprintf("unsigned-tx-%d.json", tx.Nonce)
+			var err error
+			contents, err = json.MarshalIndent(tx, "", "  ")
+			if err != nil {
+				return fmt.Errorf("Could not serialize unsigned transaction: %w", err)
+			}
+		}
+		if err := os.WriteFile(path, contents, 0644); err != nil {
+			return fmt.Errorf("Could not save unsigned transaction to %s: %w", path, err)
+		}
+
+		target := "(contract creation)"
+		if tx.To != nil {
+			target = tx.To.Hex()
+		}
+		fmt.Printf("%s=== Unsigned transaction to %s ===%s\n", colorYellow, target, colorReset)
+		fmt.Printf("From:                     %s\n", tx.From.Hex())
+		fmt.Printf("Chain ID:                 %s\n", tx.ChainID.String())
+		fmt.Printf("Nonce:                    %d\n", tx.Nonce)
+		fmt.Printf("Value:                    %.6f ETH\n", eth.WeiToEth(tx.Value))
+		fmt.Printf("Gas limit:                %d\n", tx.GasLimit)
+		fmt.Printf("Max fee:                  %.6f gwei\n", eth.WeiToGwei(tx.MaxFeePerGas))
+		fmt.Printf("Max priority fee:         %.6f gwei\n", eth.WeiToGwei(tx.MaxPriorityFeePerGas))
+		fmt.Printf("Maximum cost (incl. gas): %.6f ETH\n", eth.WeiToEth(getMaxCost(tx)))
+		if c.unsignedTxFormat == UnsignedTxFormat_Rlp {
+			fmt.Printf("Unsigned transaction:     %s\n", string(contents))
+		}
+		fmt.Printf("Saved to %s. Sign it on your offline machine with `rocketpool wallet sign-tx %s`, then submit the result from this machine with `rocketpool node broadcast-tx`.\n\n", path, path)
+	}
+	return nil
+}
+
+// Get the most ETH a transaction can cost, including its value
+func getMaxCost(tx api.UnsignedTransaction) *big.Int {
+	cost := new(big.Int).SetUint64(tx.GasLimit)
+	cost.Mul(cost, tx.MaxFeePerGas)
+	return cost.Add(cost, tx.Value)
+}
@@ -11,7 +11,7 @@ import (
 
 // Get wallet status
 func (c *Client) WalletStatus() (api.WalletStatusResponse, error) {
-	responseBytes, err := c.callAPI("wallet status")
+	responseBytes, err := c.callApiRoute("wallet/status", nil, "wallet status")
 	if err != nil {
 		return api.WalletStatusResponse{}, fmt.Errorf("Could not get wallet status: %w", err)
 	}
@@ -27,7 +27,10 @@ func (c *Client) WalletStatus() (api.WalletStatusResponse, error) {
 
 // Set wallet password
 func (c *Client) SetPassword(password string) (api.SetPasswordResponse, error) {
-	responseBytes, err := c.callAPI("wallet set-password", password)
+	responseBytes, err := c.callApiRoute("wallet/set-password", api.WalletSetPasswordRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Password:          password,
+	}, "wallet set-password", password)
 	if err != nil {
 		return api.SetPasswordResponse{}, fmt.Errorf("Could not set wallet password: %w", err)
 	}
@@ -43,7 +46,10 @@ func (c *Client) SetPassword(password string) (api.SetPasswordResponse, error) {
 
 // Initialize wallet
 func (c *Client) InitWallet(derivationPath string) (api.InitWalletResponse, error) {
-	responseBytes, err := c.callAPI("wallet init --derivation-path", derivationPath)
+	responseBytes, err := c.callApiRoute("wallet/init", api.WalletInitRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		DerivationPath:    derivationPath,
+	}, "wallet init --derivation-path", derivationPath)
 	if err != nil {
 		return api.InitWalletResponse{}, fmt.Errorf("Could not initialize wallet: %w", err)
 	}
@@ -68,7 +74,13 @@ func (c *Client) RecoverWallet(mnemonic string, skipValidatorKeyRecovery bool, d
 	}
 	command += "--derivation-path"
 
-	responseBytes, err := c.callAPI(command, derivationPath, mnemonic)
+	responseBytes, err := c.callApiRoute("wallet/recover", api.WalletRecoverRequest{
+		ApiServerSettings:        c.getApiServerSettings(),
+		Mnemonic:                 mnemonic,
+		SkipValidatorKeyRecovery: skipValidatorKeyRecovery,
+		DerivationPath:           derivationPath,
+		WalletIndex:              walletIndex,
+	}, command, derivationPath, mnemonic)
 	if err != nil {
 		return api.RecoverWalletResponse{}, fmt.Errorf("Could not recover wallet: %w", err)
 	}
@@ -89,7 +101,12 @@ func (c *Client) SearchAndRecoverWallet(mnemonic string, address common.Address,
 		command += "--skip-validator-key-recovery "
 	}
 
-	responseBytes, err := c.callAPI(command, mnemonic, address.Hex())
+	responseBytes, err := c.callApiRoute("wallet/search-and-recover", api.WalletSearchAndRecoverRequest{
+		ApiServerSettings:        c.getApiServerSettings(),
+		Mnemonic:                 mnemonic,
+		Address:                  address,
+		SkipValidatorKeyRecovery: skipValidatorKeyRecovery,
+	}, command, mnemonic, address.Hex())
 	if err != nil {
 		return api.SearchAndRecoverWalletResponse{}, fmt.Errorf("Could not search and recover wallet: %w", err)
 	}
@@ -114,7 +131,13 @@ func (c *Client) TestRecoverWallet(mnemonic string, skipValidatorKeyRecovery boo
 	}
 	command += "--derivation-path"
 
-	responseBytes, err := c.callAPI(command, derivationPath, mnemonic)
+	responseBytes, err := c.callApiRoute("wallet/test-recovery", api.WalletRecoverRequest{
+		ApiServerSettings:        c.getApiServerSettings(),
+		Mnemonic:                 mnemonic,
+		SkipValidatorKeyRecovery: skipValidatorKeyRecovery,
+		DerivationPath:           derivationPath,
+		WalletIndex:              walletIndex,
+	}, command, derivationPath, mnemonic)
 	if err != nil {
 		return api.RecoverWalletResponse{}, fmt.Errorf("Could not test recover wallet: %w", err)
 	}
@@ -135,7 +158,12 @@ func (c *Client) TestSearchAndRecoverWallet(mnemonic string, address common.Addr
 		command += "--skip-validator-key-recovery "
 	}
 
-	responseBytes, err := c.callAPI(command, mnemonic, address.Hex())
+	responseBytes, err := c.callApiRoute("wallet/test-search-and-recover", api.WalletSearchAndRecoverRequest{
+		ApiServerSettings:        c.getApiServerSettings(),
+		Mnemonic:                 mnemonic,
+		Address:                  address,
+		SkipValidatorKeyRecovery: skipValidatorKeyRecovery,
+	}, command, mnemonic, address.Hex())
 	if err != nil {
 		return api.SearchAndRecoverWalletResponse{}, fmt.Errorf("Could not test search and recover wallet: %w", err)
 	}
@@ -151,7 +179,7 @@ func (c *Client) TestSearchAndRecoverWallet(mnemonic string, address common.Addr
 
 // Rebuild wallet
 func (c *Client) RebuildWallet() (api.RebuildWalletResponse, error) {
-	responseBytes, err := c.callAPI("wallet rebuild")
+	responseBytes, err := c.callApiRoute("wallet/rebuild", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "wallet rebuild")
 	if err != nil {
 		return api.RebuildWalletResponse{}, fmt.Errorf("Could not rebuild wallet: %w", err)
 	}
@@ -167,7 +195,10 @@ func (c *Client) RebuildWallet() (api.RebuildWalletResponse, error) {
 
 // Estimate the gas required to set an ENS reverse record to a name
 func (c *Client) EstimateGasSetEnsName(name string) (api.SetEnsNameResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("wallet estimate-gas-set-ens-name %s", name))
+	responseBytes, err := c.callApiRoute("wallet/estimate-gas-set-ens-name", api.WalletSetEnsNameRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Name:              name,
+	}, fmt.Sprintf("wallet estimate-gas-set-ens-name %s", name))
 	if err != nil {
 		return api.SetEnsNameResponse{}, fmt.Errorf("Could not get estimate-gas-set-ens-name response: %w", err)
 	}
@@ -183,7 +214,10 @@ func (c *Client) EstimateGasSetEnsName(name string) (api.SetEnsNameResponse, err
 
 // Set an ENS reverse record to a name
 func (c *Client) SetEnsName(name string) (api.SetEnsNameResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("wallet set-ens-name %s", name))
+	responseBytes, err := c.callApiRoute("wallet/set-ens-name", api.WalletSetEnsNameRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Name:              name,
+	}, fmt.Sprintf("wallet set-ens-name %s", name))
 	if err != nil {
 		return api.SetEnsNameResponse{}, fmt.Errorf("Could not update ENS record: %w", err)
 	}
@@ -199,7 +233,7 @@ func (c *Client) SetEnsName(name string) (api.SetEnsNameResponse, error) {
 
 // Export wallet
 func (c *Client) ExportWallet() (api.ExportWalletResponse, error) {
-	responseBytes, err := c.callAPI("wallet export")
+	responseBytes, err := c.callApiRoute("wallet/export", api.ApiServerRequest{ApiServerSettings: c.getApiServerSettings()}, "wallet export")
 	if err != nil {
 		return api.ExportWalletResponse{}, fmt.Errorf("Could not export wallet: %w", err)
 	}
@@ -212,3 +246,78 @@ func (c *Client) ExportWallet() (api.ExportWalletResponse, error) {
 	}
 	return response, nil
 }
+
+// Get the EIP-3076 slashing protection history saved alongside the validator keys
+func (c *Client) GetSlashingProtection() (api.GetSlashingProtectionResponse, error) {
+	responseBytes, err := c.callApiRoute("wallet/get-slashing-protection", nil, "wallet get-slashing-protection")
+	if err != nil {
+		return api.GetSlashingProtectionResponse{}, fmt.Errorf("Could not get slashing protection history: %w", err)
+	}
+	var response api.GetSlashingProtectionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GetSlashingProtectionResponse{}, fmt.Errorf("Could not decode get slashing protection response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GetSlashingProtectionResponse{}, fmt.Errorf("Could not get slashing protection history: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Merge a serialized EIP-3076 slashing protection interchange into the history saved alongside the validator keys
+func (c *Client) SaveSlashingProtection(interchange string) (api.SaveSlashingProtectionResponse, error) {
+	responseBytes, err := c.callApiRoute("wallet/save-slashing-protection", api.WalletSaveSlashingProtectionRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		Interchange:       interchange,
+	}, "wallet save-slashing-protection", interchange)
+	if err != nil {
+		return api.SaveSlashingProtectionResponse{}, fmt.Errorf("Could not save slashing protection history: %w", err)
+	}
+	var response api.SaveSlashingProtectionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SaveSlashingProtectionResponse{}, fmt.Errorf("Could not decode save slashing protection response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SaveSlashingProtectionResponse{}, fmt.Errorf("Could not save slashing protection history: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Merge the slashing protection database the Validator Client exported to the given file (relative to the validators folder) into the history saved alongside the validator keys
+func (c *Client) SaveVcSlashingProtection(exportFile string) (api.SaveSlashingProtectionResponse, error) {
+	responseBytes, err := c.callApiRoute("wallet/save-vc-slashing-protection", api.WalletSaveVcSlashingProtectionRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		ExportFile:        exportFile,
+	}, "wallet save-vc-slashing-protection", exportFile)
+	if err != nil {
+		return api.SaveSlashingProtectionResponse{}, fmt.Errorf("Could not save the Validator Client's slashing protection history: %w", err)
+	}
+	var response api.SaveSlashingProtectionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SaveSlashingProtectionResponse{}, fmt.Errorf("Could not decode save VC slashing protection response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SaveSlashingProtectionResponse{}, fmt.Errorf("Could not save the Validator Client's slashing protection history: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Sign a transaction that was built for the node account on another machine with --unsigned-tx
+func (c *Client) SignTransaction(unsignedTx string) (api.SignTransactionResponse, error) {
+	// Ignore sync status so transactions can be signed on an offline machine
+	c.ignoreSyncCheck = true
+	responseBytes, err := c.callApiRoute("wallet/sign-tx", api.WalletSignTransactionRequest{
+		ApiServerSettings: c.getApiServerSettings(),
+		UnsignedTx:        unsignedTx,
+	}, "wallet sign-tx", unsignedTx)
+	if err != nil {
+		return api.SignTransactionResponse{}, fmt.Errorf("Could not sign transaction: %w", err)
+	}
+	var response api.SignTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SignTransactionResponse{}, fmt.Errorf("Could not decode sign transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SignTransactionResponse{}, fmt.Errorf("Could not sign transaction: %s", response.Error)
+	}
+	return response, nil
+}
@@ -2,12 +2,16 @@ package services
 
 import (
 	"fmt"
+	"log"
 	"math/big"
 	"os"
 	"sync"
 
 	"github.com/docker/docker/client"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
@@ -16,13 +20,17 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/services/contracts"
+	"github.com/rocket-pool/smartnode/shared/services/notifications"
 	"github.com/rocket-pool/smartnode/shared/services/passwords"
+	"github.com/rocket-pool/smartnode/shared/services/simulation"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	lhkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
 	lokeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lodestar"
 	nmkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
 	prkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/prysm"
 	tkkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/teku"
+	w3skeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/web3signer"
 	"github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
@@ -46,6 +54,8 @@ var (
 	snapshotDelegation *contracts.SnapshotDelegation
 	beaconClient       beacon.Client
 	docker             *client.Client
+	txManager          *txmanager.TransactionManager
+	notifier           *notifications.Notifier
 
 	initCfg                sync.Once
 	initPasswordManager    sync.Once
@@ -58,6 +68,8 @@ var (
 	initSnapshotDelegation sync.Once
 	initBeaconClient       sync.Once
 	initDocker             sync.Once
+	initTxManager          sync.Once
+	initNotifier           sync.Once
 )
 
 //
@@ -94,6 +106,9 @@ func GetEthClient(c *cli.Context) (*ExecutionClientManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if c.GlobalBool("simulate") {
+		return getSimulatingEthClient(cfg, ec)
+	}
 	return ec, nil
 }
 
@@ -113,9 +128,33 @@ func GetRocketPool(c *cli.Context) (*rocketpool.RocketPool, error) {
 		return nil, err
 	}
 
+	// Simulated calls get their own binding, so the shared one never simulates
+	if manager, ok := ec.(*ExecutionClientManager); ok && c.GlobalBool("simulate") {
+		simulatingEc, err := getSimulatingEthClient(cfg, manager)
+		if err != nil {
+			return nil, err
+		}
+		return rocketpool.NewRocketPool(simulatingEc, common.HexToAddress(cfg.Smartnode.GetStorageAddress()))
+	}
 	return getRocketPool(cfg, ec)
 }
 
+func GetTransactionManager(c *cli.Context) (*txmanager.TransactionManager, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return getTransactionManager(c, cfg)
+}
+
+func GetNotifier(c *cli.Context) (*notifications.Notifier, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return getNotifier(c, cfg)
+}
+
 func GetRplFaucet(c *cli.Context) (*contracts.RPLFaucet, error) {
 	cfg, err := getConfig(c)
 	if err != nil {
@@ -152,6 +191,25 @@ func GetDocker(c *cli.Context) (*client.Client, error) {
 	return getDocker()
 }
 
+// Get a transactor for the node account that uses the gas settings of the current call, falling back to the ones in the config.
+// The daemon's API server gives each request its own flags, so they're read here instead of being stored in the shared wallet.
+func GetNodeAccountTransactor(c *cli.Context) (*bind.TransactOpts, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := getWallet(c, cfg, getPasswordManager(cfg))
+	if err != nil {
+		return nil, err
+	}
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	opts.GasFeeCap, opts.GasTipCap = getGasSettings(cfg, c.GlobalFloat64("maxFee"), c.GlobalFloat64("maxPrioFee"))
+	return opts, nil
+}
+
 //
 // Service instance getters
 //
@@ -178,23 +236,7 @@ func getPasswordManager(cfg *config.RocketPoolConfig) *passwords.PasswordManager
 func getWallet(c *cli.Context, cfg *config.RocketPoolConfig, pm *passwords.PasswordManager) (*wallet.Wallet, error) {
 	var err error
 	initNodeWallet.Do(func() {
-		var maxFee *big.Int
-		maxFeeFloat := c.GlobalFloat64("maxFee")
-		if maxFeeFloat == 0 {
-			maxFeeFloat = cfg.Smartnode.ManualMaxFee.Value.(float64)
-		}
-		if maxFeeFloat != 0 {
-			maxFee = eth.GweiToWei(maxFeeFloat)
-		}
-
-		var maxPriorityFee *big.Int
-		maxPriorityFeeFloat := c.GlobalFloat64("maxPrioFee")
-		if maxPriorityFeeFloat == 0 {
-			maxPriorityFeeFloat = cfg.Smartnode.PriorityFee.Value.(float64)
-		}
-		if maxPriorityFeeFloat != 0 {
-			maxPriorityFee = eth.GweiToWei(maxPriorityFeeFloat)
-		}
+		maxFee, maxPriorityFee := getGasSettings(cfg, c.GlobalFloat64("maxFee"), c.GlobalFloat64("maxPrioFee"))
 
 		chainId := cfg.Smartnode.GetChainID()
 
@@ -202,8 +244,17 @@ func getWallet(c *cli.Context, cfg *config.RocketPoolConfig, pm *passwords.Passw
 		if err != nil {
 			return
 		}
+		nodeWallet.SetSlashingProtectionPath(os.ExpandEnv(cfg.Smartnode.GetSlashingProtectionPath()))
+		if c.GlobalBool("unsigned-tx") {
+			nodeWallet.EnableUnsignedTransactions()
+		}
 
-		// Keystores
+		// Keystores; if a remote signer holds the keys, it's the only one so the local VC never loads them too
+		remoteSignerUrl := cfg.Smartnode.RemoteSignerUrl.Value.(string)
+		if remoteSignerUrl != "" {
+			nodeWallet.AddKeystore("web3signer", w3skeystore.NewKeystore(remoteSignerUrl, cfg.Smartnode.RemoteSignerAuthToken.Value.(string)))
+			return
+		}
 		lighthouseKeystore := lhkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
 		lodestarKeystore := lokeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
 		nimbusKeystore := nmkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
@@ -218,6 +269,27 @@ func getWallet(c *cli.Context, cfg *config.RocketPoolConfig, pm *passwords.Passw
 	return nodeWallet, err
 }
 
+// Get the default gas settings for the node's transactions, falling back to the config if they aren't provided
+func getGasSettings(cfg *config.RocketPoolConfig, maxFeeGwei float64, maxPriorityFeeGwei float64) (*big.Int, *big.Int) {
+	var maxFee *big.Int
+	if maxFeeGwei == 0 {
+		maxFeeGwei = cfg.Smartnode.ManualMaxFee.Value.(float64)
+	}
+	if maxFeeGwei != 0 {
+		maxFee = eth.GweiToWei(maxFeeGwei)
+	}
+
+	var maxPriorityFee *big.Int
+	if maxPriorityFeeGwei == 0 {
+		maxPriorityFeeGwei = cfg.Smartnode.PriorityFee.Value.(float64)
+	}
+	if maxPriorityFeeGwei != 0 {
+		maxPriorityFee = eth.GweiToWei(maxPriorityFeeGwei)
+	}
+
+	return maxFee, maxPriorityFee
+}
+
 func getEthClient(c *cli.Context, cfg *config.RocketPoolConfig) (*ExecutionClientManager, error) {
 	var err error
 	initECManager.Do(func() {
@@ -226,16 +298,67 @@ func getEthClient(c *cli.Context, cfg *config.RocketPoolConfig) (*ExecutionClien
 		if err == nil {
 			// Check if the manager should ignore sync checks and/or default to using the fallback (used by the API container when driven by the CLI)
 			if c.GlobalBool("ignore-sync-check") {
-				ecManager.ignoreSyncCheck = true
+				ecManager.pool.ignoreSyncCheck = true
 			}
 			if c.GlobalBool("force-fallbacks") {
-				ecManager.primaryReady = false
+				ecManager.pool.disablePrimary()
+			}
+			if c.GlobalBool("unsigned-tx") {
+				ecManager.EnableUnsignedTransactions()
 			}
+
+			// Record the node's transactions in the journal
+			ecManager.SetTransactionObserver(func(tx *types.Transaction) {
+				txm, err := getTransactionManager(c, cfg)
+				if err != nil {
+					log.Printf("WARNING: couldn't record transaction %s: %s\n", tx.Hash().Hex(), err.Error())
+					return
+				}
+				if err := txm.Track(tx); err != nil {
+					log.Printf("WARNING: couldn't record transaction %s: %s\n", tx.Hash().Hex(), err.Error())
+				}
+			})
 		}
 	})
 	return ecManager, err
 }
 
+func getTransactionManager(c *cli.Context, cfg *config.RocketPoolConfig) (*txmanager.TransactionManager, error) {
+	var err error
+	initTxManager.Do(func() {
+		var w *wallet.Wallet
+		w, err = getWallet(c, cfg, getPasswordManager(cfg))
+		if err != nil {
+			return
+		}
+		var ec *ExecutionClientManager
+		ec, err = getEthClient(c, cfg)
+		if err != nil {
+			return
+		}
+		txManager = txmanager.NewTransactionManager(cfg, ec, w)
+	})
+	return txManager, err
+}
+
+func getNotifier(c *cli.Context, cfg *config.RocketPoolConfig) (*notifications.Notifier, error) {
+	var err error
+	initNotifier.Do(func() {
+		var w *wallet.Wallet
+		w, err = getWallet(c, cfg, getPasswordManager(cfg))
+		if err != nil {
+			return
+		}
+		var nodeAccount accounts.Account
+		nodeAccount, err = w.GetNodeAccount()
+		if err != nil {
+			return
+		}
+		notifier = notifications.NewNotifier(cfg, nodeAccount.Address)
+	})
+	return notifier, err
+}
+
 func getRocketPool(cfg *config.RocketPoolConfig, client rocketpool.ExecutionClient) (*rocketpool.RocketPool, error) {
 	var err error
 	initRocketPool.Do(func() {
@@ -244,6 +367,19 @@ func getRocketPool(cfg *config.RocketPoolConfig, client rocketpool.ExecutionClie
 	return rocketPool, err
 }
 
+// Get a copy of the EC manager that simulates every transaction it estimates, for a call that asked for simulation.
+// Simulation is a per-call setting, so it never changes the shared manager.
+func getSimulatingEthClient(cfg *config.RocketPoolConfig, ec *ExecutionClientManager) (*ExecutionClientManager, error) {
+	// Let simulations decode reverts and events with the Rocket Pool contracts
+	rocketPool, err := getRocketPool(cfg, ec)
+	if err != nil {
+		return nil, err
+	}
+	simulator := simulation.NewSimulator()
+	simulator.SetRocketPool(rocketPool)
+	return ec.withSimulator(simulator), nil
+}
+
 func getRplFaucet(cfg *config.RocketPoolConfig, client rocketpool.ExecutionClient) (*contracts.RPLFaucet, error) {
 	var err error
 	initRplFaucet.Do(func() {
@@ -271,10 +407,10 @@ func getBeaconClient(c *cli.Context, cfg *config.RocketPoolConfig) (*BeaconClien
 		if err == nil {
 			// Check if the manager should ignore sync checks and/or default to using the fallback (used by the API container when driven by the CLI)
 			if c.GlobalBool("ignore-sync-check") {
-				bcManager.ignoreSyncCheck = true
+				bcManager.pool.ignoreSyncCheck = true
 			}
 			if c.GlobalBool("force-fallbacks") {
-				bcManager.primaryReady = false
+				bcManager.pool.disablePrimary()
 			}
 		}
 	})
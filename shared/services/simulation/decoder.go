@@ -0,0 +1,229 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// The Rocket Pool contracts whose ABIs are used to decode reverts and events.
+// Contracts that don't exist on the current deployment are skipped.
+var contractNames = []string{
+	"rocketAuctionManager",
+	"rocketDAONodeTrusted",
+	"rocketDAONodeTrustedActions",
+	"rocketDAONodeTrustedProposals",
+	"rocketDAOProposal",
+	"rocketDepositPool",
+	"rocketMerkleDistributorMainnet",
+	"rocketMinipoolBondReducer",
+	"rocketMinipoolDelegate",
+	"rocketMinipoolFactory",
+	"rocketMinipoolManager",
+	"rocketMinipoolQueue",
+	"rocketNetworkBalances",
+	"rocketNetworkPenalties",
+	"rocketNetworkPrices",
+	"rocketNodeDeposit",
+	"rocketNodeDistributorDelegate",
+	"rocketNodeDistributorFactory",
+	"rocketNodeManager",
+	"rocketNodeStaking",
+	"rocketRewardsPool",
+	"rocketSmoothingPool",
+	"rocketTokenRETH",
+	"rocketTokenRPL",
+	"rocketVault",
+}
+
+// Solidity's Panic(uint256) selector and the meaning of its codes
+var panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+var panicReasons = map[uint64]string{
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array",
+	0x31: "pop from an empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to an uninitialized function",
+}
+
+// An event from one of the Rocket Pool contracts
+type contractEvent struct {
+	contract string
+	event    abi.Event
+}
+
+// An error from one of the Rocket Pool contracts
+type contractError struct {
+	contract string
+	err      abi.Error
+}
+
+// Decodes reverts and events using the Rocket Pool contract ABIs, which are loaded from the chain the first time they're needed
+type decoder struct {
+	rp     *rocketpool.RocketPool
+	once   sync.Once
+	names  map[common.Address]string
+	events map[common.Hash]contractEvent
+	errors map[[4]byte]contractError
+}
+
+// Create a new decoder
+func newDecoder(rp *rocketpool.RocketPool) *decoder {
+	return &decoder{
+		rp:     rp,
+		names:  map[common.Address]string{},
+		events: map[common.Hash]contractEvent{},
+		errors: map[[4]byte]contractError{},
+	}
+}
+
+// Load the contract addresses and ABIs
+func (d *decoder) load() {
+	d.once.Do(func() {
+		if d.rp == nil {
+			return
+		}
+		for _, name := range contractNames {
+			if address, err := d.rp.GetAddress(name, nil); err == nil && address != nil && *address != (common.Address{}) {
+				d.names[*address] = name
+			}
+			contractAbi, err := d.rp.GetABI(name, nil)
+			if err != nil || contractAbi == nil {
+				continue
+			}
+			for _, event := range contractAbi.Events {
+				d.events[event.ID] = contractEvent{contract: name, event: event}
+			}
+			for _, contractErr := range contractAbi.Errors {
+				var selector [4]byte
+				copy(selector[:], contractErr.ID[:4])
+				d.errors[selector] = contractError{contract: name, err: contractErr}
+			}
+		}
+	})
+}
+
+// Get the name of a Rocket Pool contract from its address, or a blank string if it isn't one
+func (d *decoder) getContractName(address *common.Address) string {
+	if d == nil || address == nil {
+		return ""
+	}
+	d.load()
+	return d.names[*address]
+}
+
+// Get a readable reason from a transaction's revert data
+func (d *decoder) decodeRevert(data []byte) string {
+	if len(data) == 0 {
+		return "execution reverted without a reason"
+	}
+
+	// require() / revert() messages
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+
+	// Compiler-inserted checks
+	if len(data) == 36 && bytes.Equal(data[:4], panicSelector) {
+		code := new(big.Int).SetBytes(data[4:])
+		reason, exists := panicReasons[code.Uint64()]
+		if !exists {
+			reason = "unknown panic"
+		}
+		return fmt.Sprintf("%s (panic code 0x%x)", reason, code)
+	}
+
+	// Custom errors
+	if d != nil && len(data) >= 4 {
+		d.load()
+		var selector [4]byte
+		copy(selector[:], data[:4])
+		if contractErr, exists := d.errors[selector]; exists {
+			values, err := contractErr.err.Inputs.Unpack(data[4:])
+			if err == nil {
+				args := make([]string, len(values))
+				for i, value := range values {
+					args[i] = formatValue(value)
+				}
+				return fmt.Sprintf("%s.%s(%s)", contractErr.contract, contractErr.err.Name, strings.Join(args, ", "))
+			}
+		}
+	}
+
+	return fmt.Sprintf("unknown revert data %s", hexutil.Encode(data))
+}
+
+// Decode a log into an event
+func (d *decoder) decodeEvent(log *types.Log) api.SimulatedEvent {
+	event := api.SimulatedEvent{
+		Address:  log.Address,
+		Contract: d.getContractName(&log.Address),
+		Topics:   log.Topics,
+		Data:     log.Data,
+	}
+	if d == nil || len(log.Topics) == 0 {
+		return event
+	}
+	d.load()
+	contractEvent, exists := d.events[log.Topics[0]]
+	if !exists {
+		return event
+	}
+
+	// Unpack the arguments; indexed ones are in the topics and the rest are in the data
+	values := map[string]interface{}{}
+	if err := contractEvent.event.Inputs.UnpackIntoMap(values, log.Data); err != nil {
+		return event
+	}
+	indexed := abi.Arguments{}
+	for _, input := range contractEvent.event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(values, indexed, log.Topics[1:]); err != nil {
+		return event
+	}
+
+	event.Name = contractEvent.event.Name
+	if event.Contract == "" {
+		event.Contract = contractEvent.contract
+	}
+	event.Args = make(map[string]string, len(values))
+	for name, value := range values {
+		event.Args[name] = formatValue(value)
+	}
+	return event
+}
+
+// Format a decoded ABI value for display
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case [32]byte:
+		return hexutil.Encode(v[:])
+	case []byte:
+		return hexutil.Encode(v)
+	case *big.Int:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
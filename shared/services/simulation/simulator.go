@@ -0,0 +1,225 @@
+package simulation
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Config
+const (
+	// Transactions are simulated on top of the pending block, so they see the effects of the node's other pending transactions
+	simulationBlock string = "pending"
+)
+
+// The results of every simulation run by this process
+var (
+	results     []api.TransactionSimulation
+	resultsLock sync.Mutex
+)
+
+// Runs transactions through eth_call and the debug tracers without sending them
+type Simulator struct {
+	decoder *decoder
+}
+
+// A call frame from the callTracer
+type callFrame struct {
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Logs    []callLog      `json:"logs"`
+	Calls   []callFrame    `json:"calls"`
+}
+
+// A log from the callTracer
+type callLog struct {
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+// The state of an account from the prestateTracer
+type accountState struct {
+	Balance *hexutil.Big `json:"balance"`
+}
+
+// The state diff from the prestateTracer in diff mode
+type stateDiff struct {
+	Pre  map[common.Address]accountState `json:"pre"`
+	Post map[common.Address]accountState `json:"post"`
+}
+
+// Create a new simulator
+func NewSimulator() *Simulator {
+	return &Simulator{}
+}
+
+// Use the Rocket Pool contracts to decode reverts and events
+func (s *Simulator) SetRocketPool(rp *rocketpool.RocketPool) {
+	s.decoder = newDecoder(rp)
+}
+
+// Simulate a transaction against the pending block.
+// Reverts are part of the result; an error is only returned if the client couldn't be reached.
+func (s *Simulator) Simulate(ctx context.Context, client *rpc.Client, msg ethereum.CallMsg) (api.TransactionSimulation, error) {
+
+	result := api.TransactionSimulation{
+		From:           msg.From,
+		To:             msg.To,
+		Contract:       s.decoder.getContractName(msg.To),
+		Value:          msg.Value,
+		BalanceChanges: []api.SimulatedBalanceChange{},
+		Events:         []api.SimulatedEvent{},
+	}
+	if result.Value == nil {
+		result.Value = big.NewInt(0)
+	}
+	callArg := toCallArg(msg)
+
+	// Run the call
+	var returnData hexutil.Bytes
+	err := client.CallContext(ctx, &returnData, "eth_call", callArg, simulationBlock)
+	if err != nil {
+		var rpcErr rpc.Error
+		if !errors.As(err, &rpcErr) {
+			return api.TransactionSimulation{}, err
+		}
+		result.RevertReason = s.getRevertReason(err)
+		return result, nil
+	}
+	result.Success = true
+	result.ReturnData = returnData
+
+	// Get the events and gas usage; this needs the debug namespace, which not every client provides
+	var frame callFrame
+	err = client.CallContext(ctx, &frame, "debug_traceCall", callArg, simulationBlock, map[string]interface{}{
+		"tracer":       "callTracer",
+		"tracerConfig": map[string]interface{}{"withLog": true},
+	})
+	if err != nil {
+		result.TraceError = err.Error()
+		return result, nil
+	}
+	result.GasUsed = uint64(frame.GasUsed)
+	for _, log := range frame.getLogs() {
+		result.Events = append(result.Events, s.decoder.decodeEvent(&types.Log{
+			Address: log.Address,
+			Topics:  log.Topics,
+			Data:    log.Data,
+		}))
+	}
+
+	// Get the balance changes
+	var diff stateDiff
+	err = client.CallContext(ctx, &diff, "debug_traceCall", callArg, simulationBlock, map[string]interface{}{
+		"tracer":       "prestateTracer",
+		"tracerConfig": map[string]interface{}{"diffMode": true},
+	})
+	if err != nil {
+		result.TraceError = err.Error()
+		return result, nil
+	}
+	for address, post := range diff.Post {
+		if post.Balance == nil {
+			continue
+		}
+		before := big.NewInt(0)
+		if pre, exists := diff.Pre[address]; exists && pre.Balance != nil {
+			before = pre.Balance.ToInt()
+		}
+		after := post.Balance.ToInt()
+		if before.Cmp(after) == 0 {
+			continue
+		}
+		changeAddress := address
+		result.BalanceChanges = append(result.BalanceChanges, api.SimulatedBalanceChange{
+			Address:  address,
+			Contract: s.decoder.getContractName(&changeAddress),
+			Before:   before,
+			After:    after,
+		})
+	}
+	sort.Slice(result.BalanceChanges, func(i, j int) bool {
+		return result.BalanceChanges[i].Address.Hex() < result.BalanceChanges[j].Address.Hex()
+	})
+	result.TraceAvailable = true
+
+	return result, nil
+
+}
+
+// Get the reason a call reverted from its error, decoding the revert data if there is any
+func (s *Simulator) getRevertReason(err error) string {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if dataString, ok := dataErr.ErrorData().(string); ok {
+			data, decodeErr := hexutil.Decode(dataString)
+			if decodeErr == nil {
+				return s.decoder.decodeRevert(data)
+			}
+		}
+	}
+	return strings.TrimPrefix(err.Error(), "execution reverted: ")
+}
+
+// Get every log in a call frame and its subcalls, in the order they were emitted
+func (f *callFrame) getLogs() []callLog {
+	logs := append([]callLog{}, f.Logs...)
+	for i := range f.Calls {
+		logs = append(logs, f.Calls[i].getLogs()...)
+	}
+	return logs
+}
+
+// Record the result of a simulation so it can be included in the API response
+func Record(result api.TransactionSimulation) {
+	resultsLock.Lock()
+	defer resultsLock.Unlock()
+	results = append(results, result)
+}
+
+// Get the results of every simulation run by this process
+func GetResults() []api.TransactionSimulation {
+	resultsLock.Lock()
+	defer resultsLock.Unlock()
+	return append([]api.TransactionSimulation{}, results...)
+}
+
+// Clear the results of the simulations run so far, so a long-lived process can start over for each request
+func ClearResults() {
+	resultsLock.Lock()
+	defer resultsLock.Unlock()
+	results = nil
+}
+
+// Convert a call message into the JSON-RPC call argument
+func toCallArg(msg ethereum.CallMsg) interface{} {
+	arg := map[string]interface{}{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["data"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	return arg
+}
@@ -0,0 +1,277 @@
+package state
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/klauspost/compress/zstd"
+	"github.com/rocket-pool/rocketpool-go/types"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
+	"github.com/rocket-pool/smartnode/shared"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+const (
+	stateCacheFilenameFormat  string = "%d.json.zst"
+	stateCacheFilenamePattern string = "^(?P<slot>\\d+)\\.json\\.zst$"
+	stateCacheChecksumSuffix  string = ".sha384"
+)
+
+// Serializes access to the cache folder, since several managers can share it within one process
+var stateCacheLock sync.Mutex
+
+// The on-disk representation of a network state
+type networkStateSnapshot struct {
+	SmartnodeVersion       string                           `json:"smartnodeVersion"`
+	ElBlockNumber          uint64                           `json:"elBlockNumber"`
+	BeaconSlotNumber       uint64                           `json:"beaconSlotNumber"`
+	BeaconConfig           beacon.Eth2Config                `json:"beaconConfig"`
+	NetworkDetails         *rpstate.NetworkDetails          `json:"networkDetails"`
+	NodeDetails            []rpstate.NativeNodeDetails      `json:"nodeDetails"`
+	MinipoolDetails        []rpstate.NativeMinipoolDetails  `json:"minipoolDetails"`
+	ValidatorDetails       []validatorDetailsEntry          `json:"validatorDetails"`
+	OracleDaoMemberDetails []rpstate.OracleDaoMemberDetails `json:"oracleDaoMemberDetails"`
+}
+
+// A single validator status, along with the pubkey it was requested for
+type validatorDetailsEntry struct {
+	Pubkey types.ValidatorPubkey  `json:"pubkey"`
+	Status beacon.ValidatorStatus `json:"status"`
+}
+
+// A persistent, compressed cache of finalized network states keyed by Beacon slot
+type NetworkStateCache struct {
+	path           string
+	retentionLimit uint64
+	log            *log.ColorLogger
+	compressor     *zstd.Encoder
+	decompressor   *zstd.Decoder
+	filenameRegex  *regexp.Regexp
+}
+
+// Creates a new network state cache in the provided folder. States beyond the retention limit are pruned, oldest first.
+func NewNetworkStateCache(path string, retentionLimit uint64, log *log.ColorLogger) (*NetworkStateCache, error) {
+	// Create the zstd compressor and decompressor
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedBestCompression))
+	if err != nil {
+		return nil, fmt.Errorf("error creating zstd compressor for network state cache: %w", err)
+	}
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating zstd decompressor for network state cache: %w", err)
+	}
+
+	// Make the cache folder if it doesn't exist
+	err = os.MkdirAll(path, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("error creating network state cache folder [%s]: %w", path, err)
+	}
+
+	return &NetworkStateCache{
+		path:           path,
+		retentionLimit: retentionLimit,
+		log:            log,
+		compressor:     encoder,
+		decompressor:   decoder,
+		filenameRegex:  regexp.MustCompile(stateCacheFilenamePattern),
+	}, nil
+}
+
+// Load the network state for the provided slot from disk, if it exists and passes its integrity check.
+// Corrupted or incompatible entries are removed so they can be regenerated.
+func (c *NetworkStateCache) Load(slot uint64, log *log.ColorLogger) (*NetworkState, bool, error) {
+	stateCacheLock.Lock()
+	defer stateCacheLock.Unlock()
+
+	filename := filepath.Join(c.path, fmt.Sprintf(stateCacheFilenameFormat, slot))
+	compressedBytes, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("error reading cached state file [%s]: %w", filename, err)
+	}
+
+	// Verify the checksum
+	expectedChecksumString, err := os.ReadFile(filename + stateCacheChecksumSuffix)
+	if err != nil {
+		c.logLine("Cached state for slot %d has no readable checksum (%s), discarding it.", slot, err.Error())
+		c.remove(filename)
+		return nil, false, nil
+	}
+	checksum := sha512.Sum384(compressedBytes)
+	expectedChecksum, err := hex.DecodeString(strings.TrimSpace(string(expectedChecksumString)))
+	if err != nil || !bytes.Equal(expectedChecksum, checksum[:]) {
+		c.logLine("Cached state for slot %d failed its integrity check, discarding it.", slot)
+		c.remove(filename)
+		return nil, false, nil
+	}
+
+	// Decompress and deserialize it
+	decompressedBytes, err := c.decompressor.DecodeAll(compressedBytes, []byte{})
+	if err != nil {
+		return nil, false, fmt.Errorf("error decompressing cached state file [%s]: %w", filename, err)
+	}
+	var snapshot networkStateSnapshot
+	err = json.Unmarshal(decompressedBytes, &snapshot)
+	if err != nil {
+		return nil, false, fmt.Errorf("error deserializing cached state file [%s]: %w", filename, err)
+	}
+
+	// Don't trust states made by other versions of the Smartnode, since the layout may have changed
+	if snapshot.SmartnodeVersion != shared.RocketPoolVersion {
+		c.logLine("Cached state for slot %d was made with Smartnode v%s, discarding it.", slot, snapshot.SmartnodeVersion)
+		c.remove(filename)
+		return nil, false, nil
+	}
+	if snapshot.BeaconSlotNumber != slot {
+		return nil, false, fmt.Errorf("cached state file [%s] is for slot %d instead of %d", filename, snapshot.BeaconSlotNumber, slot)
+	}
+
+	return snapshot.toNetworkState(log), true, nil
+}
+
+// Save a network state to disk, pruning the oldest entries if the retention limit has been reached
+func (c *NetworkStateCache) Save(state *NetworkState) error {
+	stateCacheLock.Lock()
+	defer stateCacheLock.Unlock()
+
+	// Serialize and compress the state
+	serializedBytes, err := json.Marshal(newNetworkStateSnapshot(state))
+	if err != nil {
+		return fmt.Errorf("error serializing network state for slot %d: %w", state.BeaconSlotNumber, err)
+	}
+	compressedBytes := c.compressor.EncodeAll(serializedBytes, make([]byte, 0, len(serializedBytes)))
+	checksum := sha512.Sum384(compressedBytes)
+
+	// Write the checksum last so a partial write is never mistaken for a valid entry
+	filename := filepath.Join(c.path, fmt.Sprintf(stateCacheFilenameFormat, state.BeaconSlotNumber))
+	err = os.WriteFile(filename, compressedBytes, 0644)
+	if err != nil {
+		return fmt.Errorf("error writing cached state file [%s]: %w", filename, err)
+	}
+	err = os.WriteFile(filename+stateCacheChecksumSuffix, []byte(hex.EncodeToString(checksum[:])), 0644)
+	if err != nil {
+		return fmt.Errorf("error writing checksum for cached state file [%s]: %w", filename, err)
+	}
+
+	return c.prune()
+}
+
+// Remove the oldest cached states beyond the retention limit
+func (c *NetworkStateCache) prune() error {
+	entries, err := os.ReadDir(c.path)
+	if err != nil {
+		return fmt.Errorf("error reading network state cache folder [%s]: %w", c.path, err)
+	}
+
+	slots := []uint64{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		matches := c.filenameRegex.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			continue
+		}
+		slot, err := strconv.ParseUint(matches[c.filenameRegex.SubexpIndex("slot")], 10, 64)
+		if err != nil {
+			continue
+		}
+		slots = append(slots, slot)
+	}
+	if uint64(len(slots)) <= c.retentionLimit {
+		return nil
+	}
+
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i] < slots[j]
+	})
+	for _, slot := range slots[:uint64(len(slots))-c.retentionLimit] {
+		c.remove(filepath.Join(c.path, fmt.Sprintf(stateCacheFilenameFormat, slot)))
+	}
+	return nil
+}
+
+// Delete a cached state and its checksum
+func (c *NetworkStateCache) remove(filename string) {
+	for _, file := range []string{filename, filename + stateCacheChecksumSuffix} {
+		err := os.Remove(file)
+		if err != nil && !os.IsNotExist(err) {
+			c.logLine("WARNING: couldn't remove cached state file [%s]: %s", file, err.Error())
+		}
+	}
+}
+
+// Logs a line if the logger is specified
+func (c *NetworkStateCache) logLine(format string, v ...interface{}) {
+	if c.log != nil {
+		c.log.Printlnf(format, v...)
+	}
+}
+
+// Create the on-disk representation of a network state
+func newNetworkStateSnapshot(state *NetworkState) *networkStateSnapshot {
+	validatorDetails := make([]validatorDetailsEntry, 0, len(state.ValidatorDetails))
+	for pubkey, status := range state.ValidatorDetails {
+		validatorDetails = append(validatorDetails, validatorDetailsEntry{
+			Pubkey: pubkey,
+			Status: status,
+		})
+	}
+
+	return &networkStateSnapshot{
+		SmartnodeVersion:       shared.RocketPoolVersion,
+		ElBlockNumber:          state.ElBlockNumber,
+		BeaconSlotNumber:       state.BeaconSlotNumber,
+		BeaconConfig:           state.BeaconConfig,
+		NetworkDetails:         state.NetworkDetails,
+		NodeDetails:            state.NodeDetails,
+		MinipoolDetails:        state.MinipoolDetails,
+		ValidatorDetails:       validatorDetails,
+		OracleDaoMemberDetails: state.OracleDaoMemberDetails,
+	}
+}
+
+// Rebuild a network state and its lookups from its on-disk representation
+func (s *networkStateSnapshot) toNetworkState(log *log.ColorLogger) *NetworkState {
+	state := &NetworkState{
+		ElBlockNumber:            s.ElBlockNumber,
+		BeaconSlotNumber:         s.BeaconSlotNumber,
+		BeaconConfig:             s.BeaconConfig,
+		NetworkDetails:           s.NetworkDetails,
+		NodeDetails:              s.NodeDetails,
+		NodeDetailsByAddress:     map[common.Address]*rpstate.NativeNodeDetails{},
+		MinipoolDetails:          s.MinipoolDetails,
+		MinipoolDetailsByAddress: map[common.Address]*rpstate.NativeMinipoolDetails{},
+		MinipoolDetailsByNode:    map[common.Address][]*rpstate.NativeMinipoolDetails{},
+		ValidatorDetails:         map[types.ValidatorPubkey]beacon.ValidatorStatus{},
+		OracleDaoMemberDetails:   s.OracleDaoMemberDetails,
+		log:                      log,
+	}
+
+	for i, details := range state.NodeDetails {
+		state.NodeDetailsByAddress[details.NodeAddress] = &state.NodeDetails[i]
+	}
+	for i, details := range state.MinipoolDetails {
+		state.MinipoolDetailsByAddress[details.MinipoolAddress] = &state.MinipoolDetails[i]
+		state.MinipoolDetailsByNode[details.NodeAddress] = append(state.MinipoolDetailsByNode[details.NodeAddress], &state.MinipoolDetails[i])
+	}
+	for _, entry := range s.ValidatorDetails {
+		state.ValidatorDetails[entry.Pubkey] = entry.Status
+	}
+
+	return state
+}
@@ -24,6 +24,7 @@ type NetworkStateManager struct {
 	Network      cfgtypes.Network
 	ChainID      uint
 	BeaconConfig beacon.Eth2Config
+	cache        *NetworkStateCache
 }
 
 // Create a new manager for the network state
@@ -48,6 +49,15 @@ func NewNetworkStateManager(rp *rocketpool.RocketPool, cfg *config.RocketPoolCon
 		return nil, err
 	}
 
+	// Set up the on-disk cache for finalized states
+	retentionLimit := cfg.Smartnode.StateCacheRetentionLimit.Value.(uint64)
+	if retentionLimit > 0 {
+		m.cache, err = NewNetworkStateCache(cfg.Smartnode.GetStateCachePath(), retentionLimit, log)
+		if err != nil {
+			return nil, fmt.Errorf("error creating network state cache: %w", err)
+		}
+	}
+
 	return m, nil
 
 }
@@ -129,12 +139,39 @@ func (m *NetworkStateManager) GetLatestProposedBeaconBlock(targetSlot uint64) (b
 	}
 }
 
-// Get the state of the network at the provided Beacon slot
+// Get the state of the network at the provided Beacon slot, using the on-disk cache for finalized slots if it's enabled
 func (m *NetworkStateManager) getState(slotNumber uint64) (*NetworkState, error) {
+	// Only finalized states can be cached since anything newer could still be reorged
+	isFinalized := false
+	if m.cache != nil {
+		head, err := m.bc.GetBeaconHead()
+		if err != nil {
+			return nil, fmt.Errorf("error getting Beacon chain head: %w", err)
+		}
+		isFinalized = slotNumber < (head.FinalizedEpoch+1)*m.BeaconConfig.SlotsPerEpoch
+	}
+
+	if isFinalized {
+		state, exists, err := m.cache.Load(slotNumber, m.log)
+		if err != nil {
+			m.logLine("WARNING: couldn't load cached network state for slot %d, it will be regenerated: %s", slotNumber, err.Error())
+		} else if exists {
+			m.logLine("Loaded cached network state for slot %d.", slotNumber)
+			return state, nil
+		}
+	}
+
 	state, err := CreateNetworkState(m.cfg, m.rp, m.ec, m.bc, m.log, slotNumber, m.BeaconConfig)
 	if err != nil {
 		return nil, err
 	}
+
+	if isFinalized {
+		err = m.cache.Save(state)
+		if err != nil {
+			m.logLine("WARNING: couldn't save network state for slot %d to the cache: %s", slotNumber, err.Error())
+		}
+	}
 	return state, nil
 }
 
@@ -150,6 +187,6 @@ func (m *NetworkStateManager) getStateForNode(nodeAddress common.Address, slotNu
 // Logs a line if the logger is specified
 func (m *NetworkStateManager) logLine(format string, v ...interface{}) {
 	if m.log != nil {
-		m.log.Printlnf(format, v)
+		m.log.Printlnf(format, v...)
 	}
 }
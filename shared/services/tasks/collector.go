@@ -0,0 +1,108 @@
+package tasks
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "rocketpool"
+
+// Represents the collector for a daemon's task metrics
+type TaskCollector struct {
+	// The time each task last started
+	lastRunTime *prometheus.Desc
+
+	// How long each task's last run took
+	lastDuration *prometheus.Desc
+
+	// The time each task last finished without an error
+	lastSuccessTime *prometheus.Desc
+
+	// The time each task last failed
+	lastErrorTime *prometheus.Desc
+
+	// The number of times in a row each task has failed
+	consecutiveFailures *prometheus.Desc
+
+	// Whether each task is currently running
+	running *prometheus.Desc
+
+	// The task runner
+	runner *Runner
+}
+
+// Create a new TaskCollector instance
+func NewTaskCollector(runner *Runner) *TaskCollector {
+	subsystem := "task"
+	labels := []string{"daemon", "task"}
+	return &TaskCollector{
+		lastRunTime: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_run_timestamp"),
+			"The time the task last started",
+			labels, nil,
+		),
+		lastDuration: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_duration_seconds"),
+			"How long the task's last run took",
+			labels, nil,
+		),
+		lastSuccessTime: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_success_timestamp"),
+			"The time the task last finished without an error",
+			labels, nil,
+		),
+		lastErrorTime: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_error_timestamp"),
+			"The time the task last failed",
+			labels, nil,
+		),
+		consecutiveFailures: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "consecutive_failures"),
+			"The number of times in a row the task has failed",
+			labels, nil,
+		),
+		running: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "running"),
+			"1 if the task is currently running, 0 if not",
+			labels, nil,
+		),
+		runner: runner,
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *TaskCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.lastRunTime
+	channel <- collector.lastDuration
+	channel <- collector.lastSuccessTime
+	channel <- collector.lastErrorTime
+	channel <- collector.consecutiveFailures
+	channel <- collector.running
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *TaskCollector) Collect(channel chan<- prometheus.Metric) {
+	status := collector.runner.GetStatus()
+	for _, task := range status.Tasks {
+		running := float64(0)
+		if task.Running {
+			running = 1
+		}
+
+		channel <- prometheus.MustNewConstMetric(
+			collector.lastRunTime, prometheus.GaugeValue, getTimestamp(task.LastRunTime), status.Daemon, task.Name)
+		channel <- prometheus.MustNewConstMetric(
+			collector.lastDuration, prometheus.GaugeValue, task.LastDuration.Seconds(), status.Daemon, task.Name)
+		channel <- prometheus.MustNewConstMetric(
+			collector.lastSuccessTime, prometheus.GaugeValue, getTimestamp(task.LastSuccessTime), status.Daemon, task.Name)
+		channel <- prometheus.MustNewConstMetric(
+			collector.lastErrorTime, prometheus.GaugeValue, getTimestamp(task.LastErrorTime), status.Daemon, task.Name)
+		channel <- prometheus.MustNewConstMetric(
+			collector.consecutiveFailures, prometheus.GaugeValue, float64(task.ConsecutiveFailures), status.Daemon, task.Name)
+		channel <- prometheus.MustNewConstMetric(
+			collector.running, prometheus.GaugeValue, running, status.Daemon, task.Name)
+	}
+}
+
+// Get the Unix timestamp of a time, or 0 if it hasn't been set
+func getTimestamp(t time.Time) float64 {
+	if t.IsZero() {
+		return 0
+	}
+	return float64(t.Unix())
+}
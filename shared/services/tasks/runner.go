@@ -0,0 +1,442 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Defaults for tasks that don't specify their own settings
+var DefaultTimeout, _ = time.ParseDuration("1h")
+var DefaultMaxBackoff, _ = time.ParseDuration("6h")
+var eventStreamReconnectDelay, _ = time.ParseDuration("30s")
+
+const maxBackoffShift uint64 = 16
+
+// Provides the network state for tasks that need it.
+// It's only called if at least one of the due tasks needs the state.
+type StateProvider func() (*state.NetworkState, error)
+
+// A daemon task and the rules for when it should run
+type Task struct {
+	// The name of the task, used in logs, metrics and the status file
+	Name string
+
+	// How long to wait after a successful run before running again
+	Interval time.Duration
+
+	// A random delay of up to this long is added to the interval, so daemons on different machines don't all run at once
+	Jitter time.Duration
+
+	// How long a run can take before it's reported as timed out; the runner still waits for it to finish
+	Timeout time.Duration
+
+	// The longest the task will wait before retrying after consecutive failures
+	MaxBackoff time.Duration
+
+	// True if the task needs a fresh network state
+	NeedsState bool
+
+	// Beacon node events that should run the task immediately instead of waiting for its interval
+	Events []beacon.EventTopic
+
+	// If set, the task is skipped while this returns false
+	Condition func() bool
+
+	// The task body; the state is nil if NeedsState is false
+	Run func(state *state.NetworkState) error
+}
+
+// A registered task and its run history
+type taskRecord struct {
+	task   Task
+	status api.TaskStatus
+}
+
+// Runs a daemon's tasks on their own intervals, backing off when they fail and recording the outcome of each run
+type Runner struct {
+	daemon     string
+	statusPath string
+	log        *log.ColorLogger
+	cooldown   time.Duration
+	tasks      []*taskRecord
+	lock       sync.Mutex
+
+	pendingEvents map[beacon.EventTopic]bool
+	signal        chan struct{}
+}
+
+// Create a new task runner; the status of each task is saved to statusPath after it runs
+func NewRunner(daemon string, statusPath string, logger *log.ColorLogger, cooldown time.Duration) *Runner {
+	return &Runner{
+		daemon:        daemon,
+		statusPath:    statusPath,
+		log:           logger,
+		cooldown:      cooldown,
+		tasks:         []*taskRecord{},
+		pendingEvents: map[beacon.EventTopic]bool{},
+		signal:        make(chan struct{}, 1),
+	}
+}
+
+// Register a task; tasks run in the order they were registered, and each one is due immediately
+func (r *Runner) Register(task Task) {
+	if task.Timeout == 0 {
+		task.Timeout = DefaultTimeout
+	}
+	if task.MaxBackoff == 0 {
+		task.MaxBackoff = DefaultMaxBackoff
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.tasks = append(r.tasks, &taskRecord{
+		task: task,
+		status: api.TaskStatus{
+			Name:     task.Name,
+			Interval: task.Interval,
+		},
+	})
+}
+
+// Subscribe to the Beacon node events that the registered tasks care about, reconnecting whenever the stream drops
+func (r *Runner) ListenForEvents(ctx context.Context, bc beacon.Client) {
+	topics := r.getTopics()
+	if len(topics) == 0 {
+		return
+	}
+
+	go func() {
+		for {
+			events, err := bc.SubscribeToEvents(ctx, topics)
+			if err != nil {
+				r.log.Printlnf("WARNING: couldn't subscribe to Beacon node events (%s), relying on task intervals.", err.Error())
+			} else {
+				for event := range events {
+					r.handleEvent(event)
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(eventStreamReconnectDelay):
+			}
+		}
+	}()
+}
+
+// Wait until a task is due or an event arrives, and return the events that arrived
+func (r *Runner) Wait() map[beacon.EventTopic]bool {
+	timer := time.NewTimer(r.timeUntilNextRun())
+	defer timer.Stop()
+
+	select {
+	case <-r.signal:
+	case <-timer.C:
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	events := r.pendingEvents
+	r.pendingEvents = map[beacon.EventTopic]bool{}
+	return events
+}
+
+// Put events back in the queue so their tasks run on the next round (e.g. if the clients weren't ready)
+func (r *Runner) Requeue(events map[beacon.EventTopic]bool) {
+	if len(events) == 0 {
+		return
+	}
+	r.lock.Lock()
+	for topic := range events {
+		r.pendingEvents[topic] = true
+	}
+	r.lock.Unlock()
+	r.notify()
+}
+
+// Run every task that is due, either because its interval has passed or because one of its events arrived.
+// The state is only retrieved if a due task needs it; if that fails, the tasks needing it are skipped and the error is returned.
+func (r *Runner) RunDue(events map[beacon.EventTopic]bool, getState StateProvider) error {
+	due := r.getDueTasks(events)
+
+	var networkState *state.NetworkState
+	var stateErr error
+	stateLoaded := false
+	ranTask := false
+	for _, record := range due {
+		var taskState *state.NetworkState
+		if record.task.NeedsState {
+			if !stateLoaded {
+				networkState, stateErr = getState()
+				stateLoaded = true
+			}
+			if stateErr != nil {
+				continue
+			}
+			taskState = networkState
+		}
+
+		if ranTask {
+			time.Sleep(r.cooldown)
+		}
+		r.runTask(record, taskState)
+		ranTask = true
+	}
+
+	if stateErr != nil {
+		return fmt.Errorf("error getting network state for tasks: %w", stateErr)
+	}
+	return nil
+}
+
+// Get the status of every registered task
+func (r *Runner) GetStatus() api.DaemonTaskStatus {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	status := api.DaemonTaskStatus{
+		Daemon:      r.daemon,
+		UpdatedTime: time.Now(),
+		Tasks:       make([]api.TaskStatus, len(r.tasks)),
+	}
+	for i, record := range r.tasks {
+		status.Tasks[i] = record.status
+	}
+	return status
+}
+
+// Run a single task and record the result.
+// A task that runs past its timeout is reported, but the runner keeps waiting for it so two tasks never run at once.
+func (r *Runner) runTask(record *taskRecord, taskState *state.NetworkState) {
+	r.lock.Lock()
+	record.status.Running = true
+	record.status.LastRunTime = time.Now()
+	r.lock.Unlock()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		err := record.task.Run(taskState)
+		r.finishTask(record, start, err)
+		done <- err
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-time.After(record.task.Timeout):
+		r.lock.Lock()
+		record.status.LastError = fmt.Sprintf("timed out after %s", record.task.Timeout)
+		record.status.LastErrorTime = time.Now()
+		r.lock.Unlock()
+		r.log.Printlnf("WARNING: task %s has been running for more than %s, waiting for it to finish.", record.task.Name, record.task.Timeout)
+		r.saveStatus()
+		err = <-done
+	}
+	if err != nil {
+		r.log.Printlnf("Error running %s task: %s", record.task.Name, err.Error())
+	}
+}
+
+// Record the result of a task run and schedule its next one
+func (r *Runner) finishTask(record *taskRecord, start time.Time, err error) {
+	r.lock.Lock()
+	now := time.Now()
+	record.status.Running = false
+	record.status.LastDuration = now.Sub(start)
+	if err == nil {
+		record.status.LastSuccessTime = now
+		record.status.ConsecutiveFailures = 0
+		record.status.NextRunTime = now.Add(record.task.Interval + getJitter(record.task.Jitter))
+	} else {
+		record.status.LastError = err.Error()
+		record.status.LastErrorTime = now
+		record.status.ConsecutiveFailures++
+		record.status.NextRunTime = now.Add(getBackoff(record.task, record.status.ConsecutiveFailures))
+	}
+	r.lock.Unlock()
+	r.saveStatus()
+}
+
+// Get the tasks that should run now
+func (r *Runner) getDueTasks(events map[beacon.EventTopic]bool) []*taskRecord {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := time.Now()
+	due := []*taskRecord{}
+	for _, record := range r.tasks {
+		if record.status.Running {
+			continue
+		}
+		if record.task.Condition != nil && !record.task.Condition() {
+			continue
+		}
+
+		// Events can bring a task forward, but not while it's backing off after a failure
+		if !now.Before(record.status.NextRunTime) {
+			due = append(due, record)
+		} else if record.status.ConsecutiveFailures == 0 {
+			for _, topic := range record.task.Events {
+				if events[topic] {
+					due = append(due, record)
+					break
+				}
+			}
+		}
+	}
+	return due
+}
+
+// Get the time until the next task is due
+func (r *Runner) timeUntilNextRun() time.Duration {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var next time.Time
+	var shortestInterval time.Duration
+	for _, record := range r.tasks {
+		if shortestInterval == 0 || record.task.Interval < shortestInterval {
+			shortestInterval = record.task.Interval
+		}
+		if record.status.Running {
+			continue
+		}
+		if record.task.Condition != nil && !record.task.Condition() {
+			continue
+		}
+		if next.IsZero() || record.status.NextRunTime.Before(next) {
+			next = record.status.NextRunTime
+		}
+	}
+	if next.IsZero() {
+		// Nothing can run right now, so check again after the shortest interval
+		if shortestInterval < r.cooldown {
+			return r.cooldown
+		}
+		return shortestInterval
+	}
+
+	// Wait at least the cooldown so tasks that are skipped by their condition don't cause a busy loop
+	wait := time.Until(next)
+	if wait < r.cooldown {
+		wait = r.cooldown
+	}
+	return wait
+}
+
+// Queue the topic of an event if any task cares about it
+func (r *Runner) handleEvent(event beacon.BeaconEvent) {
+	// Head events arrive every slot, so only the first one of each epoch triggers tasks
+	if event.Topic == beacon.EventTopic_Head && !event.EpochTransition {
+		return
+	}
+	if event.Topic == beacon.EventTopic_ChainReorg {
+		r.log.Printlnf("Beacon chain reorg of depth %d detected at slot %d.", event.Depth, event.Slot)
+	}
+
+	r.lock.Lock()
+	r.pendingEvents[event.Topic] = true
+	r.lock.Unlock()
+	r.notify()
+}
+
+// Wake up Wait() without blocking
+func (r *Runner) notify() {
+	select {
+	case r.signal <- struct{}{}:
+	default:
+	}
+}
+
+// Get the unique set of topics the registered tasks care about
+func (r *Runner) getTopics() []beacon.EventTopic {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	seen := map[beacon.EventTopic]bool{}
+	topics := []beacon.EventTopic{}
+	for _, record := range r.tasks {
+		for _, topic := range record.task.Events {
+			if !seen[topic] {
+				seen[topic] = true
+				topics = append(topics, topic)
+			}
+		}
+	}
+	return topics
+}
+
+// Save the status of every task to disk so the API can report it
+func (r *Runner) saveStatus() {
+	if r.statusPath == "" {
+		return
+	}
+
+	bytes, err := json.Marshal(r.GetStatus())
+	if err != nil {
+		r.log.Printlnf("WARNING: couldn't serialize task status: %s", err.Error())
+		return
+	}
+	err = os.MkdirAll(filepath.Dir(r.statusPath), 0755)
+	if err != nil {
+		r.log.Printlnf("WARNING: couldn't create task status folder: %s", err.Error())
+		return
+	}
+	err = os.WriteFile(r.statusPath, bytes, 0644)
+	if err != nil {
+		r.log.Printlnf("WARNING: couldn't save task status to %s: %s", r.statusPath, err.Error())
+	}
+}
+
+// Get the delay before retrying a task that failed the provided number of times in a row
+func getBackoff(task Task, failures uint64) time.Duration {
+	shift := failures - 1
+	if shift > maxBackoffShift {
+		shift = maxBackoffShift
+	}
+	backoff := task.Interval << shift
+	if backoff <= 0 || backoff > task.MaxBackoff {
+		backoff = task.MaxBackoff
+	}
+	return backoff
+}
+
+// Get a random delay of up to the provided duration
+func getJitter(jitter time.Duration) time.Duration {
+	if jitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(jitter)))
+}
+
+// Load the task status that a daemon saved to disk
+func LoadStatus(path string) (api.DaemonTaskStatus, bool, error) {
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return api.DaemonTaskStatus{}, false, nil
+	}
+	if err != nil {
+		return api.DaemonTaskStatus{}, false, fmt.Errorf("error reading task status file %s: %w", path, err)
+	}
+
+	var status api.DaemonTaskStatus
+	err = json.Unmarshal(bytes, &status)
+	if err != nil {
+		return api.DaemonTaskStatus{}, false, fmt.Errorf("error deserializing task status file %s: %w", path, err)
+	}
+	return status, true, nil
+}
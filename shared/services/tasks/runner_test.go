@@ -0,0 +1,133 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+const testBlock = "0x9a2fefd2fdb57f74993c7780ea5b9030d2897b615b89f808011ca5aebed54eaf"
+
+// Serve a fixed set of server-sent events, then hold the stream open like a Beacon node would
+func newEventServer(stream string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, stream)
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+		<-r.Context().Done()
+	}))
+}
+
+func TestBeaconEventsTriggerTasks(t *testing.T) {
+	// A mid-epoch head event shouldn't trigger anything, but the finalized checkpoint should
+	stream := "event: head\n" +
+		"data: {\"slot\":\"65\",\"block\":\"" + testBlock + "\",\"epoch_transition\":false}\n\n" +
+		"event: finalized_checkpoint\n" +
+		"data: {\"block\":\"" + testBlock + "\",\"epoch\":\"2\"}\n\n"
+	server := newEventServer(stream)
+	defer server.Close()
+
+	logger := log.NewColorLogger(color.FgWhite)
+	runner := NewRunner("test", "", &logger, time.Millisecond)
+	runs := 0
+	runner.Register(Task{
+		Name:     "finalized",
+		Interval: time.Hour,
+		Events:   []beacon.EventTopic{beacon.EventTopic_FinalizedCheckpoint},
+		Run: func(state *state.NetworkState) error {
+			runs++
+			return nil
+		},
+	})
+	getState := func() (*state.NetworkState, error) {
+		t.Fatal("the state shouldn't be needed")
+		return nil, nil
+	}
+
+	// Tasks are due as soon as they're registered
+	if err := runner.RunDue(map[beacon.EventTopic]bool{}, getState); err != nil {
+		t.Fatalf("error running tasks: %s", err.Error())
+	}
+	if runs != 1 {
+		t.Fatalf("expected the task to run once on startup, it ran %d times", runs)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	runner.ListenForEvents(ctx, client.NewStandardHttpClient(server.URL))
+
+	// The task's interval is an hour away, so only the event can wake the runner up in time
+	events := make(chan map[beacon.EventTopic]bool, 1)
+	go func() {
+		events <- runner.Wait()
+	}()
+	var received map[beacon.EventTopic]bool
+	select {
+	case received = <-events:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the Beacon node event")
+	}
+	if !received[beacon.EventTopic_FinalizedCheckpoint] || received[beacon.EventTopic_Head] {
+		t.Fatalf("unexpected events %v", received)
+	}
+
+	if err := runner.RunDue(received, getState); err != nil {
+		t.Fatalf("error running tasks: %s", err.Error())
+	}
+	if runs != 2 {
+		t.Fatalf("expected the event to run the task again, it ran %d times", runs)
+	}
+}
+
+func TestTimedOutTaskBlocksTheNextOne(t *testing.T) {
+	logger := log.NewColorLogger(color.FgWhite)
+	runner := NewRunner("test", "", &logger, time.Millisecond)
+
+	release := make(chan struct{})
+	slowFinished := false
+	runner.Register(Task{
+		Name:     "slow",
+		Interval: time.Hour,
+		Timeout:  10 * time.Millisecond,
+		Run: func(state *state.NetworkState) error {
+			<-release
+			slowFinished = true
+			return nil
+		},
+	})
+	runner.Register(Task{
+		Name:     "next",
+		Interval: time.Hour,
+		Run: func(state *state.NetworkState) error {
+			if !slowFinished {
+				t.Error("the next task started while the timed out one was still running")
+			}
+			return nil
+		},
+	})
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+	}()
+	if err := runner.RunDue(map[beacon.EventTopic]bool{}, nil); err != nil {
+		t.Fatalf("error running tasks: %s", err.Error())
+	}
+
+	status := runner.GetStatus()
+	if status.Tasks[0].LastError == "" {
+		t.Error("expected the slow task's timeout to be recorded")
+	}
+}
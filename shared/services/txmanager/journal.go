@@ -0,0 +1,132 @@
+package txmanager
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/goccy/go-json"
+)
+
+// A node transaction that has been sent but not mined yet.
+// Replacements share the nonce of the original, so only the newest version is kept in full.
+// An entry without any hashes is a nonce that was handed out for a transaction that hasn't been sent yet.
+type JournalEntry struct {
+	Nonce          uint64        `json:"nonce"`
+	Hashes         []common.Hash `json:"hashes"`
+	RawTransaction hexutil.Bytes `json:"rawTransaction"`
+	FirstSent      time.Time     `json:"firstSent"`
+	LastSent       time.Time     `json:"lastSent"`
+	IsCancellation bool          `json:"isCancellation"`
+}
+
+// The transactions the node account has in flight
+type journal struct {
+	Address      common.Address  `json:"address"`
+	Transactions []*JournalEntry `json:"transactions"`
+}
+
+// Get the newest version of the transaction
+func (e *JournalEntry) GetTransaction() (*types.Transaction, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(e.RawTransaction); err != nil {
+		return nil, fmt.Errorf("error decoding transaction with nonce %d: %w", e.Nonce, err)
+	}
+	return tx, nil
+}
+
+// Check if the entry only reserves its nonce for a transaction that hasn't been sent yet
+func (e *JournalEntry) IsReservation() bool {
+	return len(e.Hashes) == 0
+}
+
+// Get the hash of the newest version of the transaction
+func (e *JournalEntry) GetHash() common.Hash {
+	return e.Hashes[len(e.Hashes)-1]
+}
+
+// Get the number of times the transaction has been replaced
+func (e *JournalEntry) GetReplacementCount() uint64 {
+	return uint64(len(e.Hashes) - 1)
+}
+
+// Load the journal from disk; a missing file or one for a different node account is treated as empty
+func loadJournal(path string, address common.Address) (*journal, error) {
+	j := &journal{
+		Address:      address,
+		Transactions: []*JournalEntry{},
+	}
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return j, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading transaction journal: %w", err)
+	}
+
+	var loaded journal
+	if err := json.Unmarshal(bytes, &loaded); err != nil {
+		return nil, fmt.Errorf("error decoding transaction journal: %w", err)
+	}
+	if loaded.Address != address {
+		return j, nil
+	}
+	if loaded.Transactions != nil {
+		j.Transactions = loaded.Transactions
+	}
+	return j, nil
+}
+
+// Save the journal to disk, replacing the old one in a single step so a crash can't leave it half-written
+func (j *journal) save(path string) error {
+	sort.Slice(j.Transactions, func(a, b int) bool {
+		return j.Transactions[a].Nonce < j.Transactions[b].Nonce
+	})
+	bytes, err := json.Marshal(j)
+	if err != nil {
+		return fmt.Errorf("error encoding transaction journal: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("error creating transaction journal folder: %w", err)
+	}
+	tempPath := path + ".tmp"
+	if err := os.WriteFile(tempPath, bytes, 0600); err != nil {
+		return fmt.Errorf("error writing transaction journal: %w", err)
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		return fmt.Errorf("error replacing transaction journal: %w", err)
+	}
+	return nil
+}
+
+// Get the entry for a nonce, if there is one
+func (j *journal) getEntry(nonce uint64) *JournalEntry {
+	for _, entry := range j.Transactions {
+		if entry.Nonce == nonce {
+			return entry
+		}
+	}
+	return nil
+}
+
+// Remove every entry below the given nonce, since those have been mined, and every reservation that was never sent
+func (j *journal) prune(latestNonce uint64) bool {
+	pending := []*JournalEntry{}
+	for _, entry := range j.Transactions {
+		if entry.Nonce < latestNonce {
+			continue
+		}
+		if entry.IsReservation() && time.Since(entry.FirstSent) > nonceReservationTimeout {
+			continue
+		}
+		pending = append(pending, entry)
+	}
+	pruned := len(pending) != len(j.Transactions)
+	j.Transactions = pending
+	return pruned
+}
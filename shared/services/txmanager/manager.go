@@ -0,0 +1,424 @@
+package txmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Settings
+const (
+	// Replacements have to raise both fees by at least 10% for the mempool to accept them; this bumps them by 12.5%
+	feeBumpNumerator   int64 = 9
+	feeBumpDenominator int64 = 8
+
+	// The gas limit of a plain ETH transfer, used for cancellations
+	CancelGasLimit uint64 = 21000
+
+	// How long a nonce handed out for a new transaction stays reserved; if the transaction isn't sent by then, the nonce is reused
+	nonceReservationTimeout time.Duration = 2 * time.Minute
+)
+
+// Keeps track of the node account's transactions until they're mined.
+// Every transaction the node sends is recorded in a journal on disk, so nonces stay consistent across restarts and Execution client switches,
+// and transactions that get stuck can be replaced with higher fees.
+type TransactionManager struct {
+	cfg         *config.RocketPoolConfig
+	ec          rocketpool.ExecutionClient
+	w           *wallet.Wallet
+	journalPath string
+
+	// Serializes access to the journal within this process; lockJournal() does the same across processes
+	lock sync.Mutex
+}
+
+// Create a new transaction manager
+func NewTransactionManager(cfg *config.RocketPoolConfig, ec rocketpool.ExecutionClient, w *wallet.Wallet) *TransactionManager {
+	return &TransactionManager{
+		cfg:         cfg,
+		ec:          ec,
+		w:           w,
+		journalPath: os.ExpandEnv(cfg.Smartnode.GetTransactionJournalPath()),
+	}
+}
+
+// Use the journal to pick the nonces for all of the node's new transactions, instead of leaving it to the Execution client
+func (m *TransactionManager) ManageNonces() {
+	m.w.SetNonceProvider(m.GetNextNonce)
+}
+
+// Record a transaction that was just sent; transactions that weren't sent by the node account are ignored
+func (m *TransactionManager) Track(tx *types.Transaction) error {
+	return m.record(tx, false)
+}
+
+// Get the nonce of the node account's latest mined transaction (plus one) and the nonce the Execution client would give its next transaction
+func (m *TransactionManager) GetNonces() (uint64, uint64, error) {
+	address, err := m.getNodeAddress()
+	if err != nil {
+		return 0, 0, err
+	}
+	latestNonce, err := m.ec.NonceAt(context.Background(), address, nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error getting latest nonce for node account: %w", err)
+	}
+	pendingNonce, err := m.ec.PendingNonceAt(context.Background(), address)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error getting pending nonce for node account: %w", err)
+	}
+	return latestNonce, pendingNonce, nil
+}
+
+// Get the nonce for the node's next transaction and reserve it in the journal, so concurrent senders can't get the same one.
+// This is the lowest nonce from the Execution client's pending nonce on that isn't already taken by a journaled transaction
+// the client doesn't know about, or by another reservation.
+func (m *TransactionManager) GetNextNonce() (uint64, error) {
+	address, err := m.getNodeAddress()
+	if err != nil {
+		return 0, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	unlock, err := lockJournal(m.journalPath)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	// Get the nonces while holding the lock, so a transaction sent in the meantime can't be missed
+	latestNonce, pendingNonce, err := m.GetNonces()
+	if err != nil {
+		return 0, err
+	}
+	j, err := loadJournal(m.journalPath, address)
+	if err != nil {
+		return 0, err
+	}
+	j.prune(latestNonce)
+
+	nonce := pendingNonce
+	for j.getEntry(nonce) != nil {
+		nonce++
+	}
+	j.Transactions = append(j.Transactions, &JournalEntry{
+		Nonce:     nonce,
+		Hashes:    []common.Hash{},
+		FirstSent: time.Now(),
+	})
+	if err := j.save(m.journalPath); err != nil {
+		return 0, err
+	}
+	return nonce, nil
+}
+
+// Get the node's journaled transactions that haven't been mined yet, ordered by nonce
+func (m *TransactionManager) GetPendingTransactions() ([]*JournalEntry, error) {
+	latestNonce, _, err := m.GetNonces()
+	if err != nil {
+		return nil, err
+	}
+	j, err := m.loadPendingJournal(latestNonce)
+	if err != nil {
+		return nil, err
+	}
+	entries := []*JournalEntry{}
+	for _, entry := range j.Transactions {
+		if !entry.IsReservation() {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// Check on the node's pending transactions: resend any that the Execution client has dropped, and replace any that have been pending for too long
+func (m *TransactionManager) CheckPendingTransactions(logger *log.ColorLogger) error {
+
+	entries, err := m.GetPendingTransactions()
+	if err != nil {
+		return err
+	}
+
+	timeout := time.Duration(m.cfg.Smartnode.StuckTxTimeout.Value.(uint64)) * time.Minute
+	maxFeeGwei := m.cfg.Smartnode.StuckTxMaxFee.Value.(float64)
+	for _, entry := range entries {
+		tx, err := entry.GetTransaction()
+		if err != nil {
+			return err
+		}
+
+		// Resend the transaction if the client doesn't know about it anymore, which happens when it restarts or requests move to another client
+		_, _, err = m.ec.TransactionByHash(context.Background(), tx.Hash())
+		if errors.Is(err, ethereum.NotFound) {
+			logger.Printlnf("Transaction %s (nonce %d) isn't known to the Execution client, sending it again...", tx.Hash().Hex(), entry.Nonce)
+			if err := m.ec.SendTransaction(context.Background(), tx); err != nil {
+				logger.Printlnf("WARNING: couldn't resend transaction %s: %s", tx.Hash().Hex(), err.Error())
+			}
+		} else if err != nil {
+			return fmt.Errorf("error checking transaction %s: %w", tx.Hash().Hex(), err)
+		}
+
+		// Replace it if it's stuck
+		if timeout == 0 || time.Since(entry.LastSent) < timeout {
+			continue
+		}
+		gasFeeCap, gasTipCap, err := m.getReplacementFees(tx.GasFeeCap(), tx.GasTipCap())
+		if err != nil {
+			return err
+		}
+		if maxFeeGwei > 0 && gasFeeCap.Cmp(eth.GweiToWei(maxFeeGwei)) > 0 {
+			logger.Printlnf("Transaction %s (nonce %d) has been pending since %s, but replacing it would need a max fee of %.2f gwei which is above the limit of %.2f gwei. Leaving it alone.",
+				tx.Hash().Hex(), entry.Nonce, entry.LastSent.Format(time.RFC822), eth.WeiToGwei(gasFeeCap), maxFeeGwei)
+			continue
+		}
+		replacement, err := m.sendReplacement(entry.Nonce, tx.To(), tx.Value(), tx.Data(), tx.Gas(), gasFeeCap, gasTipCap, entry.IsCancellation)
+		if err != nil {
+			logger.Printlnf("WARNING: couldn't replace stuck transaction %s (nonce %d): %s", tx.Hash().Hex(), entry.Nonce, err.Error())
+			continue
+		}
+		logger.Printlnf("Transaction %s (nonce %d) has been pending since %s; replaced it with %s (max fee %.2f gwei, priority fee %.2f gwei).",
+			tx.Hash().Hex(), entry.Nonce, entry.LastSent.Format(time.RFC822), replacement.Hash().Hex(), eth.WeiToGwei(gasFeeCap), eth.WeiToGwei(gasTipCap))
+	}
+
+	return nil
+
+}
+
+// Get the max fee and priority fee needed to cancel the pending transaction with the given nonce
+func (m *TransactionManager) GetCancellationFees(nonce uint64) (*big.Int, *big.Int, error) {
+	latestNonce, pendingNonce, err := m.GetNonces()
+	if err != nil {
+		return nil, nil, err
+	}
+	if nonce < latestNonce {
+		return nil, nil, fmt.Errorf("the transaction with nonce %d has already been mined", nonce)
+	}
+	j, err := m.loadPendingJournal(latestNonce)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Outbid the journaled version if there is one; otherwise the Execution client has to know about the transaction
+	entry := j.getEntry(nonce)
+	if entry == nil || entry.IsReservation() {
+		if nonce >= pendingNonce {
+			return nil, nil, fmt.Errorf("there is no pending transaction with nonce %d", nonce)
+		}
+		return m.getReplacementFees(nil, nil)
+	}
+	tx, err := entry.GetTransaction()
+	if err != nil {
+		return nil, nil, err
+	}
+	return m.getReplacementFees(tx.GasFeeCap(), tx.GasTipCap())
+}
+
+// Cancel the pending transaction with the given nonce by replacing it with an empty transfer to the node account
+func (m *TransactionManager) CancelTransaction(nonce uint64) (*types.Transaction, error) {
+	address, err := m.getNodeAddress()
+	if err != nil {
+		return nil, err
+	}
+	gasFeeCap, gasTipCap, err := m.GetCancellationFees(nonce)
+	if err != nil {
+		return nil, err
+	}
+	return m.sendReplacement(nonce, &address, big.NewInt(0), nil, CancelGasLimit, gasFeeCap, gasTipCap, true)
+}
+
+// Get the fees for a replacement transaction: enough above the old ones for the mempool to accept it, and enough to get into a block soon
+func (m *TransactionManager) getReplacementFees(oldGasFeeCap *big.Int, oldGasTipCap *big.Int) (*big.Int, *big.Int, error) {
+	suggestedTip, err := m.ec.SuggestGasTipCap(context.Background())
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting suggested priority fee: %w", err)
+	}
+	header, err := m.ec.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting latest block header: %w", err)
+	}
+
+	configuredTip := eth.GweiToWei(m.cfg.Smartnode.PriorityFee.Value.(float64))
+	gasTipCap := maxBig(bumpFee(oldGasTipCap), suggestedTip, configuredTip)
+
+	// Leave room for the base fee to double
+	gasFeeCap := big.NewInt(0)
+	if header.BaseFee != nil {
+		gasFeeCap.Mul(header.BaseFee, big.NewInt(2))
+	}
+	gasFeeCap.Add(gasFeeCap, gasTipCap)
+	gasFeeCap = maxBig(bumpFee(oldGasFeeCap), gasFeeCap)
+	return gasFeeCap, gasTipCap, nil
+}
+
+// Sign and send a transaction that replaces the one with the given nonce
+func (m *TransactionManager) sendReplacement(nonce uint64, to *common.Address, value *big.Int, data []byte, gasLimit uint64, gasFeeCap *big.Int, gasTipCap *big.Int, isCancellation bool) (*types.Transaction, error) {
+
+	// Sign it
+	unsignedTx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(int64(m.cfg.Smartnode.GetChainID())),
+		Nonce:     nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       gasLimit,
+		To:        to,
+		Value:     value,
+		Data:      data,
+	})
+	serializedTx, err := unsignedTx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("error serializing replacement transaction: %w", err)
+	}
+	signedBytes, err := m.w.Sign(serializedTx)
+	if err != nil {
+		return nil, err
+	}
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(signedBytes); err != nil {
+		return nil, fmt.Errorf("error decoding signed replacement transaction: %w", err)
+	}
+
+	// Send it
+	if err := m.ec.SendTransaction(context.Background(), signedTx); err != nil {
+		return nil, fmt.Errorf("error sending replacement transaction: %w", err)
+	}
+	if err := m.record(signedTx, isCancellation); err != nil {
+		return nil, err
+	}
+	return signedTx, nil
+
+}
+
+// Add a transaction to the journal
+func (m *TransactionManager) record(tx *types.Transaction, isCancellation bool) error {
+
+	// Only keep track of the node account's transactions
+	address, err := m.getNodeAddress()
+	if err != nil {
+		return err
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil || sender != address {
+		return nil
+	}
+	rawTx, err := tx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("error serializing transaction %s: %w", tx.Hash().Hex(), err)
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	unlock, err := lockJournal(m.journalPath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// Reload it in case another process has changed it
+	j, err := loadJournal(m.journalPath, address)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	entry := j.getEntry(tx.Nonce())
+	if entry == nil {
+		entry = &JournalEntry{
+			Nonce:     tx.Nonce(),
+			Hashes:    []common.Hash{},
+			FirstSent: now,
+		}
+		j.Transactions = append(j.Transactions, entry)
+	}
+	if entry.IsReservation() {
+		entry.FirstSent = now
+	}
+	if !containsHash(entry.Hashes, tx.Hash()) {
+		entry.Hashes = append(entry.Hashes, tx.Hash())
+		entry.RawTransaction = rawTx
+		entry.LastSent = now
+	}
+	entry.IsCancellation = entry.IsCancellation || isCancellation
+	return j.save(m.journalPath)
+
+}
+
+// Load the journal, removing the transactions that have been mined
+func (m *TransactionManager) loadPendingJournal(latestNonce uint64) (*journal, error) {
+	address, err := m.getNodeAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	unlock, err := lockJournal(m.journalPath)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	j, err := loadJournal(m.journalPath, address)
+	if err != nil {
+		return nil, err
+	}
+	if j.prune(latestNonce) {
+		if err := j.save(m.journalPath); err != nil {
+			return nil, err
+		}
+	}
+	return j, nil
+}
+
+// Get the node account's address
+func (m *TransactionManager) getNodeAddress() (common.Address, error) {
+	account, err := m.w.GetNodeAccount()
+	if err != nil {
+		return common.Address{}, fmt.Errorf("error getting node account: %w", err)
+	}
+	return account.Address, nil
+}
+
+// Raise a fee by the replacement bump, rounding up
+func bumpFee(fee *big.Int) *big.Int {
+	if fee == nil {
+		return big.NewInt(0)
+	}
+	bumped := new(big.Int).Mul(fee, big.NewInt(feeBumpNumerator))
+	bumped.Add(bumped, big.NewInt(feeBumpDenominator-1))
+	return bumped.Div(bumped, big.NewInt(feeBumpDenominator))
+}
+
+// Get the largest of the given values
+func maxBig(values ...*big.Int) *big.Int {
+	result := big.NewInt(0)
+	for _, value := range values {
+		if value != nil && value.Cmp(result) > 0 {
+			result = value
+		}
+	}
+	return new(big.Int).Set(result)
+}
+
+// Check if a list of hashes contains the given one
+func containsHash(hashes []common.Hash, hash common.Hash) bool {
+	for _, h := range hashes {
+		if h == hash {
+			return true
+		}
+	}
+	return false
+}
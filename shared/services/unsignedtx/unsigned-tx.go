@@ -0,0 +1,176 @@
+package unsignedtx
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// The fields of an EIP-1559 transaction that are signed
+type unsignedDynamicFeeTx struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         *common.Address `rlp:"nil"`
+	Value      *big.Int
+	Data       []byte
+	AccessList types.AccessList
+}
+
+// The transactions built by this process
+var (
+	lock         sync.Mutex
+	senders      = map[common.Hash]common.Address{}
+	transactions = []api.UnsignedTransaction{}
+)
+
+// Create a transaction signer that builds unsigned EIP-1559 transactions for the given account instead of signing them
+func NewSigner(account common.Address, chainID *big.Int) bind.SignerFn {
+	return func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if address != account {
+			return nil, bind.ErrNotAuthorized
+		}
+		if tx.Type() != types.DynamicFeeTxType {
+			return nil, fmt.Errorf("only EIP-1559 transactions can be built for offline signing")
+		}
+
+		// Contract bindings leave the chain ID for the signer to set
+		unsignedTx := types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      tx.Nonce(),
+			GasTipCap:  tx.GasTipCap(),
+			GasFeeCap:  tx.GasFeeCap(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		})
+
+		lock.Lock()
+		defer lock.Unlock()
+		senders[unsignedTx.Hash()] = account
+		return unsignedTx, nil
+	}
+}
+
+// Record a transaction built by a signer from NewSigner instead of sending it, so it can be included in the API response
+func Record(tx *types.Transaction) error {
+	lock.Lock()
+	defer lock.Unlock()
+	from, exists := senders[tx.Hash()]
+	if !exists {
+		return errors.New("only transactions built for offline signing can be exported")
+	}
+	unsignedTx, err := ToApi(tx, from)
+	if err != nil {
+		return err
+	}
+	transactions = append(transactions, unsignedTx)
+	return nil
+}
+
+// Get the transactions recorded by this process
+func GetTransactions() []api.UnsignedTransaction {
+	lock.Lock()
+	defer lock.Unlock()
+	return append([]api.UnsignedTransaction{}, transactions...)
+}
+
+// Encode the unsigned payload of an EIP-1559 transaction
+func Encode(tx *types.Transaction) ([]byte, error) {
+	if tx.Type() != types.DynamicFeeTxType {
+		return nil, fmt.Errorf("transaction type %d is not an EIP-1559 transaction", tx.Type())
+	}
+	payload, err := rlp.EncodeToBytes(unsignedDynamicFeeTx{
+		ChainID:    tx.ChainId(),
+		Nonce:      tx.Nonce(),
+		GasTipCap:  tx.GasTipCap(),
+		GasFeeCap:  tx.GasFeeCap(),
+		Gas:        tx.Gas(),
+		To:         tx.To(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error encoding transaction: %w", err)
+	}
+	return append([]byte{types.DynamicFeeTxType}, payload...), nil
+}
+
+// Decode the unsigned payload of an EIP-1559 transaction
+func Decode(payload []byte) (*types.Transaction, error) {
+	if len(payload) == 0 || payload[0] != types.DynamicFeeTxType {
+		return nil, fmt.Errorf("the transaction is not an unsigned EIP-1559 transaction")
+	}
+	var fields unsignedDynamicFeeTx
+	if err := rlp.DecodeBytes(payload[1:], &fields); err != nil {
+		return nil, fmt.Errorf("error decoding transaction: %w", err)
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:    fields.ChainID,
+		Nonce:      fields.Nonce,
+		GasTipCap:  fields.GasTipCap,
+		GasFeeCap:  fields.GasFeeCap,
+		Gas:        fields.Gas,
+		To:         fields.To,
+		Value:      fields.Value,
+		Data:       fields.Data,
+		AccessList: fields.AccessList,
+	}), nil
+}
+
+// Describe an unsigned transaction for the API
+func ToApi(tx *types.Transaction, from common.Address) (api.UnsignedTransaction, error) {
+	payload, err := Encode(tx)
+	if err != nil {
+		return api.UnsignedTransaction{}, err
+	}
+	return api.UnsignedTransaction{
+		ChainID:              tx.ChainId(),
+		From:                 from,
+		To:                   tx.To(),
+		Nonce:                tx.Nonce(),
+		Value:                tx.Value(),
+		Data:                 tx.Data(),
+		GasLimit:             tx.Gas(),
+		MaxFeePerGas:         tx.GasFeeCap(),
+		MaxPriorityFeePerGas: tx.GasTipCap(),
+		Rlp:                  payload,
+	}, nil
+}
+
+// Parse an exported transaction, which is either the JSON form of an api.UnsignedTransaction or the hex-encoded
+// unsigned payload. Returns the transaction and its sender, if the JSON form names one.
+func Parse(exported []byte) (*types.Transaction, common.Address, error) {
+	text := strings.TrimSpace(string(exported))
+	if strings.HasPrefix(text, "{") {
+		var unsignedTx api.UnsignedTransaction
+		if err := json.Unmarshal([]byte(text), &unsignedTx); err != nil {
+			return nil, common.Address{}, fmt.Errorf("error parsing transaction JSON: %w", err)
+		}
+		tx, err := Decode(unsignedTx.Rlp)
+		return tx, unsignedTx.From, err
+	}
+
+	payload, err := hexutil.Decode(text)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("the transaction is neither JSON nor a 0x-prefixed hex string: %w", err)
+	}
+	tx, err := Decode(payload)
+	return tx, common.Address{}, err
+}
@@ -1,11 +1,35 @@
 package keystore
 
 import (
+	"fmt"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
 	"github.com/rocket-pool/rocketpool-go/types"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 	"github.com/sethvargo/go-password/password"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// Config
+const (
+	// The path the validators folder is mounted at in the Validator Client containers
+	ContainerValidatorsPath = "/validators"
+
+	// The name of the interchange file the Validator Clients export their slashing protection databases to
+	SlashingProtectionExportFileName = "slashing-protection-export.json"
 )
 
+// An EIP-2335 encrypted validator key store
+type EncryptedKeystore struct {
+	Crypto  map[string]interface{} `json:"crypto"`
+	Version uint                   `json:"version"`
+	UUID    uuid.UUID              `json:"uuid"`
+	Path    string                 `json:"path"`
+	Pubkey  types.ValidatorPubkey  `json:"pubkey"`
+}
+
 // Generates a random password
 func GenerateRandomPassword() (string, error) {
 
@@ -18,9 +42,63 @@ func GenerateRandomPassword() (string, error) {
 	return password, nil
 }
 
+// The commands that move a Validator Client's slashing protection database in and out of the EIP-3076 interchange format.
+// They're run in the client's own Docker image with the validators folder mounted at ContainerValidatorsPath.
+type SlashingProtectionCommands struct {
+	Entrypoint string
+	ExportArgs []string
+	ImportArgs []string
+
+	// The interchange file created by the export command, relative to the validators folder
+	ExportFile string
+}
+
 // Validator keystore interface
 type Keystore interface {
 	StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error
 	LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, error)
 	GetKeystoreDir() string
 }
+
+// Encrypts a validator key into a serialized EIP-2335 key store with a new random password, for handing it to a remote service
+func CreateEncryptedKeystore(key *eth2types.BLSPrivateKey, derivationPath string) ([]byte, string, error) {
+
+	// Create a new password
+	password, err := GenerateRandomPassword()
+	if err != nil {
+		return nil, "", fmt.Errorf("Could not generate random password: %w", err)
+	}
+
+	// Encrypt key
+	encryptor := eth2ks.New(eth2ks.WithCipher("scrypt"))
+	encryptedKey, err := encryptor.Encrypt(key.Marshal(), password)
+	if err != nil {
+		return nil, "", fmt.Errorf("Could not encrypt validator key: %w", err)
+	}
+
+	// Encode key store
+	keyStoreBytes, err := json.Marshal(EncryptedKeystore{
+		Crypto:  encryptedKey,
+		Version: encryptor.Version(),
+		UUID:    uuid.New(),
+		Path:    derivationPath,
+		Pubkey:  types.BytesToValidatorPubkey(key.PublicKey().Marshal()),
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("Could not encode validator key: %w", err)
+	}
+
+	return keyStoreBytes, password, nil
+
+}
+
+// Get the name most clients use for the given network
+func GetClientNetworkName(network cfgtypes.Network) string {
+	switch network {
+	case cfgtypes.Network_Devnet:
+		// The devnet runs on Prater
+		return string(cfgtypes.Network_Prater)
+	default:
+		return string(network)
+	}
+}
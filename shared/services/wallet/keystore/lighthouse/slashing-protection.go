@@ -0,0 +1,36 @@
+package lighthouse
+
+import (
+	"path"
+
+	keystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Config
+const (
+	ContainerEntrypoint = "lighthouse"
+)
+
+// Get the commands that export and import Lighthouse's slashing protection database.
+// The import file is relative to the validators folder.
+func GetSlashingProtectionCommands(network cfgtypes.Network, importFile string) keystore.SlashingProtectionCommands {
+	dataDir := path.Join(keystore.ContainerValidatorsPath, KeystoreDir)
+	exportFile := path.Join(KeystoreDir, keystore.SlashingProtectionExportFileName)
+	networkName := keystore.GetClientNetworkName(network)
+
+	return keystore.SlashingProtectionCommands{
+		Entrypoint: ContainerEntrypoint,
+		ExportArgs: []string{
+			"account", "validator", "slashing-protection", "export", path.Join(keystore.ContainerValidatorsPath, exportFile),
+			"--network", networkName,
+			"--datadir", dataDir,
+		},
+		ImportArgs: []string{
+			"account", "validator", "slashing-protection", "import", path.Join(keystore.ContainerValidatorsPath, importFile),
+			"--network", networkName,
+			"--datadir", dataDir,
+		},
+		ExportFile: exportFile,
+	}
+}
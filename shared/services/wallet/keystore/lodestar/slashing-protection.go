@@ -0,0 +1,48 @@
+package lodestar
+
+import (
+	"path"
+
+	keystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Config
+const (
+	ContainerEntrypoint = "node"
+	ContainerBinPath    = "/usr/app/packages/cli/bin/lodestar"
+)
+
+// Get the commands that export and import Lodestar's slashing protection database.
+// The import file is relative to the validators folder.
+func GetSlashingProtectionCommands(network cfgtypes.Network, importFile string) keystore.SlashingProtectionCommands {
+	dataDir := path.Join(keystore.ContainerValidatorsPath, KeystoreDir)
+	exportFile := path.Join(KeystoreDir, keystore.SlashingProtectionExportFileName)
+	networkName := getNetworkName(network)
+
+	return keystore.SlashingProtectionCommands{
+		Entrypoint: ContainerEntrypoint,
+		ExportArgs: []string{
+			ContainerBinPath, "validator", "slashing-protection", "export",
+			"--network", networkName,
+			"--dataDir", dataDir,
+			"--file", path.Join(keystore.ContainerValidatorsPath, exportFile),
+		},
+		ImportArgs: []string{
+			ContainerBinPath, "validator", "slashing-protection", "import",
+			"--network", networkName,
+			"--dataDir", dataDir,
+			"--file", path.Join(keystore.ContainerValidatorsPath, importFile),
+		},
+		ExportFile: exportFile,
+	}
+}
+
+// Lodestar still calls Prater by its execution layer name
+func getNetworkName(network cfgtypes.Network) string {
+	networkName := keystore.GetClientNetworkName(network)
+	if networkName == string(cfgtypes.Network_Prater) {
+		return "goerli"
+	}
+	return networkName
+}
@@ -0,0 +1,40 @@
+package nimbus
+
+import (
+	"path"
+
+	keystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Config
+const (
+	// Only the Beacon Node binary can manage the slashing protection database, so this has to run in the BN image
+	ContainerEntrypoint = "/home/user/nimbus-eth2/build/nimbus_beacon_node"
+)
+
+// Get the commands that export and import Nimbus's slashing protection database.
+// The import file is relative to the validators folder.
+func GetSlashingProtectionCommands(network cfgtypes.Network, importFile string) keystore.SlashingProtectionCommands {
+	dataDir := path.Join(keystore.ContainerValidatorsPath, KeystoreDir)
+	validatorsDir := path.Join(dataDir, ValidatorsDir)
+	exportFile := path.Join(KeystoreDir, keystore.SlashingProtectionExportFileName)
+	networkName := keystore.GetClientNetworkName(network)
+
+	return keystore.SlashingProtectionCommands{
+		Entrypoint: ContainerEntrypoint,
+		ExportArgs: []string{
+			"slashingdb", "export", path.Join(keystore.ContainerValidatorsPath, exportFile),
+			"--network=" + networkName,
+			"--data-dir=" + dataDir,
+			"--validators-dir=" + validatorsDir,
+		},
+		ImportArgs: []string{
+			"slashingdb", "import", path.Join(keystore.ContainerValidatorsPath, importFile),
+			"--network=" + networkName,
+			"--data-dir=" + dataDir,
+			"--validators-dir=" + validatorsDir,
+		},
+		ExportFile: exportFile,
+	}
+}
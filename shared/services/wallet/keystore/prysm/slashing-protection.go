@@ -0,0 +1,41 @@
+package prysm
+
+import (
+	"path"
+
+	rpkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Config
+const (
+	ContainerEntrypoint = "/app/cmd/validator/validator"
+
+	// Prysm exports to a folder instead of a file, and always names the interchange file the same thing
+	SlashingProtectionExportDir      = "slashing-protection-export"
+	SlashingProtectionExportFileName = "slashing_protection.json"
+)
+
+// Get the commands that export and import Prysm's slashing protection database.
+// The import file is relative to the validators folder.
+func GetSlashingProtectionCommands(network cfgtypes.Network, importFile string) rpkeystore.SlashingProtectionCommands {
+	dataDir := path.Join(rpkeystore.ContainerValidatorsPath, KeystoreDir, WalletDir)
+	exportDir := path.Join(KeystoreDir, SlashingProtectionExportDir)
+
+	return rpkeystore.SlashingProtectionCommands{
+		Entrypoint: ContainerEntrypoint,
+		ExportArgs: []string{
+			"slashing-protection-history", "export",
+			"--accept-terms-of-use",
+			"--datadir=" + dataDir,
+			"--slashing-protection-export-dir=" + path.Join(rpkeystore.ContainerValidatorsPath, exportDir),
+		},
+		ImportArgs: []string{
+			"slashing-protection-history", "import",
+			"--accept-terms-of-use",
+			"--datadir=" + dataDir,
+			"--slashing-protection-json-file=" + path.Join(rpkeystore.ContainerValidatorsPath, importFile),
+		},
+		ExportFile: path.Join(exportDir, SlashingProtectionExportFileName),
+	}
+}
@@ -0,0 +1,35 @@
+package teku
+
+import (
+	"path"
+
+	keystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Config
+const (
+	ContainerEntrypoint = "/opt/teku/bin/teku"
+)
+
+// Get the commands that export and import Teku's slashing protection database.
+// The import file is relative to the validators folder.
+func GetSlashingProtectionCommands(network cfgtypes.Network, importFile string) keystore.SlashingProtectionCommands {
+	dataPath := path.Join(keystore.ContainerValidatorsPath, KeystoreDir)
+	exportFile := path.Join(KeystoreDir, keystore.SlashingProtectionExportFileName)
+
+	return keystore.SlashingProtectionCommands{
+		Entrypoint: ContainerEntrypoint,
+		ExportArgs: []string{
+			"slashing-protection", "export",
+			"--data-path=" + dataPath,
+			"--to=" + path.Join(keystore.ContainerValidatorsPath, exportFile),
+		},
+		ImportArgs: []string{
+			"slashing-protection", "import",
+			"--data-path=" + dataPath,
+			"--from=" + path.Join(keystore.ContainerValidatorsPath, importFile),
+		},
+		ExportFile: exportFile,
+	}
+}
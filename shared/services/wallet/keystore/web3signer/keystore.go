@@ -0,0 +1,143 @@
+package web3signer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	keystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+)
+
+// Config
+const (
+	KeystoresPath  string        = "/eth/v1/keystores"
+	RequestTimeout time.Duration = 30 * time.Second
+)
+
+// Web3Signer keystore; keys are imported into the remote signer through the keymanager API instead of being written to disk
+type Keystore struct {
+	url       string
+	authToken string
+	client    http.Client
+}
+
+// Keymanager API import request
+type importKeystoresRequest struct {
+	Keystores []string `json:"keystores"`
+	Passwords []string `json:"passwords"`
+}
+
+// Keymanager API import response
+type importKeystoresResponse struct {
+	Data []struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	} `json:"data"`
+}
+
+// Create new Web3Signer keystore
+func NewKeystore(url string, authToken string) *Keystore {
+	return &Keystore{
+		url:       strings.TrimSuffix(url, "/"),
+		authToken: authToken,
+		client: http.Client{
+			Timeout: RequestTimeout,
+		},
+	}
+}
+
+// Get the keystore directory; the remote signer doesn't have a local one
+func (ks *Keystore) GetKeystoreDir() string {
+	return ""
+}
+
+// Store a validator key by importing it into the remote signer
+func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error {
+
+	// Get validator pubkey
+	pubkey := types.BytesToValidatorPubkey(key.PublicKey().Marshal())
+
+	// Encrypt it with a new password; it's only used for the transfer since the remote signer manages its own storage
+	keyStoreBytes, password, err := keystore.CreateEncryptedKeystore(key, derivationPath)
+	if err != nil {
+		return err
+	}
+
+	// Import it
+	request := importKeystoresRequest{
+		Keystores: []string{string(keyStoreBytes)},
+		Passwords: []string{password},
+	}
+	var response importKeystoresResponse
+	if err := ks.sendRequest(http.MethodPost, KeystoresPath, request, &response); err != nil {
+		return fmt.Errorf("Could not import validator key %s into the remote signer: %w", pubkey.Hex(), err)
+	}
+	if len(response.Data) != 1 {
+		return fmt.Errorf("Could not import validator key %s into the remote signer: expected 1 result but got %d", pubkey.Hex(), len(response.Data))
+	}
+	switch response.Data[0].Status {
+	case "imported", "duplicate":
+		return nil
+	default:
+		return fmt.Errorf("Could not import validator key %s into the remote signer: status '%s' (%s)", pubkey.Hex(), response.Data[0].Status, response.Data[0].Message)
+	}
+
+}
+
+// Load a private key; the remote signer never releases them, so this only reports that the key isn't available locally
+func (ks *Keystore) LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
+	return nil, nil
+}
+
+// Send a JSON request to the remote signer and decode its JSON response
+func (ks *Keystore) sendRequest(method string, path string, body interface{}, response interface{}) error {
+
+	// Encode the body
+	var bodyReader io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error encoding request body: %w", err)
+		}
+		bodyReader = bytes.NewReader(bodyBytes)
+	}
+
+	// Build the request
+	request, err := http.NewRequest(method, ks.url+path, bodyReader)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+	if ks.authToken != "" {
+		request.Header.Set("Authorization", "Bearer "+ks.authToken)
+	}
+
+	// Send it
+	resp, err := ks.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	responseBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP status %d; response body: '%s'", resp.StatusCode, string(responseBytes))
+	}
+
+	// Decode the response
+	if err := json.Unmarshal(responseBytes, response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+	return nil
+
+}
@@ -0,0 +1,97 @@
+package web3signer
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
+)
+
+// Config
+const (
+	SignPathFormat string = "/api/v1/eth2/sign/%s"
+)
+
+// Fork info for a signing request
+type forkInfo struct {
+	Fork struct {
+		PreviousVersion string `json:"previous_version"`
+		CurrentVersion  string `json:"current_version"`
+		Epoch           string `json:"epoch"`
+	} `json:"fork"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+}
+
+// Voluntary exit signing request
+type voluntaryExitSignRequest struct {
+	Type          string    `json:"type"`
+	ForkInfo      *forkInfo `json:"fork_info"`
+	SigningRoot   string    `json:"signingRoot"`
+	VoluntaryExit struct {
+		Epoch          string `json:"epoch"`
+		ValidatorIndex string `json:"validator_index"`
+	} `json:"voluntary_exit"`
+}
+
+// Deposit signing request
+type depositSignRequest struct {
+	Type        string `json:"type"`
+	SigningRoot string `json:"signingRoot"`
+	Deposit     struct {
+		Pubkey                string `json:"pubkey"`
+		WithdrawalCredentials string `json:"withdrawal_credentials"`
+		Amount                string `json:"amount"`
+		GenesisForkVersion    string `json:"genesis_fork_version"`
+	} `json:"deposit"`
+}
+
+// Signing response
+type signResponse struct {
+	Signature string `json:"signature"`
+}
+
+// Sign a voluntary exit with the remote signer
+func (ks *Keystore) SignVoluntaryExit(pubkey types.ValidatorPubkey, exit eth2.VoluntaryExit, fork beacon.Fork, genesisValidatorsRoot []byte, signingRoot common.Hash) (types.ValidatorSignature, error) {
+	request := voluntaryExitSignRequest{
+		Type:        "VOLUNTARY_EXIT",
+		ForkInfo:    &forkInfo{},
+		SigningRoot: signingRoot.Hex(),
+	}
+	request.ForkInfo.Fork.PreviousVersion = hexutil.Encode(fork.PreviousVersion)
+	request.ForkInfo.Fork.CurrentVersion = hexutil.Encode(fork.CurrentVersion)
+	request.ForkInfo.Fork.Epoch = fmt.Sprint(fork.Epoch)
+	request.ForkInfo.GenesisValidatorsRoot = hexutil.Encode(genesisValidatorsRoot)
+	request.VoluntaryExit.Epoch = fmt.Sprint(exit.Epoch)
+	request.VoluntaryExit.ValidatorIndex = fmt.Sprint(exit.ValidatorIndex)
+	return ks.sign(pubkey, request)
+}
+
+// Sign deposit data with the remote signer
+func (ks *Keystore) SignDeposit(pubkey types.ValidatorPubkey, deposit eth2.DepositDataNoSignature, genesisForkVersion []byte, signingRoot common.Hash) (types.ValidatorSignature, error) {
+	request := depositSignRequest{
+		Type:        "DEPOSIT",
+		SigningRoot: signingRoot.Hex(),
+	}
+	request.Deposit.Pubkey = hexutil.Encode(deposit.PublicKey)
+	request.Deposit.WithdrawalCredentials = hexutil.Encode(deposit.WithdrawalCredentials)
+	request.Deposit.Amount = fmt.Sprint(deposit.Amount)
+	request.Deposit.GenesisForkVersion = hexutil.Encode(genesisForkVersion)
+	return ks.sign(pubkey, request)
+}
+
+// Submit a signing request for a validator
+func (ks *Keystore) sign(pubkey types.ValidatorPubkey, request interface{}) (types.ValidatorSignature, error) {
+	var response signResponse
+	if err := ks.sendRequest(http.MethodPost, fmt.Sprintf(SignPathFormat, hexutil.Encode(pubkey.Bytes())), request, &response); err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	signature, err := hexutil.Decode(response.Signature)
+	if err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("error decoding signature [%s]: %w", response.Signature, err)
+	}
+	return types.BytesToValidatorSignature(signature), nil
+}
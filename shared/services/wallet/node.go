@@ -10,7 +10,11 @@ import (
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/rocket-pool/smartnode/shared/services/unsignedtx"
 )
 
 // Get the node account
@@ -59,14 +63,114 @@ func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 		return nil, err
 	}
 
-	// Create & return transactor
+	// Create transactor
 	transactor, err := bind.NewKeyedTransactorWithChainID(privateKey, w.chainID)
+	if err != nil {
+		return nil, err
+	}
+	if w.unsignedTransactions {
+		transactor.Signer = unsignedtx.NewSigner(transactor.From, w.chainID)
+	}
 	transactor.GasFeeCap = w.maxFee
 	transactor.GasTipCap = w.maxPriorityFee
 	transactor.GasLimit = w.gasLimit
 	transactor.Context = context.Background()
-	return transactor, err
 
+	// If the nonce is being managed, get it when a transaction is actually signed instead of for every transactor
+	if w.nonceProvider != nil {
+		transactor.Signer = getManagedNonceSigner(transactor, w.nonceProvider)
+	}
+
+	// Return
+	return transactor, nil
+
+}
+
+// Sign an EIP-1559 transaction that was built for the node account on another machine
+func (w *Wallet) SignNodeTransaction(tx *types.Transaction) (*types.Transaction, error) {
+
+	// Check wallet is initialized
+	if !w.IsInitialized() {
+		return nil, errors.New("Wallet is not initialized")
+	}
+
+	// Make sure it's for this network, so it can't be replayed on another one
+	if tx.ChainId().Cmp(w.chainID) != 0 {
+		return nil, fmt.Errorf("The transaction is for chain ID %s, but this node is configured for chain ID %s", tx.ChainId().String(), w.chainID.String())
+	}
+
+	// Get private key
+	privateKey, _, err := w.getNodePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign it
+	signedTx, err := types.SignTx(tx, types.NewLondonSigner(w.chainID), privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("Could not sign transaction: %w", err)
+	}
+	return signedTx, nil
+
+}
+
+// Wrap a transactor's signer so each transaction gets its nonce from the provider, unless the caller set one on the transactor
+func getManagedNonceSigner(opts *bind.TransactOpts, nonceProvider NonceProvider) bind.SignerFn {
+	signer := opts.Signer
+	return func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if opts.Nonce != nil {
+			return signer(address, tx)
+		}
+		nonce, err := nonceProvider()
+		if err != nil {
+			return nil, fmt.Errorf("Could not get the next nonce for the node account: %w", err)
+		}
+		tx, err = setTransactionNonce(tx, nonce)
+		if err != nil {
+			return nil, err
+		}
+		return signer(address, tx)
+	}
+}
+
+// Get a copy of an unsigned transaction with a different nonce
+func setTransactionNonce(tx *types.Transaction, nonce uint64) (*types.Transaction, error) {
+	switch tx.Type() {
+	case types.LegacyTxType:
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: tx.GasPrice(),
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		}), nil
+	case types.AccessListTxType:
+		return types.NewTx(&types.AccessListTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      nonce,
+			GasPrice:   tx.GasPrice(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		}), nil
+	case types.DynamicFeeTxType:
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      nonce,
+			GasTipCap:  tx.GasTipCap(),
+			GasFeeCap:  tx.GasFeeCap(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		}), nil
+	default:
+		return nil, fmt.Errorf("Unsupported transaction type %d", tx.Type())
+	}
 }
 
 // Get the node account private key bytes
@@ -0,0 +1,113 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/types"
+
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+// Set the path of the EIP-3076 slashing protection interchange that's kept alongside the validator keys
+func (w *Wallet) SetSlashingProtectionPath(path string) {
+	w.slashingProtectionPath = path
+}
+
+// Get the saved signing history of the given validators, or of all of them if no pubkeys are provided.
+// Returns false if no signing history has been saved yet.
+func (w *Wallet) ExportSlashingProtection(pubkeys []types.ValidatorPubkey) (validator.SlashingProtectionInterchange, bool, error) {
+
+	interchange, exists, err := w.loadSlashingProtection()
+	if err != nil {
+		return validator.SlashingProtectionInterchange{}, false, err
+	}
+	if !exists {
+		return validator.SlashingProtectionInterchange{}, false, nil
+	}
+	if len(pubkeys) > 0 {
+		interchange = interchange.Filter(pubkeys)
+	}
+	return interchange, true, nil
+
+}
+
+// Merge an interchange into the saved signing history, returning the number of validators it contained.
+// The interchange must be for the chain with the provided genesis validators root.
+func (w *Wallet) ImportSlashingProtection(interchange validator.SlashingProtectionInterchange, genesisValidatorsRoot []byte) (int, error) {
+
+	if !interchange.IsForChain(genesisValidatorsRoot) {
+		return 0, fmt.Errorf("the slashing protection interchange is for genesis validators root %s, which doesn't match this network", interchange.Metadata.GenesisValidatorsRoot)
+	}
+
+	// Merge it with the existing history
+	existing, exists, err := w.loadSlashingProtection()
+	if err != nil {
+		return 0, err
+	}
+	if !exists {
+		existing = validator.NewSlashingProtectionInterchange(genesisValidatorsRoot)
+	}
+	merged, err := validator.MergeSlashingProtection(existing, interchange)
+	if err != nil {
+		return 0, err
+	}
+
+	// Save it
+	err = w.saveSlashingProtection(merged)
+	if err != nil {
+		return 0, err
+	}
+	return len(interchange.Data), nil
+
+}
+
+// Load the saved slashing protection interchange from disk
+func (w *Wallet) loadSlashingProtection() (validator.SlashingProtectionInterchange, bool, error) {
+
+	if w.slashingProtectionPath == "" {
+		return validator.SlashingProtectionInterchange{}, false, errors.New("the wallet does not have a slashing protection path")
+	}
+	bytes, err := os.ReadFile(w.slashingProtectionPath)
+	if os.IsNotExist(err) {
+		return validator.SlashingProtectionInterchange{}, false, nil
+	}
+	if err != nil {
+		return validator.SlashingProtectionInterchange{}, false, fmt.Errorf("error reading slashing protection file %s: %w", w.slashingProtectionPath, err)
+	}
+	interchange, err := validator.ParseSlashingProtectionInterchange(bytes)
+	if err != nil {
+		return validator.SlashingProtectionInterchange{}, false, fmt.Errorf("error loading slashing protection file %s: %w", w.slashingProtectionPath, err)
+	}
+	return interchange, true, nil
+
+}
+
+// Save the slashing protection interchange to disk
+func (w *Wallet) saveSlashingProtection(interchange validator.SlashingProtectionInterchange) error {
+
+	bytes, err := json.MarshalIndent(interchange, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing slashing protection interchange: %w", err)
+	}
+	err = os.MkdirAll(filepath.Dir(w.slashingProtectionPath), 0770)
+	if err != nil {
+		return fmt.Errorf("error creating slashing protection folder: %w", err)
+	}
+
+	// Write to a temporary file first so a crash can't leave a partial file behind
+	tempPath := w.slashingProtectionPath + ".tmp"
+	err = os.WriteFile(tempPath, bytes, 0640)
+	if err != nil {
+		return fmt.Errorf("error writing slashing protection file %s: %w", tempPath, err)
+	}
+	err = os.Rename(tempPath, w.slashingProtectionPath)
+	if err != nil {
+		return fmt.Errorf("error replacing slashing protection file %s: %w", w.slashingProtectionPath, err)
+	}
+	return nil
+
+}
@@ -7,8 +7,11 @@ import (
 	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/types"
 	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
 	eth2util "github.com/wealdtech/go-eth2-util"
@@ -136,6 +139,88 @@ func (w *Wallet) LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BLSP
 
 }
 
+// Get the remote signer holding the wallet's validator keys, if one of the keystores is backed by one
+func (w *Wallet) GetRemoteSigner() (validator.RemoteSigner, bool) {
+	for name := range w.keystores {
+		if signer, ok := w.keystores[name].(validator.RemoteSigner); ok {
+			return signer, true
+		}
+	}
+	return nil, false
+}
+
+// Get deposit data & root for one of the wallet's validators, signing it with the remote signer if the keys are held by one
+func (w *Wallet) GetDepositData(pubkey types.ValidatorPubkey, withdrawalCredentials common.Hash, eth2Config beacon.Eth2Config, depositAmount uint64) (eth2.DepositData, common.Hash, error) {
+
+	if signer, ok := w.GetRemoteSigner(); ok {
+		return validator.GetRemoteSignedDepositData(signer, pubkey, withdrawalCredentials, eth2Config, depositAmount)
+	}
+
+	validatorKey, err := w.GetValidatorKeyByPubkey(pubkey)
+	if err != nil {
+		return eth2.DepositData{}, common.Hash{}, err
+	}
+	return validator.GetDepositData(validatorKey, withdrawalCredentials, eth2Config, depositAmount)
+
+}
+
+// Get a voluntary exit message signature for one of the wallet's validators, signing it with the remote signer if the keys are held by one
+func (w *Wallet) GetSignedExitMessage(pubkey types.ValidatorPubkey, validatorIndex string, epoch uint64, bc beacon.Client) (types.ValidatorSignature, error) {
+
+	if signer, ok := w.GetRemoteSigner(); ok {
+		eth2Config, err := bc.GetEth2Config()
+		if err != nil {
+			return types.ValidatorSignature{}, err
+		}
+		fork, err := bc.GetFork("head")
+		if err != nil {
+			return types.ValidatorSignature{}, err
+		}
+		return validator.GetRemoteSignedExitMessage(signer, pubkey, validatorIndex, epoch, fork, eth2Config.GenesisValidatorsRoot)
+	}
+
+	validatorKey, err := w.GetValidatorKeyByPubkey(pubkey)
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	signatureDomain, err := bc.GetDomainData(eth2types.DomainVoluntaryExit[:], epoch, false)
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	return validator.GetSignedExitMessage(validatorKey, validatorIndex, epoch, signatureDomain)
+
+}
+
+// Get a voluntary exit message signature that stays valid after future forks.
+// Since Deneb, exits are always verified against the Capella fork version (EIP-7044), so the signature is made with that domain instead of the head fork's.
+func (w *Wallet) GetPresignedExitMessage(pubkey types.ValidatorPubkey, validatorIndex string, epoch uint64, bc beacon.Client) (types.ValidatorSignature, error) {
+
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	if len(eth2Config.CapellaForkVersion) == 0 {
+		return types.ValidatorSignature{}, errors.New("the Beacon Node did not provide the Capella fork version")
+	}
+
+	if signer, ok := w.GetRemoteSigner(); ok {
+		fork := beacon.Fork{
+			PreviousVersion: eth2Config.CapellaForkVersion,
+			CurrentVersion:  eth2Config.CapellaForkVersion,
+			Epoch:           0,
+		}
+		return validator.GetRemoteSignedExitMessage(signer, pubkey, validatorIndex, epoch, fork, eth2Config.GenesisValidatorsRoot)
+	}
+
+	validatorKey, err := w.GetValidatorKeyByPubkey(pubkey)
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	signatureDomain := eth2types.Domain(eth2types.DomainVoluntaryExit, eth2Config.CapellaForkVersion, eth2Config.GenesisValidatorsRoot)
+	return validator.GetSignedExitMessage(validatorKey, validatorIndex, epoch, signatureDomain)
+
+}
+
 // Deletes all of the keystore directories and persistent VC storage
 func (w *Wallet) DeleteValidatorStores() error {
 
@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/goccy/go-json"
 	"github.com/google/uuid"
 	"github.com/tyler-smith/go-bip39"
@@ -61,8 +62,20 @@ type Wallet struct {
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
 	gasLimit       uint64
+
+	// Provides the nonce for new node transactions, if set
+	nonceProvider NonceProvider
+
+	// Whether node transactions are built without being signed, for signing on an offline machine
+	unsignedTransactions bool
+
+	// The EIP-3076 slashing protection interchange kept alongside the validator keys
+	slashingProtectionPath string
 }
 
+// Provides the nonce to use for the next node transaction
+type NonceProvider func() (uint64, error)
+
 // Encrypted wallet store
 type walletStore struct {
 	Crypto         map[string]interface{} `json:"crypto"`
@@ -111,6 +124,16 @@ func (w *Wallet) AddKeystore(name string, ks keystore.Keystore) {
 	w.keystores[name] = ks
 }
 
+// Set the provider for the nonces of new node transactions; if it isn't set, nonces are left to the Execution client
+func (w *Wallet) SetNonceProvider(provider NonceProvider) {
+	w.nonceProvider = provider
+}
+
+// Build node transactions without signing them, so they can be exported and signed on an offline machine
+func (w *Wallet) EnableUnsignedTransactions() {
+	w.unsignedTransactions = true
+}
+
 // Check if the wallet has been initialized
 func (w *Wallet) IsInitialized() bool {
 	return (w.ws != nil && w.seed != nil && w.mk != nil)
@@ -317,6 +340,28 @@ func (w *Wallet) SignMessage(message string) ([]byte, error) {
 	return signedMessage, nil
 }
 
+// Signs EIP-712 typed data using the wallet's private key
+func (w *Wallet) SignTypedData(typedData apitypes.TypedData) ([]byte, error) {
+	// Get the wallet's private key
+	privateKey, _, err := w.getNodePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	dataHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("Error hashing typed data: %w", err)
+	}
+	signedData, err := crypto.Sign(dataHash, privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("Error signing typed data: %w", err)
+	}
+
+	// fix the ECDSA 'v' the same way as personal_sign signatures
+	signedData[crypto.RecoveryIDOffset] += 27
+	return signedData, nil
+}
+
 // Reloads wallet from disk
 func (w *Wallet) Reload() error {
 	_, err := w.loadStore()
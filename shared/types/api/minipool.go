@@ -101,6 +101,24 @@ type ExitMinipoolResponse struct {
 	Error  string `json:"error"`
 }
 
+type MinipoolPresignedExit struct {
+	Address        common.Address           `json:"address"`
+	Pubkey         types.ValidatorPubkey    `json:"pubkey"`
+	ValidatorIndex string                   `json:"validatorIndex"`
+	Epoch          uint64                   `json:"epoch"`
+	Signature      types.ValidatorSignature `json:"signature"`
+}
+type ExportMinipoolExitsResponse struct {
+	Status  string                  `json:"status"`
+	Error   string                  `json:"error"`
+	Exits   []MinipoolPresignedExit `json:"exits"`
+	Skipped []common.Address        `json:"skipped"`
+}
+type BroadcastMinipoolExitResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
 type CanChangeWithdrawalCredentialsResponse struct {
 	Status    string `json:"status"`
 	Error     string `json:"error"`
@@ -112,8 +130,16 @@ type ChangeWithdrawalCredentialsResponse struct {
 }
 
 type ImportKeyResponse struct {
-	Status string `json:"status"`
-	Error  string `json:"error"`
+	Status               string `json:"status"`
+	Error                string `json:"error"`
+	ValidatorIsLive      bool   `json:"validatorIsLive"`
+	LastAttestationEpoch uint64 `json:"lastAttestationEpoch"`
+}
+
+type LoadKeyResponse struct {
+	Status    string `json:"status"`
+	Error     string `json:"error"`
+	Restarted bool   `json:"restarted"`
 }
 
 type CanProcessWithdrawalResponse struct {
@@ -349,3 +375,39 @@ type RescueDissolvedMinipoolResponse struct {
 	Error  string      `json:"error"`
 	TxHash common.Hash `json:"txHash"`
 }
+
+// The performance of one of the node's validators during an epoch
+type ValidatorEpochPerformance struct {
+	Epoch               uint64   `json:"epoch"`
+	AttestationSlot     uint64   `json:"attestationSlot,omitempty"`
+	HasAttestationDuty  bool     `json:"hasAttestationDuty,omitempty"`
+	AttestationIncluded bool     `json:"attestationIncluded,omitempty"`
+	ProposalSlots       []uint64 `json:"proposalSlots,omitempty"`
+	MissedProposalSlots []uint64 `json:"missedProposalSlots,omitempty"`
+	SyncDuties          uint64   `json:"syncDuties,omitempty"`
+	SyncParticipation   uint64   `json:"syncParticipation,omitempty"`
+}
+
+// The recent performance of one of the node's validators
+type MinipoolPerformance struct {
+	Address        common.Address              `json:"address"`
+	Pubkey         types.ValidatorPubkey       `json:"pubkey"`
+	ValidatorIndex string                      `json:"validatorIndex"`
+	Epochs         []ValidatorEpochPerformance `json:"epochs"`
+}
+
+// The performance of the node's validators, as tracked by the node daemon
+type MinipoolPerformanceRecord struct {
+	StartEpoch  uint64                          `json:"startEpoch"`
+	LatestEpoch uint64                          `json:"latestEpoch"`
+	Minipools   map[string]*MinipoolPerformance `json:"minipools"`
+}
+
+type MinipoolPerformanceResponse struct {
+	Status      string                `json:"status"`
+	Error       string                `json:"error"`
+	IsTracked   bool                  `json:"isTracked"`
+	StartEpoch  uint64                `json:"startEpoch"`
+	LatestEpoch uint64                `json:"latestEpoch"`
+	Minipools   []MinipoolPerformance `json:"minipools"`
+}
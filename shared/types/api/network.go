@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/shared/services/rewards"
 )
 
 type NodeFeeResponse struct {
@@ -70,6 +71,13 @@ type NetworkGenerateRewardsTreeResponse struct {
 	Error  string `json:"error"`
 }
 
+type NetworkVerifyRewardsTreeResponse struct {
+	Status       string                         `json:"status"`
+	Error        string                         `json:"error"`
+	CanonicalCID string                         `json:"canonicalCid"`
+	Comparison   *rewards.RewardsFileComparison `json:"comparison"`
+}
+
 type NetworkDAOProposalsResponse struct {
 	Status                  string                 `json:"status"`
 	Error                   string                 `json:"error"`
@@ -231,6 +231,8 @@ type CanNodeDepositResponse struct {
 	DepositDisabled                  bool               `json:"depositDisabled"`
 	InConsensus                      bool               `json:"inConsensus"`
 	MinipoolAddress                  common.Address     `json:"minipoolAddress"`
+	ValidatorKeyIsLive               bool               `json:"validatorKeyIsLive"`
+	LastAttestationEpoch             uint64             `json:"lastAttestationEpoch"`
 	GasInfo                          rocketpool.GasInfo `json:"gasInfo"`
 }
 type NodeDepositResponse struct {
@@ -344,6 +346,25 @@ type NodeRewardsResponse struct {
 	TxHash                      common.Hash   `json:"txHash"`
 }
 
+type NodeEstimateSmoothingPoolRewardsResponse struct {
+	Status                       string    `json:"status"`
+	Error                        string    `json:"error"`
+	Index                        uint64    `json:"index"`
+	RulesetVersion               uint64    `json:"rulesetVersion"`
+	IntervalStartTime            time.Time `json:"intervalStartTime"`
+	IntervalEndTime              time.Time `json:"intervalEndTime"`
+	EstimateTime                 time.Time `json:"estimateTime"`
+	UsedRollingRecord            bool      `json:"usedRollingRecord"`
+	RollingRecordSlot            uint64    `json:"rollingRecordSlot"`
+	IsOptedIn                    bool      `json:"isOptedIn"`
+	SmoothingPoolBalance         *big.Int  `json:"smoothingPoolBalance"`
+	PoolStakerSmoothingPoolEth   *big.Int  `json:"poolStakerSmoothingPoolEth"`
+	NodeOperatorSmoothingPoolEth *big.Int  `json:"nodeOperatorSmoothingPoolEth"`
+	NodeSmoothingPoolEth         *big.Int  `json:"nodeSmoothingPoolEth"`
+	NodeCollateralRpl            *big.Int  `json:"nodeCollateralRpl"`
+	TotalCollateralRpl           *big.Int  `json:"totalCollateralRpl"`
+}
+
 type DepositContractInfoResponse struct {
 	Status                string         `json:"status"`
 	Error                 string         `json:"error"`
@@ -541,3 +562,37 @@ type NodeEthBalanceResponse struct {
 	Error   string   `json:"error"`
 	Balance *big.Int `json:"balance"`
 }
+
+type PendingTransaction struct {
+	Nonce          uint64          `json:"nonce"`
+	Hash           common.Hash     `json:"hash"`
+	To             *common.Address `json:"to"`
+	Value          *big.Int        `json:"value"`
+	GasLimit       uint64          `json:"gasLimit"`
+	MaxFee         *big.Int        `json:"maxFee"`
+	MaxPriorityFee *big.Int        `json:"maxPriorityFee"`
+	FirstSent      time.Time       `json:"firstSent"`
+	LastSent       time.Time       `json:"lastSent"`
+	Replacements   uint64          `json:"replacements"`
+	IsCancellation bool            `json:"isCancellation"`
+}
+type NodeTransactionsResponse struct {
+	Status       string               `json:"status"`
+	Error        string               `json:"error"`
+	LatestNonce  uint64               `json:"latestNonce"`
+	PendingNonce uint64               `json:"pendingNonce"`
+	Transactions []PendingTransaction `json:"transactions"`
+}
+
+type CanCancelNodeTransactionResponse struct {
+	Status         string   `json:"status"`
+	Error          string   `json:"error"`
+	MaxFee         *big.Int `json:"maxFee"`
+	MaxPriorityFee *big.Int `json:"maxPriorityFee"`
+	GasLimit       uint64   `json:"gasLimit"`
+}
+type CancelNodeTransactionResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/dao"
@@ -314,3 +315,41 @@ type GetTNDAOMinipoolSettingsResponse struct {
 	BondReductionWindowStart  uint64 `json:"bondReductionWindowStart"`
 	BondReductionWindowLength uint64 `json:"bondReductionWindowLength"`
 }
+
+type PenaltyViolationType string
+
+const (
+	// The node was opted into the Smoothing Pool but the fees went somewhere else
+	PenaltyViolationType_SmoothingPoolTheft PenaltyViolationType = "smoothing-pool-theft"
+
+	// The node opted out of the Smoothing Pool too late to keep the fees of this block
+	PenaltyViolationType_LateOptOut PenaltyViolationType = "late-opt-out"
+
+	// The node wasn't in the Smoothing Pool and the fees didn't go to its fee distributor
+	PenaltyViolationType_IllegalFeeRecipient PenaltyViolationType = "illegal-fee-recipient"
+)
+
+// A block where a Rocket Pool validator sent its fees and MEV to the wrong address
+type PenaltyViolation struct {
+	Type                 PenaltyViolationType `json:"type"`
+	Slot                 uint64               `json:"slot"`
+	ExecutionBlockNumber uint64               `json:"executionBlockNumber"`
+	ProposerIndex        string               `json:"proposerIndex"`
+	MinipoolAddress      common.Address       `json:"minipoolAddress"`
+	NodeAddress          common.Address       `json:"nodeAddress"`
+	ExpectedRecipient    common.Address       `json:"expectedRecipient"`
+	FeeRecipient         common.Address       `json:"feeRecipient"`
+	HasMevPayment        bool                 `json:"hasMevPayment"`
+	MevRecipient         common.Address       `json:"mevRecipient"`
+	MevAmount            *big.Int             `json:"mevAmount"`
+	DetectedTime         time.Time            `json:"detectedTime"`
+	PenaltySubmitted     bool                 `json:"penaltySubmitted"`
+	PenaltyTxHash        common.Hash          `json:"penaltyTxHash"`
+}
+
+type TNDAOPenaltiesResponse struct {
+	Status          string             `json:"status"`
+	Error           string             `json:"error"`
+	SubmitPenalties bool               `json:"submitPenalties"`
+	Violations      []PenaltyViolation `json:"violations"`
+}
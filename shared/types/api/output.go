@@ -0,0 +1,269 @@
+package api
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+)
+
+// The version of the JSON document the CLI prints with `--output json`. It's incremented whenever a field is removed
+// or changes meaning; new fields can be added without changing it.
+const CliOutputSchemaVersion int = 1
+
+// The JSON document the CLI prints with `--output json`, instead of its usual text, when a command finishes
+type CliOutput struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Command       string `json:"command"`
+	Status        string `json:"status"`
+	Error         string `json:"error"`
+
+	// The responses to the API calls the command made, in the order it made them. These are the daemon's raw API
+	// responses, which can change between releases; they're included for troubleshooting, and scripts should use Data.
+	Responses []CliApiResponse `json:"responses"`
+
+	// The command's report, for the commands that have one. It's one of the *Data types below, and its fields follow
+	// the rules of SchemaVersion.
+	Data interface{} `json:"data,omitempty"`
+}
+
+// The response to one of the API calls made by a CLI command. Call is the name of the API command (e.g. "node status")
+// and Response is the response struct in this package that it returns (e.g. NodeStatusResponse).
+type CliApiResponse struct {
+	Call     string          `json:"call"`
+	Response json.RawMessage `json:"response"`
+}
+
+// The versions of the Smartnode and its clients, reported by `service version`
+type ServiceVersionData struct {
+	ClientVersion        string `json:"clientVersion"`
+	ServiceVersion       string `json:"serviceVersion"`
+	IsNativeMode         bool   `json:"isNativeMode"`
+	ExecutionClientMode  string `json:"executionClientMode"`
+	ExecutionClient      string `json:"executionClient"`
+	ExecutionClientImage string `json:"executionClientImage"`
+	ConsensusClientMode  string `json:"consensusClientMode"`
+	ConsensusClient      string `json:"consensusClient"`
+	ConsensusClientImage string `json:"consensusClientImage"`
+	ValidatorClientImage string `json:"validatorClientImage"`
+}
+
+// The state of one of the Smartnode's Docker containers, reported by `service status`
+type ServiceContainerStatus struct {
+	Name    string `json:"name"`
+	Service string `json:"service"`
+
+	// The container's state according to Docker (e.g. "running" or "exited")
+	State string `json:"state"`
+
+	// The result of the container's health check, if it has one
+	Health string `json:"health"`
+}
+
+// The status of the Smartnode's services, reported by `service status`
+type ServiceStatusData struct {
+	Network string `json:"network"`
+
+	// The Smartnode's Docker containers; this is empty in Native Mode
+	Containers []ServiceContainerStatus `json:"containers"`
+
+	// The health of the node's Execution and Consensus clients, if the daemon could report it
+	ExecutionClients *ClientManagerStatus `json:"executionClients,omitempty"`
+	ConsensusClients *ClientManagerStatus `json:"consensusClients,omitempty"`
+}
+
+// The node's account, stake and minipools, reported by `node status`. ETH and RPL amounts are in wei.
+type NodeStatusData struct {
+	AccountAddress           common.Address `json:"accountAddress"`
+	EthBalance               *big.Int       `json:"ethBalance"`
+	RplBalance               *big.Int       `json:"rplBalance"`
+	FixedSupplyRplBalance    *big.Int       `json:"fixedSupplyRplBalance"`
+	CreditBalance            *big.Int       `json:"creditBalance"`
+	Registered               bool           `json:"registered"`
+	Trusted                  bool           `json:"trusted"`
+	TimezoneLocation         string         `json:"timezoneLocation"`
+	WithdrawalAddress        common.Address `json:"withdrawalAddress"`
+	PendingWithdrawalAddress common.Address `json:"pendingWithdrawalAddress"`
+	WithdrawalEthBalance     *big.Int       `json:"withdrawalEthBalance"`
+	WithdrawalRplBalance     *big.Int       `json:"withdrawalRplBalance"`
+	VotingDelegate           common.Address `json:"votingDelegate"`
+
+	// The node's RPL stake, taking any pending bond reductions into account
+	RplStake                *big.Int `json:"rplStake"`
+	EffectiveRplStake       *big.Int `json:"effectiveRplStake"`
+	MinimumRplStake         *big.Int `json:"minimumRplStake"`
+	MaximumRplStake         *big.Int `json:"maximumRplStake"`
+	BorrowedCollateralRatio float64  `json:"borrowedCollateralRatio"`
+	BondedCollateralRatio   float64  `json:"bondedCollateralRatio"`
+
+	// The part of the RPL stake that's eligible for rewards, counting only the minipools that are active on the Beacon Chain
+	EligibleRplStake *big.Int `json:"eligibleRplStake"`
+
+	// The ETH the node can still borrow from the staking pool with its current RPL stake
+	RemainingBorrowableEth *big.Int `json:"remainingBorrowableEth"`
+
+	InSmoothingPool             bool           `json:"inSmoothingPool"`
+	SmoothingPoolOptOutCooldown bool           `json:"smoothingPoolOptOutCooldown"`
+	SmoothingPoolOptOutEpoch    uint64         `json:"smoothingPoolOptOutEpoch"`
+	SmoothingPoolAddress        common.Address `json:"smoothingPoolAddress"`
+	FeeDistributorAddress       common.Address `json:"feeDistributorAddress"`
+	FeeDistributorBalance       *big.Int       `json:"feeDistributorBalance"`
+	FeeDistributorInitialized   bool           `json:"feeDistributorInitialized"`
+
+	Minipools          NodeMinipoolCounts `json:"minipools"`
+	PenalizedMinipools []MinipoolPenalty  `json:"penalizedMinipools"`
+}
+
+// The number of the node's minipools in each state, reported by `node status`
+type NodeMinipoolCounts struct {
+	Total               int `json:"total"`
+	Initialized         int `json:"initialized"`
+	Prelaunch           int `json:"prelaunch"`
+	Staking             int `json:"staking"`
+	Withdrawable        int `json:"withdrawable"`
+	Dissolved           int `json:"dissolved"`
+	Finalized           int `json:"finalized"`
+	RefundAvailable     int `json:"refundAvailable"`
+	WithdrawalAvailable int `json:"withdrawalAvailable"`
+	CloseAvailable      int `json:"closeAvailable"`
+}
+
+// A minipool that was penalized for using the wrong fee recipient; the first 2 penalties are strikes and the rest are infractions
+type MinipoolPenalty struct {
+	Address   common.Address `json:"address"`
+	Penalties uint64         `json:"penalties"`
+}
+
+// The node's minipools, reported by `minipool status`. ETH amounts are in wei.
+type MinipoolStatusData struct {
+	// The node's minipools; finalized minipools are only included with `--include-finalized`
+	Minipools      []MinipoolStatus `json:"minipools"`
+	FinalizedCount int              `json:"finalizedCount"`
+	LatestDelegate common.Address   `json:"latestDelegate"`
+}
+
+// One of the node's minipools, reported by `minipool status`
+type MinipoolStatus struct {
+	Address         common.Address        `json:"address"`
+	ValidatorPubkey types.ValidatorPubkey `json:"validatorPubkey"`
+
+	// The minipool's status in the Rocket Pool contracts (e.g. "Staking")
+	Status     string    `json:"status"`
+	StatusTime time.Time `json:"statusTime"`
+	Finalized  bool      `json:"finalized"`
+	Penalties  uint64    `json:"penalties"`
+
+	NodeFee             float64  `json:"nodeFee"`
+	NodeDepositBalance  *big.Int `json:"nodeDepositBalance"`
+	UserDepositAssigned bool     `json:"userDepositAssigned"`
+	UserDepositBalance  *big.Int `json:"userDepositBalance"`
+
+	// The minipool's position in the deposit queue, or 0 if it isn't in the queue
+	QueuePosition int64 `json:"queuePosition"`
+
+	// The minipool contract's ETH balance on the Execution layer, and the node's share of it
+	Balance            *big.Int `json:"balance"`
+	NodeShareOfBalance *big.Int `json:"nodeShareOfBalance"`
+	RefundBalance      *big.Int `json:"refundBalance"`
+
+	ValidatorIndex       string   `json:"validatorIndex"`
+	ValidatorSeen        bool     `json:"validatorSeen"`
+	ValidatorActive      bool     `json:"validatorActive"`
+	ValidatorBalance     *big.Int `json:"validatorBalance"`
+	ValidatorNodeBalance *big.Int `json:"validatorNodeBalance"`
+
+	RefundAvailable     bool `json:"refundAvailable"`
+	WithdrawalAvailable bool `json:"withdrawalAvailable"`
+	CloseAvailable      bool `json:"closeAvailable"`
+
+	UseLatestDelegate bool           `json:"useLatestDelegate"`
+	Delegate          common.Address `json:"delegate"`
+	PreviousDelegate  common.Address `json:"previousDelegate"`
+	EffectiveDelegate common.Address `json:"effectiveDelegate"`
+}
+
+// The node's rewards, reported by `node rewards`. Amounts are in ETH and RPL.
+type NodeRewardsData struct {
+	Registered bool `json:"registered"`
+
+	// The start and end of the current rewards interval
+	IntervalStart time.Time `json:"intervalStart"`
+	IntervalEnd   time.Time `json:"intervalEnd"`
+
+	// The node's share of its validators' Beacon Chain balances above their deposits, including its commission
+	BeaconRewards float64 `json:"beaconRewards"`
+
+	ClaimedSmoothingPoolEth   float64 `json:"claimedSmoothingPoolEth"`
+	UnclaimedSmoothingPoolEth float64 `json:"unclaimedSmoothingPoolEth"`
+
+	TotalRplStake       float64 `json:"totalRplStake"`
+	EstimatedRplRewards float64 `json:"estimatedRplRewards"`
+	EstimatedRplApr     float64 `json:"estimatedRplApr"`
+	ClaimedRplRewards   float64 `json:"claimedRplRewards"`
+	UnclaimedRplRewards float64 `json:"unclaimedRplRewards"`
+
+	// The oracle DAO rewards, which are only set for oracle DAO members
+	Trusted                    bool    `json:"trusted"`
+	TrustedRplBond             float64 `json:"trustedRplBond"`
+	EstimatedTrustedRplRewards float64 `json:"estimatedTrustedRplRewards"`
+	EstimatedTrustedRplApr     float64 `json:"estimatedTrustedRplApr"`
+	ClaimedTrustedRplRewards   float64 `json:"claimedTrustedRplRewards"`
+	UnclaimedTrustedRplRewards float64 `json:"unclaimedTrustedRplRewards"`
+}
+
+// The state of the Rocket Pool network, reported by `network stats`. Amounts are in ETH and RPL.
+type NetworkStatsData struct {
+	TotalValueLocked   float64 `json:"totalValueLocked"`
+	DepositPoolBalance float64 `json:"depositPoolBalance"`
+	MinipoolCapacity   float64 `json:"minipoolCapacity"`
+	StakerUtilization  float64 `json:"stakerUtilization"`
+	NodeFee            float64 `json:"nodeFee"`
+	NodeCount          uint64  `json:"nodeCount"`
+
+	ActiveMinipoolCount       uint64 `json:"activeMinipoolCount"`
+	InitializedMinipoolCount  uint64 `json:"initializedMinipoolCount"`
+	PrelaunchMinipoolCount    uint64 `json:"prelaunchMinipoolCount"`
+	StakingMinipoolCount      uint64 `json:"stakingMinipoolCount"`
+	WithdrawableMinipoolCount uint64 `json:"withdrawableMinipoolCount"`
+	DissolvedMinipoolCount    uint64 `json:"dissolvedMinipoolCount"`
+	FinalizedMinipoolCount    uint64 `json:"finalizedMinipoolCount"`
+
+	SmoothingPoolAddress common.Address `json:"smoothingPoolAddress"`
+	SmoothingPoolNodes   uint64         `json:"smoothingPoolNodes"`
+	SmoothingPoolBalance float64        `json:"smoothingPoolBalance"`
+
+	RethPrice          float64 `json:"rethPrice"`
+	RplPrice           float64 `json:"rplPrice"`
+	TotalRplStaked     float64 `json:"totalRplStaked"`
+	EffectiveRplStaked float64 `json:"effectiveRplStaked"`
+}
+
+// The oracle DAO proposals, reported by `odao proposals list`
+type TNDAOProposalsData struct {
+	// The proposals that match the command's state filter
+	Proposals []TNDAOProposal `json:"proposals"`
+}
+
+// An oracle DAO proposal, reported by `odao proposals list`. Times are Unix timestamps.
+type TNDAOProposal struct {
+	ID              uint64         `json:"id"`
+	Message         string         `json:"message"`
+	ProposerAddress common.Address `json:"proposerAddress"`
+	ProposerID      string         `json:"proposerId"`
+
+	// The proposal's state (e.g. "Active")
+	State       string `json:"state"`
+	CreatedTime uint64 `json:"createdTime"`
+	StartTime   uint64 `json:"startTime"`
+	EndTime     uint64 `json:"endTime"`
+	ExpiryTime  uint64 `json:"expiryTime"`
+
+	VotesRequired   float64 `json:"votesRequired"`
+	VotesFor        float64 `json:"votesFor"`
+	VotesAgainst    float64 `json:"votesAgainst"`
+	MemberVoted     bool    `json:"memberVoted"`
+	MemberSupported bool    `json:"memberSupported"`
+	Payload         string  `json:"payload"`
+}
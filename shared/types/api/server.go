@@ -0,0 +1,456 @@
+package api
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+)
+
+// The per-call transaction settings of an API server request; they match the daemon's global flags of the same name
+type ApiServerSettings struct {
+	MaxFee      float64  `json:"maxFee,omitempty"`
+	MaxPrioFee  float64  `json:"maxPrioFee,omitempty"`
+	CustomNonce *big.Int `json:"nonce,omitempty"`
+	Simulate    bool     `json:"simulate,omitempty"`
+}
+
+// Get the settings of a request that embeds them
+func (s ApiServerSettings) GetApiServerSettings() ApiServerSettings {
+	return s
+}
+
+// The body of the POST routes that take no arguments besides the transaction settings
+type ApiServerRequest struct {
+	ApiServerSettings `json:"settings"`
+}
+
+// The body of the wait route
+type WaitForTransactionRequest struct {
+	ApiServerSettings `json:"settings"`
+	TxHash            common.Hash `json:"txHash"`
+}
+
+// The body of the auction/can-bid-lot and auction/bid-lot routes
+type AuctionBidLotRequest struct {
+	ApiServerSettings `json:"settings"`
+	LotIndex          uint64   `json:"lotIndex"`
+	AmountWei         *big.Int `json:"amountWei"`
+}
+
+// The body of the auction routes that act on a single lot
+type AuctionLotRequest struct {
+	ApiServerSettings `json:"settings"`
+	LotIndex          uint64 `json:"lotIndex"`
+}
+
+// The body of the minipool/performance route
+type MinipoolPerformanceRequest struct {
+	ApiServerSettings `json:"settings"`
+	Epochs            uint64 `json:"epochs"`
+}
+
+// The body of the minipool routes that act on a single minipool
+type MinipoolRequest struct {
+	ApiServerSettings `json:"settings"`
+	MinipoolAddress   common.Address `json:"minipoolAddress"`
+}
+
+// The body of the minipool/broadcast-exit route
+type MinipoolBroadcastExitRequest struct {
+	ApiServerSettings `json:"settings"`
+	ValidatorIndex    string                   `json:"validatorIndex"`
+	Epoch             uint64                   `json:"epoch"`
+	Signature         types.ValidatorSignature `json:"signature"`
+}
+
+// The body of the minipool/can-set-use-latest-delegate and minipool/set-use-latest-delegate routes
+type MinipoolSetUseLatestDelegateRequest struct {
+	ApiServerSettings `json:"settings"`
+	MinipoolAddress   common.Address `json:"minipoolAddress"`
+	Setting           bool           `json:"setting"`
+}
+
+// The body of the minipool/get-vanity-artifacts route
+type MinipoolVanityArtifactsRequest struct {
+	ApiServerSettings `json:"settings"`
+	DepositAmount     *big.Int `json:"depositAmount"`
+	NodeAddress       string   `json:"nodeAddress"`
+}
+
+// The body of the minipool/can-begin-reduce-bond-amount and minipool/begin-reduce-bond-amount routes
+type MinipoolBeginReduceBondAmountRequest struct {
+	ApiServerSettings `json:"settings"`
+	MinipoolAddress   common.Address `json:"minipoolAddress"`
+	NewBondAmountWei  *big.Int       `json:"newBondAmountWei"`
+}
+
+// The body of the minipool/import-key route
+type MinipoolImportKeyRequest struct {
+	ApiServerSettings `json:"settings"`
+	MinipoolAddress   common.Address `json:"minipoolAddress"`
+	SkipLivenessCheck bool           `json:"skipLivenessCheck"`
+	Mnemonic          string         `json:"mnemonic"`
+}
+
+// The body of the minipool/can-change-withdrawal-creds and minipool/change-withdrawal-creds routes
+type MinipoolChangeWithdrawalCredsRequest struct {
+	ApiServerSettings `json:"settings"`
+	MinipoolAddress   common.Address `json:"minipoolAddress"`
+	Mnemonic          string         `json:"mnemonic"`
+}
+
+// The body of the minipool/rescue-dissolved route
+type MinipoolRescueDissolvedRequest struct {
+	ApiServerSettings `json:"settings"`
+	MinipoolAddress   common.Address `json:"minipoolAddress"`
+	DepositAmount     *big.Int       `json:"depositAmount"`
+}
+
+// The body of the network routes that generate or verify a rewards tree
+type NetworkRewardsTreeRequest struct {
+	ApiServerSettings `json:"settings"`
+	Index             uint64 `json:"index"`
+}
+
+// The body of the network/download-rewards-file route
+type NetworkDownloadRewardsFileRequest struct {
+	ApiServerSettings `json:"settings"`
+	Interval          uint64 `json:"interval"`
+}
+
+// The body of the node registration and timezone routes
+type NodeTimezoneRequest struct {
+	ApiServerSettings `json:"settings"`
+	TimezoneLocation  string `json:"timezoneLocation"`
+}
+
+// The body of the node/can-set-withdrawal-address and node/set-withdrawal-address routes
+type NodeSetWithdrawalAddressRequest struct {
+	ApiServerSettings `json:"settings"`
+	WithdrawalAddress common.Address `json:"withdrawalAddress"`
+	Confirm           bool           `json:"confirm"`
+}
+
+// The body of the node routes that swap, stake or withdraw an amount of RPL
+type NodeRplAmountRequest struct {
+	ApiServerSettings `json:"settings"`
+	AmountWei         *big.Int `json:"amountWei"`
+}
+
+// The body of the node/wait-and-swap-rpl and node/wait-and-stake-rpl routes
+type NodeWaitForRplApprovalRequest struct {
+	ApiServerSettings `json:"settings"`
+	AmountWei         *big.Int    `json:"amountWei"`
+	Hash              common.Hash `json:"hash"`
+}
+
+// The body of the node/can-set-stake-rpl-for-allowed and node/set-stake-rpl-for-allowed routes
+type NodeStakeRplForAllowedRequest struct {
+	ApiServerSettings `json:"settings"`
+	CallerAddress     common.Address `json:"callerAddress"`
+	Allowed           bool           `json:"allowed"`
+}
+
+// The body of the node/can-deposit route
+type NodeCanDepositRequest struct {
+	ApiServerSettings `json:"settings"`
+	AmountWei         *big.Int `json:"amountWei"`
+	MinNodeFee        float64  `json:"minNodeFee"`
+	Salt              *big.Int `json:"salt"`
+}
+
+// The body of the node/deposit route; the API server always submits the deposit
+type NodeDepositRequest struct {
+	ApiServerSettings `json:"settings"`
+	AmountWei         *big.Int `json:"amountWei"`
+	MinNodeFee        float64  `json:"minNodeFee"`
+	Salt              *big.Int `json:"salt"`
+	UseCreditBalance  bool     `json:"useCreditBalance"`
+	SkipLivenessCheck bool     `json:"skipLivenessCheck"`
+}
+
+// The body of the node/can-send and node/send routes
+type NodeSendRequest struct {
+	ApiServerSettings `json:"settings"`
+	AmountWei         *big.Int       `json:"amountWei"`
+	Token             string         `json:"token"`
+	ToAddress         common.Address `json:"toAddress"`
+}
+
+// The body of the node/can-burn and node/burn routes
+type NodeBurnRequest struct {
+	ApiServerSettings `json:"settings"`
+	AmountWei         *big.Int `json:"amountWei"`
+	Token             string   `json:"token"`
+}
+
+// The body of the node/broadcast-tx route
+type NodeBroadcastTransactionRequest struct {
+	ApiServerSettings `json:"settings"`
+	SignedTx          string `json:"signedTx"`
+}
+
+// The body of the node/sign route
+type NodeSignRequest struct {
+	ApiServerSettings `json:"settings"`
+	Data              string `json:"data"`
+}
+
+// The body of the node/sign-typed-data route
+type NodeSignTypedDataRequest struct {
+	ApiServerSettings `json:"settings"`
+	Data              string `json:"data"`
+	AllowNoChainID    bool   `json:"allowNoChainId"`
+}
+
+// The body of the node/sign-message and node/sign-siwe routes
+type NodeSignMessageRequest struct {
+	ApiServerSettings `json:"settings"`
+	Message           string `json:"message"`
+}
+
+// The body of the node/estimate-set-snapshot-delegate-gas and node/set-snapshot-delegate routes
+type NodeSetSnapshotDelegateRequest struct {
+	ApiServerSettings `json:"settings"`
+	Delegate          common.Address `json:"delegate"`
+}
+
+// The body of the node/can-claim-rewards and node/claim-rewards routes
+type NodeClaimRewardsRequest struct {
+	ApiServerSettings `json:"settings"`
+	Indices           []uint64 `json:"indices"`
+}
+
+// The body of the node/can-claim-and-stake-rewards and node/claim-and-stake-rewards routes
+type NodeClaimAndStakeRewardsRequest struct {
+	ApiServerSettings `json:"settings"`
+	Indices           []uint64 `json:"indices"`
+	StakeAmount       *big.Int `json:"stakeAmount"`
+}
+
+// The body of the node/can-set-smoothing-pool-status and node/set-smoothing-pool-status routes
+type NodeSetSmoothingPoolStatusRequest struct {
+	ApiServerSettings `json:"settings"`
+	Status            bool `json:"status"`
+}
+
+// The body of the node/resolve-ens-name route
+type NodeResolveEnsNameRequest struct {
+	ApiServerSettings `json:"settings"`
+	Name              string `json:"name"`
+}
+
+// The body of the node/reverse-resolve-ens-name route
+type NodeReverseResolveEnsNameRequest struct {
+	ApiServerSettings `json:"settings"`
+	Address           string `json:"address"`
+}
+
+// The body of the node/can-create-vacant-minipool and node/create-vacant-minipool routes
+type NodeCreateVacantMinipoolRequest struct {
+	ApiServerSettings `json:"settings"`
+	AmountWei         *big.Int              `json:"amountWei"`
+	MinNodeFee        float64               `json:"minNodeFee"`
+	Salt              *big.Int              `json:"salt"`
+	Pubkey            types.ValidatorPubkey `json:"pubkey"`
+}
+
+// The body of the node/can-send-message and node/send-message routes
+type NodeSendMessageRequest struct {
+	ApiServerSettings `json:"settings"`
+	Address           common.Address `json:"address"`
+	Message           hexutil.Bytes  `json:"message"`
+}
+
+// The body of the node/can-cancel-tx and node/cancel-tx routes
+type NodeCancelTransactionRequest struct {
+	ApiServerSettings `json:"settings"`
+	Nonce             uint64 `json:"nonce"`
+}
+
+// The body of the odao/proposal-details route
+type TNDAOProposalDetailsRequest struct {
+	ApiServerSettings `json:"settings"`
+	Id                uint64 `json:"id"`
+}
+
+// The body of the odao/can-propose-invite and odao/propose-invite routes
+type TNDAOProposeInviteRequest struct {
+	ApiServerSettings `json:"settings"`
+	MemberAddress     common.Address `json:"memberAddress"`
+	MemberId          string         `json:"memberId"`
+	MemberUrl         string         `json:"memberUrl"`
+}
+
+// The body of the odao/can-propose-kick and odao/propose-kick routes
+type TNDAOProposeKickRequest struct {
+	ApiServerSettings `json:"settings"`
+	MemberAddress     common.Address `json:"memberAddress"`
+	FineAmountWei     *big.Int       `json:"fineAmountWei"`
+}
+
+// The body of the odao routes that act on a single proposal
+type TNDAOProposalRequest struct {
+	ApiServerSettings `json:"settings"`
+	ProposalId        uint64 `json:"proposalId"`
+}
+
+// The body of the odao/vote-proposal route
+type TNDAOVoteProposalRequest struct {
+	ApiServerSettings `json:"settings"`
+	ProposalId        uint64 `json:"proposalId"`
+	Support           bool   `json:"support"`
+}
+
+// The body of the odao/join route
+type TNDAOJoinRequest struct {
+	ApiServerSettings `json:"settings"`
+	Hash              common.Hash `json:"hash"`
+}
+
+// The body of the odao/leave route
+type TNDAOLeaveRequest struct {
+	ApiServerSettings `json:"settings"`
+	BondRefundAddress common.Address `json:"bondRefundAddress"`
+}
+
+// The body of the odao/can-propose-members-quorum and odao/propose-members-quorum routes
+type TNDAOProposeMembersQuorumRequest struct {
+	ApiServerSettings `json:"settings"`
+	Quorum            float64 `json:"quorum"`
+}
+
+// The body of the odao/can-propose-members-rplbond and odao/propose-members-rplbond routes
+type TNDAOProposeMembersRplBondRequest struct {
+	ApiServerSettings `json:"settings"`
+	BondAmountWei     *big.Int `json:"bondAmountWei"`
+}
+
+// The body of the odao/can-propose-members-minipool-unbonded-max and odao/propose-members-minipool-unbonded-max routes
+type TNDAOProposeMinipoolUnbondedMaxRequest struct {
+	ApiServerSettings   `json:"settings"`
+	UnbondedMinipoolMax uint64 `json:"unbondedMinipoolMax"`
+}
+
+// The body of the odao/can-propose-proposal-cooldown and odao/propose-proposal-cooldown routes
+type TNDAOProposeProposalCooldownRequest struct {
+	ApiServerSettings      `json:"settings"`
+	ProposalCooldownBlocks uint64 `json:"proposalCooldownBlocks"`
+}
+
+// The body of the odao/can-propose-proposal-vote-timespan and odao/propose-proposal-vote-timespan routes
+type TNDAOProposeProposalVoteTimespanRequest struct {
+	ApiServerSettings    `json:"settings"`
+	ProposalVoteTimespan uint64 `json:"proposalVoteTimespan"`
+}
+
+// The body of the odao/can-propose-proposal-vote-delay-timespan and odao/propose-proposal-vote-delay-timespan routes
+type TNDAOProposeProposalVoteDelayTimespanRequest struct {
+	ApiServerSettings     `json:"settings"`
+	ProposalDelayTimespan uint64 `json:"proposalDelayTimespan"`
+}
+
+// The body of the odao/can-propose-proposal-execute-timespan and odao/propose-proposal-execute-timespan routes
+type TNDAOProposeProposalExecuteTimespanRequest struct {
+	ApiServerSettings       `json:"settings"`
+	ProposalExecuteTimespan uint64 `json:"proposalExecuteTimespan"`
+}
+
+// The body of the odao/can-propose-proposal-action-timespan and odao/propose-proposal-action-timespan routes
+type TNDAOProposeProposalActionTimespanRequest struct {
+	ApiServerSettings      `json:"settings"`
+	ProposalActionTimespan uint64 `json:"proposalActionTimespan"`
+}
+
+// The body of the odao routes that propose a scrub period
+type TNDAOProposeScrubPeriodRequest struct {
+	ApiServerSettings `json:"settings"`
+	ScrubPeriod       uint64 `json:"scrubPeriod"`
+}
+
+// The body of the odao/can-propose-scrub-penalty-enabled and odao/propose-scrub-penalty-enabled routes
+type TNDAOProposeScrubPenaltyEnabledRequest struct {
+	ApiServerSettings `json:"settings"`
+	Enabled           bool `json:"enabled"`
+}
+
+// The body of the odao/can-propose-bond-reduction-window-start and odao/propose-bond-reduction-window-start routes
+type TNDAOProposeBondReductionWindowStartRequest struct {
+	ApiServerSettings `json:"settings"`
+	WindowStart       uint64 `json:"windowStart"`
+}
+
+// The body of the odao/can-propose-bond-reduction-window-length and odao/propose-bond-reduction-window-length routes
+type TNDAOProposeBondReductionWindowLengthRequest struct {
+	ApiServerSettings `json:"settings"`
+	WindowLength      uint64 `json:"windowLength"`
+}
+
+// The body of the wallet/set-password route
+type WalletSetPasswordRequest struct {
+	ApiServerSettings `json:"settings"`
+	Password          string `json:"password"`
+}
+
+// The body of the wallet/init route
+type WalletInitRequest struct {
+	ApiServerSettings `json:"settings"`
+	DerivationPath    string `json:"derivationPath"`
+}
+
+// The body of the wallet/recover and wallet/test-recovery routes
+type WalletRecoverRequest struct {
+	ApiServerSettings        `json:"settings"`
+	Mnemonic                 string `json:"mnemonic"`
+	SkipValidatorKeyRecovery bool   `json:"skipValidatorKeyRecovery"`
+	DerivationPath           string `json:"derivationPath"`
+	WalletIndex              uint   `json:"walletIndex"`
+}
+
+// The body of the wallet/search-and-recover and wallet/test-search-and-recover routes
+type WalletSearchAndRecoverRequest struct {
+	ApiServerSettings        `json:"settings"`
+	Mnemonic                 string         `json:"mnemonic"`
+	Address                  common.Address `json:"address"`
+	SkipValidatorKeyRecovery bool           `json:"skipValidatorKeyRecovery"`
+}
+
+// The body of the wallet/sign-tx route
+type WalletSignTransactionRequest struct {
+	ApiServerSettings `json:"settings"`
+	UnsignedTx        string `json:"unsignedTx"`
+}
+
+// The body of the wallet/save-slashing-protection route
+type WalletSaveSlashingProtectionRequest struct {
+	ApiServerSettings `json:"settings"`
+	Interchange       string `json:"interchange"`
+}
+
+// The body of the wallet/save-vc-slashing-protection route
+type WalletSaveVcSlashingProtectionRequest struct {
+	ApiServerSettings `json:"settings"`
+	ExportFile        string `json:"exportFile"`
+}
+
+// The body of the wallet/estimate-gas-set-ens-name and wallet/set-ens-name routes
+type WalletSetEnsNameRequest struct {
+	ApiServerSettings `json:"settings"`
+	Name              string `json:"name"`
+}
+
+// One of the API server's routes; GET routes take no body, and POST routes take the JSON request listed in their usage
+type ApiServerRoute struct {
+	Path   string `json:"path"`
+	Method string `json:"method"`
+	Usage  string `json:"usage"`
+}
+
+type ApiServerRoutesResponse struct {
+	Status string           `json:"status"`
+	Error  string           `json:"error"`
+	Routes []ApiServerRoute `json:"routes"`
+}
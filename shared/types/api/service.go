@@ -1,6 +1,10 @@
 package api
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 type TerminateDataFolderResponse struct {
 	Status        string `json:"status"`
@@ -23,11 +27,25 @@ type ClientStatus struct {
 	Error        string  `json:"error"`
 }
 
+// The health of a single client in a manager's pool
+type ClientEndpointStatus struct {
+	Endpoint  string       `json:"endpoint"`
+	Status    ClientStatus `json:"status"`
+	Head      uint64       `json:"head"`
+	HeadLag   uint64       `json:"headLag"`
+	IsLagging bool         `json:"isLagging"`
+	LatencyMs float64      `json:"latencyMs"`
+	ErrorRate float64      `json:"errorRate"`
+	Score     float64      `json:"score"`
+	IsActive  bool         `json:"isActive"`
+}
+
 // This is a wrapper for the manager's overall status report
 type ClientManagerStatus struct {
-	PrimaryClientStatus  ClientStatus `json:"primaryEcStatus"`
-	FallbackEnabled      bool         `json:"fallbackEnabled"`
-	FallbackClientStatus ClientStatus `json:"fallbackEcStatus"`
+	PrimaryClientStatus  ClientStatus           `json:"primaryEcStatus"`
+	FallbackEnabled      bool                   `json:"fallbackEnabled"`
+	FallbackClientStatus ClientStatus           `json:"fallbackEcStatus"`
+	Endpoints            []ClientEndpointStatus `json:"endpoints"`
 }
 
 type ClientStatusResponse struct {
@@ -41,3 +59,57 @@ type RestartVcResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+
+type StopVcResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
+// The run history of a single daemon task
+type TaskStatus struct {
+	Name                string        `json:"name"`
+	Interval            time.Duration `json:"interval"`
+	Running             bool          `json:"running"`
+	LastRunTime         time.Time     `json:"lastRunTime"`
+	LastDuration        time.Duration `json:"lastDuration"`
+	LastSuccessTime     time.Time     `json:"lastSuccessTime"`
+	LastError           string        `json:"lastError"`
+	LastErrorTime       time.Time     `json:"lastErrorTime"`
+	ConsecutiveFailures uint64        `json:"consecutiveFailures"`
+	NextRunTime         time.Time     `json:"nextRunTime"`
+}
+
+// The status of every task in a daemon
+type DaemonTaskStatus struct {
+	Daemon      string       `json:"daemon"`
+	UpdatedTime time.Time    `json:"updatedTime"`
+	Tasks       []TaskStatus `json:"tasks"`
+}
+
+type TaskStatusResponse struct {
+	Status  string             `json:"status"`
+	Error   string             `json:"error"`
+	Daemons []DaemonTaskStatus `json:"daemons"`
+}
+
+// The status of a single MEV-boost relay and the node's registrations with it
+type RelayStatus struct {
+	Name                     string   `json:"name"`
+	Url                      string   `json:"url"`
+	IsReachable              bool     `json:"isReachable"`
+	Error                    string   `json:"error"`
+	RegisteredCount          int      `json:"registeredCount"`
+	UnregisteredPubkeys      []string `json:"unregisteredPubkeys"`
+	WrongFeeRecipientPubkeys []string `json:"wrongFeeRecipientPubkeys"`
+	RegistrationErrorCount   int      `json:"registrationErrorCount"`
+}
+
+type CheckRelaysResponse struct {
+	Status               string         `json:"status"`
+	Error                string         `json:"error"`
+	MevBoostEnabled      bool           `json:"mevBoostEnabled"`
+	IsExternal           bool           `json:"isExternal"`
+	ValidatorCount       int            `json:"validatorCount"`
+	ExpectedFeeRecipient common.Address `json:"expectedFeeRecipient"`
+	Relays               []RelayStatus  `json:"relays"`
+}
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// An EIP-1559 transaction built by the API without being signed, so it can be signed on an offline machine.
+// Rlp is the transaction's unsigned EIP-2718 payload (0x02 || rlp([chainId, nonce, maxPriorityFeePerGas, maxFeePerGas,
+// gasLimit, to, value, data, accessList])), which is what gets signed; the other fields describe it.
+type UnsignedTransaction struct {
+	ChainID              *big.Int        `json:"chainId"`
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to"`
+	Nonce                uint64          `json:"nonce"`
+	Value                *big.Int        `json:"value"`
+	Data                 hexutil.Bytes   `json:"data"`
+	GasLimit             uint64          `json:"gasLimit"`
+	MaxFeePerGas         *big.Int        `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *big.Int        `json:"maxPriorityFeePerGas"`
+	Rlp                  hexutil.Bytes   `json:"rlp"`
+}
+
+type SignTransactionResponse struct {
+	Status            string        `json:"status"`
+	Error             string        `json:"error"`
+	SignedTransaction hexutil.Bytes `json:"signedTransaction"`
+	TxHash            common.Hash   `json:"txHash"`
+}
+
+type BroadcastTransactionResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// A change to an account's ETH balance caused by a simulated transaction
+type SimulatedBalanceChange struct {
+	Address  common.Address `json:"address"`
+	Contract string         `json:"contract"`
+	Before   *big.Int       `json:"before"`
+	After    *big.Int       `json:"after"`
+}
+
+// An event emitted by a simulated transaction; if it couldn't be decoded, only the raw topics and data are provided
+type SimulatedEvent struct {
+	Address  common.Address    `json:"address"`
+	Contract string            `json:"contract"`
+	Name     string            `json:"name"`
+	Args     map[string]string `json:"args"`
+	Topics   []common.Hash     `json:"topics"`
+	Data     hexutil.Bytes     `json:"data"`
+}
+
+// The expected outcome of a transaction, from running it against the pending block without sending it
+type TransactionSimulation struct {
+	From           common.Address           `json:"from"`
+	To             *common.Address          `json:"to"`
+	Contract       string                   `json:"contract"`
+	Value          *big.Int                 `json:"value"`
+	Success        bool                     `json:"success"`
+	RevertReason   string                   `json:"revertReason"`
+	ReturnData     hexutil.Bytes            `json:"returnData"`
+	GasUsed        uint64                   `json:"gasUsed"`
+	TraceAvailable bool                     `json:"traceAvailable"`
+	TraceError     string                   `json:"traceError"`
+	BalanceChanges []SimulatedBalanceChange `json:"balanceChanges"`
+	Events         []SimulatedEvent         `json:"events"`
+	Error          string                   `json:"error"`
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/types"
+
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
 
 // Encrypted validator keystore following the EIP-2335 standard
@@ -88,3 +90,16 @@ type PurgeResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+
+type GetSlashingProtectionResponse struct {
+	Status      string                                  `json:"status"`
+	Error       string                                  `json:"error"`
+	Exists      bool                                    `json:"exists"`
+	Interchange validator.SlashingProtectionInterchange `json:"interchange"`
+}
+
+type SaveSlashingProtectionResponse struct {
+	Status         string `json:"status"`
+	Error          string `json:"error"`
+	ValidatorCount int    `json:"validatorCount"`
+}
@@ -21,6 +21,7 @@ type Parameter struct {
 	EnvironmentVariables  []string                `yaml:"environmentVariables,omitempty"`
 	CanBeBlank            bool                    `yaml:"canBeBlank,omitempty"`
 	OverwriteOnUpgrade    bool                    `yaml:"overwriteOnUpgrade,omitempty"`
+	Sensitive             bool                    `yaml:"sensitive,omitempty"`
 	Options               []ParameterOption       `yaml:"options,omitempty"`
 	Value                 interface{}             `yaml:"-"`
 	DescriptionsByNetwork map[Network]string      `yaml:"-"`
@@ -131,6 +132,39 @@ func (param *Parameter) Deserialize(serializedParams map[string]string, network
 	return nil
 }
 
+// Check that the parameter's value matches its format, length and options
+func (param *Parameter) Validate() error {
+	switch param.Type {
+	case ParameterType_String:
+		value, ok := param.Value.(string)
+		if !ok {
+			return fmt.Errorf("value [%v] is not a string", param.Value)
+		}
+		if param.Regex != "" && value != "" {
+			regex, err := regexp.Compile(param.Regex)
+			if err != nil {
+				return fmt.Errorf("invalid format pattern [%s]: %w", param.Regex, err)
+			}
+			if !regex.MatchString(value) {
+				return fmt.Errorf("value [%s] does not match the expected format", value)
+			}
+		}
+		if param.MaxLength > 0 && len(value) > param.MaxLength {
+			return fmt.Errorf("value [%s] is longer than the max length of [%d]", value, param.MaxLength)
+		}
+	case ParameterType_Choice:
+		value := fmt.Sprint(param.Value)
+		for _, option := range param.Options {
+			if fmt.Sprint(option.Value) == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("value [%s] is not one of the valid options", value)
+	}
+
+	return nil
+}
+
 // Set the value to the default for the provided config's network
 func (param *Parameter) SetToDefault(network Network) error {
 	defaultSetting, err := param.GetDefault(network)
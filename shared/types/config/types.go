@@ -94,6 +94,7 @@ const (
 	MevRelayID_Eden               MevRelayID = "eden"
 	MevRelayID_Ultrasound         MevRelayID = "ultrasound"
 	MevRelayID_Aestus             MevRelayID = "aestus"
+	MevRelayID_Custom             MevRelayID = "custom"
 )
 
 // Enum to describe MEV-Boost relay selection mode
@@ -8,6 +8,8 @@ import (
 
 	"github.com/goccy/go-json"
 
+	"github.com/rocket-pool/smartnode/shared/services/simulation"
+	"github.com/rocket-pool/smartnode/shared/services/unsignedtx"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
@@ -20,12 +22,22 @@ func ZeroIfNil(in **big.Int) {
 // Print an API response
 // response must be a pointer to a struct type with Error and Status string fields
 func PrintResponse(response interface{}, responseError error) {
+	fmt.Println(string(EncodeResponse(response, responseError)))
+}
+
+// Print an API error response
+func PrintErrorResponse(err error) {
+	PrintResponse(&api.APIResponse{}, err)
+}
+
+// Encode an API response, so it can be printed or returned by the daemon's API server
+// response must be a pointer to a struct type with Error and Status string fields
+func EncodeResponse(response interface{}, responseError error) []byte {
 
 	// Check response type
 	r := reflect.ValueOf(response)
 	if !(r.Kind() == reflect.Ptr && r.Type().Elem().Kind() == reflect.Struct) {
-		PrintErrorResponse(errors.New("Invalid API response"))
-		return
+		return encodeErrorResponse(errors.New("Invalid API response"))
 	}
 
 	// Create zero response value if nil
@@ -38,8 +50,7 @@ func PrintResponse(response interface{}, responseError error) {
 	sf := r.Elem().FieldByName("Status")
 	ef := r.Elem().FieldByName("Error")
 	if !(sf.IsValid() && sf.CanSet() && sf.Kind() == reflect.String && ef.IsValid() && ef.CanSet() && ef.Kind() == reflect.String) {
-		PrintErrorResponse(errors.New("Invalid API response"))
-		return
+		return encodeErrorResponse(errors.New("Invalid API response"))
 	}
 
 	// Populate error
@@ -57,16 +68,44 @@ func PrintResponse(response interface{}, responseError error) {
 	// Encode
 	responseBytes, err := json.Marshal(response)
 	if err != nil {
-		PrintErrorResponse(fmt.Errorf("Could not encode API response: %w", err))
-		return
+		return encodeErrorResponse(fmt.Errorf("Could not encode API response: %w", err))
+	}
+
+	// Attach the results of any transaction simulations
+	if simulations := simulation.GetResults(); len(simulations) > 0 {
+		responseBytes, err = addField(responseBytes, "simulations", simulations)
+		if err != nil {
+			return encodeErrorResponse(fmt.Errorf("Could not encode API response: %w", err))
+		}
 	}
 
-	// Print
-	fmt.Println(string(responseBytes))
+	// Attach any transactions that were built for offline signing instead of being sent
+	if unsignedTransactions := unsignedtx.GetTransactions(); len(unsignedTransactions) > 0 {
+		responseBytes, err = addField(responseBytes, "unsignedTransactions", unsignedTransactions)
+		if err != nil {
+			return encodeErrorResponse(fmt.Errorf("Could not encode API response: %w", err))
+		}
+	}
+
+	return responseBytes
 
 }
 
-// Print an API error response
-func PrintErrorResponse(err error) {
-	PrintResponse(&api.APIResponse{}, err)
+// Encode an API error response
+func encodeErrorResponse(err error) []byte {
+	return EncodeResponse(&api.APIResponse{}, err)
+}
+
+// Add a field to an encoded API response
+func addField(responseBytes []byte, name string, value interface{}) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(responseBytes, &fields); err != nil {
+		return nil, err
+	}
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	fields[name] = valueBytes
+	return json.Marshal(fields)
 }
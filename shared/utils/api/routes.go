@@ -0,0 +1,20 @@
+package api
+
+import (
+	"github.com/urfave/cli"
+)
+
+// An API handler that the node daemon's API server serves as a route
+type Route struct {
+	// The route's path under the API server's prefix, e.g. node/status
+	Path string
+
+	// What the route does, shown in the list of routes
+	Usage string
+
+	// Create an empty request for the route to decode its JSON body into; routes without one take no body and are served over GET
+	NewRequest func() interface{}
+
+	// Run the handler with the request's context and decoded body, returning one of the shared/types/api responses
+	Handler func(c *cli.Context, request interface{}) (interface{}, error)
+}
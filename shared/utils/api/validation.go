@@ -0,0 +1,52 @@
+package api
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Validate a big int in an API server request
+func ValidateBigInt(name string, value *big.Int) error {
+	if value == nil {
+		return fmt.Errorf("Missing %s", name)
+	}
+	return nil
+}
+
+// Validate a positive wei amount in an API server request
+func ValidatePositiveWeiAmount(name string, value *big.Int) error {
+	if err := ValidateBigInt(name, value); err != nil {
+		return err
+	}
+	if value.Sign() < 1 {
+		return fmt.Errorf("Invalid %s '%s' - must be greater than 0", name, value.String())
+	}
+	return nil
+}
+
+// Validate a positive or zero wei amount in an API server request
+func ValidatePositiveOrZeroWeiAmount(name string, value *big.Int) error {
+	if err := ValidateBigInt(name, value); err != nil {
+		return err
+	}
+	if value.Sign() < 0 {
+		return fmt.Errorf("Invalid %s '%s' - must be greater or equal to 0", name, value.String())
+	}
+	return nil
+}
+
+// Validate a positive unsigned integer value in an API server request
+func ValidatePositiveUint(name string, value uint64) error {
+	if value == 0 {
+		return fmt.Errorf("Invalid %s '%d' - must be greater than 0", name, value)
+	}
+	return nil
+}
+
+// Validate a fraction in an API server request
+func ValidateFraction(name string, value float64) error {
+	if value < 0 || value > 1 {
+		return fmt.Errorf("Invalid %s '%f' - must be a number between 0 and 1", name, value)
+	}
+	return nil
+}
@@ -2,11 +2,13 @@ package migration
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/wallet"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
 	"github.com/urfave/cli"
 )
 
@@ -31,29 +33,70 @@ func ImportKey(c *cli.Context, rp *rocketpool.Client, minipoolAddress common.Add
 		mnemonic = wallet.PromptMnemonic()
 	}
 
+	// Save the validator's signing history from its old Validator Client, so the Smartnode's won't sign anything that conflicts with it
+	if c.String("slashing-protection") != "" {
+		if !saveSlashingProtection(rp, c.String("slashing-protection")) {
+			return false
+		}
+	}
+
 	// Import the key
 	fmt.Printf("Importing validator key... ")
-	_, err := rp.ImportKey(minipoolAddress, mnemonic)
+	response, err := rp.ImportKey(minipoolAddress, c.Bool("skip-liveness-check"), mnemonic)
 	if err != nil {
 		fmt.Printf("error importing validator key: %s\n", err.Error())
 		return false
 	}
+	if response.ValidatorIsLive {
+		fmt.Println("cancelled.")
+		fmt.Printf("%sYour validator attested in epoch %d, so it's still running in another Validator Client. Importing its key now would get it SLASHED.\n", colorRed, response.LastAttestationEpoch)
+		fmt.Printf("Please remove the key from your other Validator Client, restart it, and wait for at least %d epochs before trying again.%s\n", validator.LivenessCheckEpochs+1, colorReset)
+		fmt.Println("If you're certain the validator isn't running anywhere else, you can skip this check with `--skip-liveness-check`.")
+		return false
+	}
 	fmt.Println("done!")
 
-	// Restart the VC if necessary
+	// Load the key into the VC if necessary
 	if c.Bool("no-restart") {
 		return true
 	}
-	if c.Bool("yes") || cliutils.Confirm("Would you like to restart the Smartnode's Validator Client now so it loads your validator's key?") {
-		// Restart the VC
-		fmt.Print("Restarting Validator Client... ")
-		_, err := rp.RestartVc()
+	if c.Bool("yes") || cliutils.Confirm("Would you like to load your validator's key into the Smartnode's Validator Client now? If it doesn't support the keymanager API, it will be restarted instead.") {
+		fmt.Print("Loading validator key into the Validator Client... ")
+		response, err := rp.LoadKey(minipoolAddress)
 		if err != nil {
-			fmt.Printf("failed!\n%sWARNING: error restarting validator client: %s\n\nPlease restart it manually so it picks up the new validator key for your minipool.%s", colorYellow, err.Error(), colorReset)
+			fmt.Printf("failed!\n%sWARNING: error loading the key into the validator client: %s\n\nOnce the problem is resolved, please make sure it picks up the new validator key for your minipool.%s", colorYellow, err.Error(), colorReset)
 			return false
 		}
-		fmt.Println("done!\n")
+		if response.Restarted {
+			fmt.Println("done! (the Validator Client was restarted)")
+		} else {
+			fmt.Println("done!")
+		}
+		fmt.Println()
+	}
+	return true
+
+}
+
+// Saves an EIP-3076 slashing protection file exported from another Validator Client
+func saveSlashingProtection(rp *rocketpool.Client, path string) bool {
+
+	fmt.Printf("Saving slashing protection history... ")
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Printf("error reading slashing protection file: %s\n", err.Error())
+		return false
+	}
+	if _, err := validator.ParseSlashingProtectionInterchange(bytes); err != nil {
+		fmt.Printf("%s\n", err.Error())
+		return false
+	}
+	response, err := rp.SaveSlashingProtection(string(bytes))
+	if err != nil {
+		fmt.Printf("error saving slashing protection history: %s\n", err.Error())
+		return false
 	}
+	fmt.Printf("done! (history for %d validators)\n", response.ValidatorCount)
 	return true
 
 }
@@ -0,0 +1,129 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Output formats
+const (
+	Format_Text string = "text"
+	Format_Json string = "json"
+)
+
+// The number of fields at the start of an API call's arguments that name the API command (e.g. "node status")
+const apiCallNameFields int = 2
+
+// The JSON output of the running command
+var (
+	lock      sync.Mutex
+	writer    io.Writer
+	command   string
+	responses []api.CliApiResponse
+	data      interface{}
+)
+
+// Switch the CLI to JSON output. The JSON document is written to the provided writer when the command finishes;
+// the caller should send everything else the command prints somewhere else, such as stderr.
+func EnableJson(jsonWriter io.Writer) {
+	lock.Lock()
+	defer lock.Unlock()
+	writer = jsonWriter
+	responses = []api.CliApiResponse{}
+}
+
+// Check if the CLI is printing JSON output
+func IsJson() bool {
+	lock.Lock()
+	defer lock.Unlock()
+	return writer != nil
+}
+
+// Record the response to an API call the command made
+func AddApiResponse(args string, response []byte) {
+	lock.Lock()
+	defer lock.Unlock()
+	if writer == nil || !json.Valid(response) {
+		return
+	}
+
+	// Only keep the API command's name; the rest of the arguments can include passwords
+	fields := strings.Fields(args)
+	if len(fields) > apiCallNameFields {
+		fields = fields[:apiCallNameFields]
+	}
+	responses = append(responses, api.CliApiResponse{
+		Call:     strings.Join(fields, " "),
+		Response: append(json.RawMessage{}, response...),
+	})
+}
+
+// Set what the command reports that doesn't come from an API call
+func SetData(commandData interface{}) {
+	lock.Lock()
+	defer lock.Unlock()
+	data = commandData
+}
+
+// Print the JSON document for the command that just finished, if the CLI is printing JSON output
+func Print(commandErr error) error {
+	lock.Lock()
+	defer lock.Unlock()
+	if writer == nil {
+		return nil
+	}
+
+	output := api.CliOutput{
+		SchemaVersion: api.CliOutputSchemaVersion,
+		Command:       command,
+		Status:        "success",
+		Responses:     responses,
+		Data:          data,
+	}
+	if commandErr != nil {
+		output.Status = "error"
+		output.Error = commandErr.Error()
+	}
+	bytes, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing JSON output: %w", err)
+	}
+	_, err = fmt.Fprintln(writer, string(bytes))
+	return err
+}
+
+// Print the JSON document for the command, if the CLI is printing JSON output, and exit with the provided code.
+// Commands must use this instead of os.Exit, which would skip the JSON document.
+func Exit(code int, commandErr error) {
+	if err := Print(commandErr); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+	os.Exit(code)
+}
+
+// Record the full name of each command (e.g. "node status") before it runs, so it can be included in the JSON output
+func RegisterCommandNames(commands []cli.Command, parentName string) {
+	for i := range commands {
+		name := commands[i].Name
+		if parentName != "" {
+			name = fmt.Sprintf("%s %s", parentName, name)
+		}
+		if action, ok := commands[i].Action.(func(*cli.Context) error); ok {
+			commands[i].Action = func(c *cli.Context) error {
+				lock.Lock()
+				command = name
+				lock.Unlock()
+				return action(c)
+			}
+		}
+		RegisterCommandNames(commands[i].Subcommands, name)
+	}
+}
@@ -45,6 +45,11 @@ func PrintMultiTransactionNonceWarning() {
 // Implementation of PrintTransactionHash and PrintTransactionHashNoCancel
 func printTransactionHashImpl(rp *rocketpool.Client, hash common.Hash, finalMessage string) {
 
+	if rp.IsUnsignedTxMode() {
+		fmt.Println("The transaction has been exported for offline signing and has not been sent. Once it has been signed, submit it with `rocketpool node broadcast-tx`.")
+		return
+	}
+
 	cfg, isNew, err := rp.LoadConfig()
 	if err != nil {
 		fmt.Printf("Warning: couldn't read config file so the transaction URL will be unavailable (%s).\n", err)
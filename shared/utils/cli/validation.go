@@ -275,6 +275,15 @@ func ValidatePubkey(name, value string) (types.ValidatorPubkey, error) {
 	return pubkey, nil
 }
 
+// Validate a validator signature
+func ValidateValidatorSignature(name, value string) (types.ValidatorSignature, error) {
+	signature, err := types.HexToValidatorSignature(hexutils.RemovePrefix(value))
+	if err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("Invalid %s '%s': %w", name, value, err)
+	}
+	return signature, nil
+}
+
 // Validate a hex-encoded byte array
 func ValidateByteArray(name, value string) ([]byte, error) {
 	// Remove a 0x prefix if present
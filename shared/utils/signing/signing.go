@@ -0,0 +1,111 @@
+package signing
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/goccy/go-json"
+)
+
+// Parse EIP-712 typed data, in the format used by eth_signTypedData_v4
+func ParseTypedData(data []byte) (apitypes.TypedData, error) {
+	var typedData apitypes.TypedData
+	if err := json.Unmarshal(data, &typedData); err != nil {
+		return apitypes.TypedData{}, fmt.Errorf("error decoding typed data: %w", err)
+	}
+	if typedData.PrimaryType == "" {
+		return apitypes.TypedData{}, errors.New("typed data does not have a primary type")
+	}
+	if _, exists := typedData.Types[typedData.PrimaryType]; !exists {
+		return apitypes.TypedData{}, fmt.Errorf("typed data does not define its primary type '%s'", typedData.PrimaryType)
+	}
+	if _, exists := typedData.Types["EIP712Domain"]; !exists {
+		return apitypes.TypedData{}, errors.New("typed data does not define the EIP712Domain type")
+	}
+	return typedData, nil
+}
+
+// Get the chain ID of a typed data domain, or nil if it doesn't have one
+func GetTypedDataChainID(typedData apitypes.TypedData) *big.Int {
+	if typedData.Domain.ChainId == nil {
+		return nil
+	}
+	return (*big.Int)(typedData.Domain.ChainId)
+}
+
+// Check that typed data can be signed on the given chain. Typed data without a chain ID can be replayed on any chain,
+// so it's only allowed if the caller explicitly allows it.
+func ValidateTypedDataChainID(typedData apitypes.TypedData, chainID *big.Int, allowNoChainID bool) error {
+	domainChainID := GetTypedDataChainID(typedData)
+	if domainChainID == nil {
+		if allowNoChainID {
+			return nil
+		}
+		return errors.New("the typed data does not specify a chain ID, so its signature could be used on any network; it must be explicitly allowed to sign it")
+	}
+	if domainChainID.Cmp(chainID) != 0 {
+		return fmt.Errorf("the typed data is for chain ID %s, but the node is on chain ID %s", domainChainID.String(), chainID.String())
+	}
+	return nil
+}
+
+// Get the hash of typed data that gets signed
+func GetTypedDataHash(typedData apitypes.TypedData) ([]byte, error) {
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("error hashing typed data: %w", err)
+	}
+	return hash, nil
+}
+
+// Get a readable rendering of typed data
+func FormatTypedData(typedData apitypes.TypedData) (string, error) {
+	fields, err := typedData.Format()
+	if err != nil {
+		return "", fmt.Errorf("error formatting typed data: %w", err)
+	}
+	var builder strings.Builder
+	for _, field := range fields {
+		builder.WriteString(field.Pprint(0))
+	}
+	return strings.ReplaceAll(builder.String(), "\u00a0", " "), nil
+}
+
+// Get the address that produced a signature over a hash
+func RecoverAddress(hash []byte, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("invalid signature length %d, expected %d", len(signature), crypto.SignatureLength)
+	}
+
+	// Signatures from wallets use 27 / 28 for the recovery ID, but the crypto library expects 0 / 1
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pubkey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("error recovering signer: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pubkey), nil
+}
+
+// Get the address that signed a message with personal_sign (EIP-191)
+func RecoverMessageSigner(message string, signature []byte) (common.Address, error) {
+	return RecoverAddress(accounts.TextHash([]byte(message)), signature)
+}
+
+// Get the address that signed typed data (EIP-712)
+func RecoverTypedDataSigner(typedData apitypes.TypedData, signature []byte) (common.Address, error) {
+	hash, err := GetTypedDataHash(typedData)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return RecoverAddress(hash, signature)
+}
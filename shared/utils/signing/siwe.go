@@ -0,0 +1,159 @@
+package signing
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Config
+const (
+	siweHeaderSuffix string = " wants you to sign in with your Ethereum account:"
+	siweVersion      string = "1"
+)
+
+// A Sign-In with Ethereum message (EIP-4361)
+type SiweMessage struct {
+	Domain         string
+	Address        common.Address
+	Statement      string
+	Uri            string
+	Version        string
+	ChainID        *big.Int
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime *time.Time
+	NotBefore      *time.Time
+	RequestID      string
+	Resources      []string
+}
+
+// Parse a Sign-In with Ethereum message
+func ParseSiweMessage(message string) (*SiweMessage, error) {
+
+	lines := strings.Split(strings.ReplaceAll(message, "\r\n", "\n"), "\n")
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) < 2 {
+		return nil, errors.New("message is too short to be a Sign-In with Ethereum message")
+	}
+
+	// Header and address
+	if !strings.HasSuffix(lines[0], siweHeaderSuffix) {
+		return nil, fmt.Errorf("message does not start with '<domain>%s'", siweHeaderSuffix)
+	}
+	siwe := &SiweMessage{
+		Domain:    strings.TrimSuffix(lines[0], siweHeaderSuffix),
+		Resources: []string{},
+	}
+	if siwe.Domain == "" {
+		return nil, errors.New("message does not have a domain")
+	}
+	if !common.IsHexAddress(lines[1]) {
+		return nil, fmt.Errorf("invalid address '%s'", lines[1])
+	}
+	siwe.Address = common.HexToAddress(lines[1])
+
+	// Optional statement, surrounded by blank lines
+	i := 2
+	for i < len(lines) && lines[i] == "" {
+		i++
+	}
+	if i < len(lines) && !strings.HasPrefix(lines[i], "URI: ") {
+		siwe.Statement = lines[i]
+		i++
+		for i < len(lines) && lines[i] == "" {
+			i++
+		}
+	}
+
+	// Fields
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if line == "Resources:" {
+			for i+1 < len(lines) && strings.HasPrefix(lines[i+1], "- ") {
+				i++
+				siwe.Resources = append(siwe.Resources, strings.TrimPrefix(lines[i], "- "))
+			}
+			continue
+		}
+
+		key, value, found := strings.Cut(line, ": ")
+		if !found {
+			return nil, fmt.Errorf("invalid line '%s'", line)
+		}
+		var err error
+		switch key {
+		case "URI":
+			siwe.Uri = value
+		case "Version":
+			siwe.Version = value
+		case "Chain ID":
+			chainID, success := big.NewInt(0).SetString(value, 10)
+			if !success {
+				return nil, fmt.Errorf("invalid chain ID '%s'", value)
+			}
+			siwe.ChainID = chainID
+		case "Nonce":
+			siwe.Nonce = value
+		case "Issued At":
+			siwe.IssuedAt, err = time.Parse(time.RFC3339, value)
+		case "Expiration Time":
+			var expirationTime time.Time
+			expirationTime, err = time.Parse(time.RFC3339, value)
+			siwe.ExpirationTime = &expirationTime
+		case "Not Before":
+			var notBefore time.Time
+			notBefore, err = time.Parse(time.RFC3339, value)
+			siwe.NotBefore = &notBefore
+		case "Request ID":
+			siwe.RequestID = value
+		default:
+			return nil, fmt.Errorf("unknown field '%s'", key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s '%s': %w", key, value, err)
+		}
+	}
+
+	// Check the required fields
+	if siwe.Uri == "" {
+		return nil, errors.New("message does not have a URI")
+	}
+	if siwe.Version != siweVersion {
+		return nil, fmt.Errorf("unsupported version '%s'", siwe.Version)
+	}
+	if siwe.ChainID == nil {
+		return nil, errors.New("message does not have a chain ID")
+	}
+	if len(siwe.Nonce) < 8 {
+		return nil, errors.New("message nonce must be at least 8 characters long")
+	}
+	if siwe.IssuedAt.IsZero() {
+		return nil, errors.New("message does not have an issue time")
+	}
+	return siwe, nil
+
+}
+
+// Check that a message can be signed by the given account on the given chain right now
+func (m *SiweMessage) Validate(address common.Address, chainID *big.Int, now time.Time) error {
+	if m.Address != address {
+		return fmt.Errorf("the message is for account %s, but the node account is %s", m.Address.Hex(), address.Hex())
+	}
+	if m.ChainID.Cmp(chainID) != 0 {
+		return fmt.Errorf("the message is for chain ID %s, but the node is on chain ID %s", m.ChainID.String(), chainID.String())
+	}
+	if m.ExpirationTime != nil && !now.Before(*m.ExpirationTime) {
+		return fmt.Errorf("the message expired at %s", m.ExpirationTime.Format(time.RFC3339))
+	}
+	if m.NotBefore != nil && now.Before(*m.NotBefore) {
+		return fmt.Errorf("the message is not valid until %s", m.NotBefore.Format(time.RFC3339))
+	}
+	return nil
+}
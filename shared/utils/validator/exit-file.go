@@ -0,0 +1,134 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/types"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+	hexutils "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Config
+const (
+	EncryptedExitFileVersion uint = 1
+)
+
+// A voluntary exit message, in the format used by the Beacon API
+type VoluntaryExitMessage struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+// A signed voluntary exit, in the format used by the Beacon API (signed_voluntary_exit).
+// This is what an unencrypted exit file contains, so it can be submitted with any tool that can talk to a Beacon Node.
+type SignedVoluntaryExit struct {
+	Message   VoluntaryExitMessage `json:"message"`
+	Signature string               `json:"signature"`
+}
+
+// An exit file encrypted with a passphrase, using the same scheme as EIP-2335 keystores
+type EncryptedExitFile struct {
+	Version uint                   `json:"version"`
+	Pubkey  string                 `json:"pubkey"`
+	Crypto  map[string]interface{} `json:"crypto"`
+}
+
+// Create a signed voluntary exit
+func NewSignedVoluntaryExit(validatorIndex string, epoch uint64, signature types.ValidatorSignature) SignedVoluntaryExit {
+	return SignedVoluntaryExit{
+		Message: VoluntaryExitMessage{
+			Epoch:          strconv.FormatUint(epoch, 10),
+			ValidatorIndex: validatorIndex,
+		},
+		Signature: hexutils.AddPrefix(signature.Hex()),
+	}
+}
+
+// Get the epoch of the exit
+func (e SignedVoluntaryExit) GetEpoch() (uint64, error) {
+	epoch, err := strconv.ParseUint(e.Message.Epoch, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid exit epoch '%s': %w", e.Message.Epoch, err)
+	}
+	return epoch, nil
+}
+
+// Get the signature of the exit
+func (e SignedVoluntaryExit) GetSignature() (types.ValidatorSignature, error) {
+	signature, err := types.HexToValidatorSignature(hexutils.RemovePrefix(e.Signature))
+	if err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("invalid exit signature: %w", err)
+	}
+	return signature, nil
+}
+
+// Serialize a signed exit into an exit file, encrypting it if a passphrase is provided
+func CreateExitFile(exit SignedVoluntaryExit, pubkey types.ValidatorPubkey, passphrase string) ([]byte, error) {
+
+	exitBytes, err := json.MarshalIndent(exit, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error serializing exit for validator %s: %w", pubkey.Hex(), err)
+	}
+	if passphrase == "" {
+		return exitBytes, nil
+	}
+
+	crypto, err := eth2ks.New().Encrypt(exitBytes, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting exit for validator %s: %w", pubkey.Hex(), err)
+	}
+	return json.MarshalIndent(EncryptedExitFile{
+		Version: EncryptedExitFileVersion,
+		Pubkey:  pubkey.Hex(),
+		Crypto:  crypto,
+	}, "", "  ")
+
+}
+
+// Check if the contents of an exit file are encrypted
+func IsExitFileEncrypted(fileBytes []byte) bool {
+	var file EncryptedExitFile
+	if err := json.Unmarshal(fileBytes, &file); err != nil {
+		return false
+	}
+	return file.Crypto != nil
+}
+
+// Read a signed exit from the contents of an exit file, decrypting it with the passphrase if it's encrypted
+func ParseExitFile(fileBytes []byte, passphrase string) (SignedVoluntaryExit, error) {
+
+	if IsExitFileEncrypted(fileBytes) {
+		var file EncryptedExitFile
+		if err := json.Unmarshal(fileBytes, &file); err != nil {
+			return SignedVoluntaryExit{}, fmt.Errorf("error reading encrypted exit file: %w", err)
+		}
+		if file.Version != EncryptedExitFileVersion {
+			return SignedVoluntaryExit{}, fmt.Errorf("unsupported encrypted exit file version %d", file.Version)
+		}
+		decrypted, err := eth2ks.New().Decrypt(file.Crypto, passphrase)
+		if err != nil {
+			return SignedVoluntaryExit{}, errors.New("error decrypting exit file: the passphrase is incorrect or the file is corrupted")
+		}
+		fileBytes = decrypted
+	}
+
+	var exit SignedVoluntaryExit
+	if err := json.Unmarshal(fileBytes, &exit); err != nil {
+		return SignedVoluntaryExit{}, fmt.Errorf("error reading exit file: %w", err)
+	}
+	if exit.Message.ValidatorIndex == "" || exit.Message.Epoch == "" || exit.Signature == "" {
+		return SignedVoluntaryExit{}, errors.New("the exit file does not contain a signed voluntary exit")
+	}
+	if _, err := exit.GetEpoch(); err != nil {
+		return SignedVoluntaryExit{}, err
+	}
+	if _, err := exit.GetSignature(); err != nil {
+		return SignedVoluntaryExit{}, err
+	}
+	return exit, nil
+
+}
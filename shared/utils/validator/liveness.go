@@ -0,0 +1,139 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+// Config
+const (
+	// The number of epochs before the current one to look for attestations in before saving a validator key
+	LivenessCheckEpochs uint64 = 2
+)
+
+// A validator's position in an attestation committee
+type committeePosition struct {
+	pubkey   types.ValidatorPubkey
+	position uint64
+}
+
+// Check which of the given validators have attested recently, returning the latest epoch each of them attested in.
+// A validator that's live somewhere else must not be loaded into another VC, or it will be slashed for double voting.
+func GetLiveValidators(bc beacon.Client, pubkeys []types.ValidatorPubkey, epochs uint64) (map[types.ValidatorPubkey]uint64, error) {
+
+	liveValidators := map[types.ValidatorPubkey]uint64{}
+
+	// Get the indices of the validators that are on the Beacon Chain; the rest can't have attested
+	statuses, err := bc.GetValidatorStatuses(pubkeys, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting validator statuses: %w", err)
+	}
+	pubkeysByIndex := map[string]types.ValidatorPubkey{}
+	for pubkey, status := range statuses {
+		if status.Exists {
+			pubkeysByIndex[status.Index] = pubkey
+		}
+	}
+	if len(pubkeysByIndex) == 0 {
+		return liveValidators, nil
+	}
+
+	// Get the range of epochs to check
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, fmt.Errorf("error getting Beacon config: %w", err)
+	}
+	headBlock, exists, err := bc.GetBeaconBlock("head")
+	if err != nil {
+		return nil, fmt.Errorf("error getting Beacon head block: %w", err)
+	}
+	if !exists {
+		return nil, errors.New("the Beacon Node did not return a head block")
+	}
+	headSlot := headBlock.Slot
+	headEpoch := headSlot / eth2Config.SlotsPerEpoch
+	startEpoch := uint64(0)
+	if headEpoch > epochs {
+		startEpoch = headEpoch - epochs
+	}
+
+	// Find the committee positions of the validators in each slot
+	duties := map[uint64]map[uint64][]committeePosition{}
+	for epoch := startEpoch; epoch <= headEpoch; epoch++ {
+		err := getCommitteePositions(bc, epoch, pubkeysByIndex, duties)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Look for their attestations in every block since the start of the first epoch
+	for slot := startEpoch * eth2Config.SlotsPerEpoch; slot <= headSlot; slot++ {
+		attestations, exists, err := bc.GetAttestations(fmt.Sprint(slot))
+		if err != nil {
+			return nil, fmt.Errorf("error getting attestations for slot %d: %w", slot, err)
+		}
+		if !exists {
+			continue
+		}
+
+		for _, attestation := range attestations {
+			committees, exists := duties[attestation.SlotIndex]
+			if !exists {
+				continue
+			}
+			positions, exists := committees[attestation.CommitteeIndex]
+			if !exists {
+				continue
+			}
+			for _, position := range positions {
+				if !attestation.AggregationBits.BitAt(position.position) {
+					continue
+				}
+				epoch := attestation.SlotIndex / eth2Config.SlotsPerEpoch
+				if latestEpoch, exists := liveValidators[position.pubkey]; !exists || epoch > latestEpoch {
+					liveValidators[position.pubkey] = epoch
+				}
+			}
+		}
+	}
+
+	return liveValidators, nil
+
+}
+
+// Add the positions of the given validators in the attestation committees of an epoch to the duties map, keyed by slot and committee index
+func getCommitteePositions(bc beacon.Client, epoch uint64, pubkeysByIndex map[string]types.ValidatorPubkey, duties map[uint64]map[uint64][]committeePosition) error {
+
+	committees, err := bc.GetCommitteesForEpoch(&epoch)
+	if err != nil {
+		return fmt.Errorf("error getting committees for epoch %d: %w", epoch, err)
+	}
+	defer committees.Release()
+
+	for idx := 0; idx < committees.Count(); idx++ {
+		for position, validatorIndex := range committees.Validators(idx) {
+			pubkey, exists := pubkeysByIndex[validatorIndex]
+			if !exists {
+				continue
+			}
+
+			slot := committees.Slot(idx)
+			committeeIndex := committees.Index(idx)
+			slotDuties, exists := duties[slot]
+			if !exists {
+				slotDuties = map[uint64][]committeePosition{}
+				duties[slot] = slotDuties
+			}
+			slotDuties[committeeIndex] = append(slotDuties[committeeIndex], committeePosition{
+				pubkey:   pubkey,
+				position: uint64(position),
+			})
+		}
+	}
+	return nil
+
+}
@@ -0,0 +1,139 @@
+package validator
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+)
+
+// A remote service that holds validator keys and signs messages with them on request
+type RemoteSigner interface {
+	SignVoluntaryExit(pubkey types.ValidatorPubkey, exit eth2.VoluntaryExit, fork beacon.Fork, genesisValidatorsRoot []byte, signingRoot common.Hash) (types.ValidatorSignature, error)
+	SignDeposit(pubkey types.ValidatorPubkey, deposit eth2.DepositDataNoSignature, genesisForkVersion []byte, signingRoot common.Hash) (types.ValidatorSignature, error)
+}
+
+// Get a voluntary exit message signature for a given validator from a remote signer
+func GetRemoteSignedExitMessage(signer RemoteSigner, pubkey types.ValidatorPubkey, validatorIndex string, epoch uint64, fork beacon.Fork, genesisValidatorsRoot []byte) (types.ValidatorSignature, error) {
+
+	// Parse the validator index
+	indexNum, err := strconv.ParseUint(validatorIndex, 10, 64)
+	if err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("error parsing validator index (%s): %w", validatorIndex, err)
+	}
+
+	// Build voluntary exit message
+	exitMessage := eth2.VoluntaryExit{
+		Epoch:          epoch,
+		ValidatorIndex: indexNum,
+	}
+
+	// Get the signing root so the signer's result can be checked against it
+	forkVersion := fork.CurrentVersion
+	if epoch < fork.Epoch {
+		forkVersion = fork.PreviousVersion
+	}
+	domain := eth2types.Domain(eth2types.DomainVoluntaryExit, forkVersion, genesisValidatorsRoot)
+	srHash, err := getSigningRoot(&exitMessage, domain)
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+
+	// Sign message
+	signature, err := signer.SignVoluntaryExit(pubkey, exitMessage, fork, genesisValidatorsRoot, srHash)
+	if err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("error signing voluntary exit for validator %s with the remote signer: %w", pubkey.Hex(), err)
+	}
+	if err := verifySignature(pubkey, srHash, signature); err != nil {
+		return types.ValidatorSignature{}, err
+	}
+
+	// Return
+	return signature, nil
+
+}
+
+// Get deposit data & root for a given validator and withdrawal credentials from a remote signer
+func GetRemoteSignedDepositData(signer RemoteSigner, pubkey types.ValidatorPubkey, withdrawalCredentials common.Hash, eth2Config beacon.Eth2Config, depositAmount uint64) (eth2.DepositData, common.Hash, error) {
+
+	// Build deposit data
+	dd := eth2.DepositDataNoSignature{
+		PublicKey:             pubkey.Bytes(),
+		WithdrawalCredentials: withdrawalCredentials[:],
+		Amount:                depositAmount,
+	}
+
+	// Get the signing root so the signer's result can be checked against it
+	domain := eth2types.Domain(eth2types.DomainDeposit, eth2Config.GenesisForkVersion, eth2types.ZeroGenesisValidatorsRoot)
+	srHash, err := getSigningRoot(&dd, domain)
+	if err != nil {
+		return eth2.DepositData{}, common.Hash{}, err
+	}
+
+	// Sign it
+	signature, err := signer.SignDeposit(pubkey, dd, eth2Config.GenesisForkVersion, srHash)
+	if err != nil {
+		return eth2.DepositData{}, common.Hash{}, fmt.Errorf("error signing deposit data for validator %s with the remote signer: %w", pubkey.Hex(), err)
+	}
+	if err := verifySignature(pubkey, srHash, signature); err != nil {
+		return eth2.DepositData{}, common.Hash{}, err
+	}
+
+	// Build deposit data struct (with signature)
+	var depositData = eth2.DepositData{
+		PublicKey:             dd.PublicKey,
+		WithdrawalCredentials: dd.WithdrawalCredentials,
+		Amount:                dd.Amount,
+		Signature:             signature.Bytes(),
+	}
+
+	// Get deposit data root
+	depositDataRoot, err := depositData.HashTreeRoot()
+	if err != nil {
+		return eth2.DepositData{}, common.Hash{}, err
+	}
+
+	// Return
+	return depositData, depositDataRoot, nil
+
+}
+
+// Get the signing root for an SSZ object in the given domain
+func getSigningRoot(object interface{ HashTreeRoot() ([32]byte, error) }, domain []byte) (common.Hash, error) {
+	or, err := object.HashTreeRoot()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	sr := eth2.SigningRoot{
+		ObjectRoot: or[:],
+		Domain:     domain,
+	}
+	srHash, err := sr.HashTreeRoot()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.Hash(srHash), nil
+}
+
+// Make sure a signature returned by a remote signer is valid for the given validator and signing root
+func verifySignature(pubkey types.ValidatorPubkey, signingRoot common.Hash, signature types.ValidatorSignature) error {
+	if err := InitializeBLS(); err != nil {
+		return fmt.Errorf("error initializing BLS library: %w", err)
+	}
+	blsPubkey, err := eth2types.BLSPublicKeyFromBytes(pubkey.Bytes())
+	if err != nil {
+		return fmt.Errorf("error parsing pubkey %s: %w", pubkey.Hex(), err)
+	}
+	blsSignature, err := eth2types.BLSSignatureFromBytes(signature.Bytes())
+	if err != nil {
+		return fmt.Errorf("remote signer returned an invalid signature for validator %s: %w", pubkey.Hex(), err)
+	}
+	if !blsSignature.Verify(signingRoot[:], blsPubkey) {
+		return fmt.Errorf("remote signer returned a signature for validator %s that doesn't match the expected signing root %s", pubkey.Hex(), signingRoot.Hex())
+	}
+	return nil
+}
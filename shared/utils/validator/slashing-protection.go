@@ -0,0 +1,182 @@
+package validator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/types"
+
+	hexutils "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Config
+const (
+	SlashingProtectionInterchangeVersion string = "5"
+)
+
+// An EIP-3076 slashing protection interchange file
+type SlashingProtectionInterchange struct {
+	Metadata SlashingProtectionMetadata `json:"metadata"`
+	Data     []SlashingProtectionData   `json:"data"`
+}
+
+// The metadata of an EIP-3076 interchange file
+type SlashingProtectionMetadata struct {
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+	GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+}
+
+// The signing history of a single validator
+type SlashingProtectionData struct {
+	Pubkey             string                    `json:"pubkey"`
+	SignedBlocks       []SlashingProtectionBlock `json:"signed_blocks"`
+	SignedAttestations []SlashingProtectionVote  `json:"signed_attestations"`
+}
+
+// A block that was signed by a validator
+type SlashingProtectionBlock struct {
+	Slot        uint64 `json:"slot,string"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+// An attestation that was signed by a validator
+type SlashingProtectionVote struct {
+	SourceEpoch uint64 `json:"source_epoch,string"`
+	TargetEpoch uint64 `json:"target_epoch,string"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+// The highest slot and epochs a validator has signed, which is all that's needed to keep it from being slashed
+type slashingProtectionWatermark struct {
+	hasBlock       bool
+	maxSlot        uint64
+	hasAttestation bool
+	maxSourceEpoch uint64
+	maxTargetEpoch uint64
+}
+
+// Create an empty interchange for the chain with the given genesis validators root
+func NewSlashingProtectionInterchange(genesisValidatorsRoot []byte) SlashingProtectionInterchange {
+	return SlashingProtectionInterchange{
+		Metadata: SlashingProtectionMetadata{
+			InterchangeFormatVersion: SlashingProtectionInterchangeVersion,
+			GenesisValidatorsRoot:    hexutils.AddPrefix(fmt.Sprintf("%x", genesisValidatorsRoot)),
+		},
+		Data: []SlashingProtectionData{},
+	}
+}
+
+// Parse and sanity check a serialized interchange file
+func ParseSlashingProtectionInterchange(bytes []byte) (SlashingProtectionInterchange, error) {
+	var interchange SlashingProtectionInterchange
+	if err := json.Unmarshal(bytes, &interchange); err != nil {
+		return SlashingProtectionInterchange{}, fmt.Errorf("error deserializing slashing protection interchange: %w", err)
+	}
+	if interchange.Metadata.InterchangeFormatVersion != SlashingProtectionInterchangeVersion {
+		return SlashingProtectionInterchange{}, fmt.Errorf("unsupported slashing protection interchange version '%s' (only version %s is supported)", interchange.Metadata.InterchangeFormatVersion, SlashingProtectionInterchangeVersion)
+	}
+	for _, data := range interchange.Data {
+		if _, err := types.HexToValidatorPubkey(hexutils.RemovePrefix(data.Pubkey)); err != nil {
+			return SlashingProtectionInterchange{}, fmt.Errorf("invalid validator pubkey '%s' in slashing protection interchange: %w", data.Pubkey, err)
+		}
+	}
+	return interchange, nil
+}
+
+// Check if the interchange was made for the chain with the given genesis validators root
+func (i SlashingProtectionInterchange) IsForChain(genesisValidatorsRoot []byte) bool {
+	return strings.EqualFold(hexutils.RemovePrefix(i.Metadata.GenesisValidatorsRoot), fmt.Sprintf("%x", genesisValidatorsRoot))
+}
+
+// Get the signing history of the given validators only
+func (i SlashingProtectionInterchange) Filter(pubkeys []types.ValidatorPubkey) SlashingProtectionInterchange {
+	include := map[string]bool{}
+	for _, pubkey := range pubkeys {
+		include[normalizePubkey(pubkey.Hex())] = true
+	}
+
+	filtered := SlashingProtectionInterchange{
+		Metadata: i.Metadata,
+		Data:     []SlashingProtectionData{},
+	}
+	for _, data := range i.Data {
+		if include[normalizePubkey(data.Pubkey)] {
+			filtered.Data = append(filtered.Data, data)
+		}
+	}
+	return filtered
+}
+
+// Merge two interchanges for the same chain into the EIP-3076 minimal format, keeping only the highest slot and epochs each validator has signed
+func MergeSlashingProtection(existing SlashingProtectionInterchange, incoming SlashingProtectionInterchange) (SlashingProtectionInterchange, error) {
+	if !strings.EqualFold(hexutils.RemovePrefix(existing.Metadata.GenesisValidatorsRoot), hexutils.RemovePrefix(incoming.Metadata.GenesisValidatorsRoot)) {
+		return SlashingProtectionInterchange{}, fmt.Errorf("the slashing protection interchange is for genesis validators root %s, but this node's is %s", incoming.Metadata.GenesisValidatorsRoot, existing.Metadata.GenesisValidatorsRoot)
+	}
+
+	// Get the watermarks of each validator across both interchanges
+	watermarks := map[string]*slashingProtectionWatermark{}
+	for _, interchange := range []SlashingProtectionInterchange{existing, incoming} {
+		for _, data := range interchange.Data {
+			pubkey := normalizePubkey(data.Pubkey)
+			watermark, exists := watermarks[pubkey]
+			if !exists {
+				watermark = &slashingProtectionWatermark{}
+				watermarks[pubkey] = watermark
+			}
+			for _, block := range data.SignedBlocks {
+				if !watermark.hasBlock || block.Slot > watermark.maxSlot {
+					watermark.maxSlot = block.Slot
+				}
+				watermark.hasBlock = true
+			}
+			for _, attestation := range data.SignedAttestations {
+				if !watermark.hasAttestation || attestation.SourceEpoch > watermark.maxSourceEpoch {
+					watermark.maxSourceEpoch = attestation.SourceEpoch
+				}
+				if !watermark.hasAttestation || attestation.TargetEpoch > watermark.maxTargetEpoch {
+					watermark.maxTargetEpoch = attestation.TargetEpoch
+				}
+				watermark.hasAttestation = true
+			}
+		}
+	}
+
+	// Build the merged interchange, ordered by pubkey so the file is always written the same way
+	merged := SlashingProtectionInterchange{
+		Metadata: SlashingProtectionMetadata{
+			InterchangeFormatVersion: SlashingProtectionInterchangeVersion,
+			GenesisValidatorsRoot:    existing.Metadata.GenesisValidatorsRoot,
+		},
+		Data: make([]SlashingProtectionData, 0, len(watermarks)),
+	}
+	for pubkey, watermark := range watermarks {
+		data := SlashingProtectionData{
+			Pubkey:             pubkey,
+			SignedBlocks:       []SlashingProtectionBlock{},
+			SignedAttestations: []SlashingProtectionVote{},
+		}
+		if watermark.hasBlock {
+			data.SignedBlocks = append(data.SignedBlocks, SlashingProtectionBlock{
+				Slot: watermark.maxSlot,
+			})
+		}
+		if watermark.hasAttestation {
+			data.SignedAttestations = append(data.SignedAttestations, SlashingProtectionVote{
+				SourceEpoch: watermark.maxSourceEpoch,
+				TargetEpoch: watermark.maxTargetEpoch,
+			})
+		}
+		merged.Data = append(merged.Data, data)
+	}
+	sort.Slice(merged.Data, func(i, j int) bool {
+		return merged.Data[i].Pubkey < merged.Data[j].Pubkey
+	})
+	return merged, nil
+}
+
+// Get the canonical form of a pubkey in an interchange file
+func normalizePubkey(pubkey string) string {
+	return hexutils.AddPrefix(strings.ToLower(hexutils.RemovePrefix(pubkey)))
+}